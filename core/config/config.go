@@ -9,27 +9,47 @@ import (
 )
 
 var (
-	IsDebug   = true
-	DBConn    = ""
-	JWTSecret = ""
+	IsDebug    = true
+	DBConn     = ""
+	JWTSecret  = ""
+	Storage    StorageConfig
+	Upload     UploadConfig
+	SLA        SLAConfig
+	Assignment AssignmentConfig
+	Priority   PriorityConfig
+	Rating     RatingConfig
+
+	AutoComplete AutoCompleteConfig
+	Schedule     ScheduleConfig
+	Export       ExportConfig
+	Seed         SeedConfig
 )
 
 const (
 	mysqlDBConnFormat = "%s:%s@tcp(%s:%d)/%s?%s"
+
+	// TestEnvKey 값이 있으면 config.json 이 없을 때 panic 대신 테스트 값으로 시작
+	TestEnvKey = "EDITFOLIO_TEST"
 )
 
 func init() {
-	file, err := os.Open("config.json")
-	if err != nil {
-		panic(err)
-	}
-
 	var val = make(url.Values)
 	val.Add("charset", "utf8mb4")
 	val.Add("parseTime", "true")
 	val.Add("loc", time.UTC.String())
 
-	err = json.NewDecoder(file).Decode(&c)
+	file, err := os.Open("config.json")
+	switch {
+	case err == nil:
+		err = json.NewDecoder(file).Decode(&c)
+		file.Close()
+	case os.IsNotExist(err) && os.Getenv(TestEnvKey) != "":
+		// 설정 파일 없이 도는 테스트만, 명시적으로 켰을 때 테스트 값을 씀
+		setTestConfig()
+		err = nil
+	default:
+		panic(err)
+	}
 
 	if err != nil {
 		IsDebug = true
@@ -43,5 +63,194 @@ func init() {
 			db.User, db.Pass, db.Host, db.Port, db.Name, val.Encode())
 
 		JWTSecret = c.JWT.Secret
+		Storage = c.Storage
+		Upload = c.Upload
+		SLA = c.SLA
+		Assignment = c.Assignment
+		Priority = c.Priority
+		Rating = c.Rating
+		AutoComplete = c.AutoComplete
+		Schedule = c.Schedule
+		Export = c.Export
+		Seed = c.Seed
+	}
+
+	setStorageDefault()
+	setUploadDefault()
+	setSLADefault()
+	setAssignmentDefault()
+	setPriorityDefault()
+	setRatingDefault()
+	setAutoCompleteDefault()
+	setScheduleDefault()
+	setExportDefault()
+	setSeedDefault()
+
+	// 서명 비밀키가 비어있으면 누구나 서명 URL 을 만들 수 있으므로 시작하지 않음,
+	// 설정을 읽지 못해 디버그 기본값으로 도는 경우는 그대로 둠
+	if err == nil && Storage.SignSecret == "" {
+		panic("config: storage.sign_secret is empty")
+	}
+}
+
+func setTestConfig() {
+	c.DB.User = "root"
+	c.DB.Pass = "1234"
+	c.DB.Host = "localhost"
+	c.DB.Port = 3306
+	c.DB.Name = "editfolio"
+	c.JWT.Secret = "test-secret"
+}
+
+func setStorageDefault() {
+	if Storage.Type == "" {
+		Storage.Type = "local"
+	}
+
+	if Storage.SignSecret == "" {
+		Storage.SignSecret = JWTSecret
+	}
+
+	if Storage.SignedUrlTTL <= 0 {
+		Storage.SignedUrlTTL = 60 * 60
+	}
+
+	if Storage.Local.Root == "" {
+		Storage.Local.Root = "data/storage"
+	}
+
+	if Storage.S3.Region == "" {
+		Storage.S3.Region = "us-east-1"
+	}
+}
+
+func setUploadDefault() {
+	if Upload.MaxSize <= 0 {
+		// 10GiB
+		Upload.MaxSize = 10 << 30
+	}
+
+	if Upload.ChunkSize <= 0 {
+		// 8MiB
+		Upload.ChunkSize = 8 << 20
+	}
+
+	// S3 는 마지막을 뺀 조각이 5MiB 이상이어야 청크를 그대로 조각으로 합칠 수 있음
+	if Storage.Type == "s3" && Upload.ChunkSize < 5<<20 {
+		Upload.ChunkSize = 5 << 20
+	}
+
+	if len(Upload.AllowedTypes) == 0 {
+		Upload.AllowedTypes = []string{"video/*"}
+	}
+}
+
+func setSLADefault() {
+	if SLA.MonitorInterval <= 0 {
+		SLA.MonitorInterval = 5 * 60
+	}
+
+	if SLA.AtRiskHours <= 0 {
+		SLA.AtRiskHours = 24
+	}
+
+	if SLA.StateThresholdHours == nil {
+		SLA.StateThresholdHours = map[string]int64{
+			"DEFAULT":        24,
+			"TAKE":           48,
+			"THUMBNAIL_TAKE": 48,
+			"NONE":           72,
+			"REQUEST_EDIT":   48,
+			"EDIT_DONE":      72,
+		}
+	}
+}
+
+func setAssignmentDefault() {
+	if Assignment.DefaultMaxConcurrentOrder == 0 {
+		Assignment.DefaultMaxConcurrentOrder = 5
+	}
+
+	// 한국 표준시
+	if Assignment.UTCOffsetMinutes == nil {
+		offset := 9 * 60
+		Assignment.UTCOffsetMinutes = &offset
+	}
+}
+
+func setPriorityDefault() {
+	if Priority.DueDays == nil {
+		Priority.DueDays = map[string]int{
+			"NORMAL": 7,
+			"HIGH":   5,
+			"RUSH":   2,
+		}
+	}
+
+	if Priority.ExtraOrderCount == nil {
+		Priority.ExtraOrderCount = map[string]uint8{
+			"NORMAL": 0,
+			"HIGH":   0,
+			"RUSH":   1,
+		}
+	}
+}
+
+func setRatingDefault() {
+	if Rating.WindowDays <= 0 {
+		Rating.WindowDays = 14
+	}
+
+	if Rating.LowScore == 0 {
+		Rating.LowScore = 2
+	}
+
+	if Rating.TrendDays <= 0 {
+		Rating.TrendDays = 30
+	}
+}
+
+func setAutoCompleteDefault() {
+	if AutoComplete.Interval <= 0 {
+		AutoComplete.Interval = 60 * 60
+	}
+
+	if AutoComplete.Days <= 0 {
+		AutoComplete.Days = 7
+	}
+
+	if AutoComplete.ReminderDays <= 0 {
+		AutoComplete.ReminderDays = 2
+	}
+}
+
+func setScheduleDefault() {
+	if Schedule.Interval <= 0 {
+		Schedule.Interval = 5 * 60
+	}
+
+	// 한국 표준시
+	if Schedule.UTCOffsetMinutes == nil {
+		offset := 9 * 60
+		Schedule.UTCOffsetMinutes = &offset
+	}
+}
+
+func setExportDefault() {
+	if Export.Timeout <= 0 {
+		Export.Timeout = 10 * 60
+	}
+
+	// 한국 표준시
+	if Export.UTCOffsetMinutes == nil {
+		offset := 9 * 60
+		Export.UTCOffsetMinutes = &offset
+	}
+}
+
+func setSeedDefault() {
+	if Seed.OnStartup == nil {
+		onStartup := true
+		Seed.OnStartup = &onStartup
 	}
 }
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 var c struct {
 	DB struct {
 		User string `json:"user"`
@@ -14,4 +16,138 @@ var c struct {
 	JWT struct {
 		Secret string `json:"secret"`
 	} `json:"jwt"`
+
+	Storage StorageConfig `json:"storage"`
+	Upload  UploadConfig  `json:"upload"`
+	SLA     SLAConfig     `json:"sla"`
+
+	Assignment AssignmentConfig `json:"assignment"`
+	Priority   PriorityConfig   `json:"priority"`
+	Rating     RatingConfig     `json:"rating"`
+
+	AutoComplete AutoCompleteConfig `json:"auto_complete"`
+	Schedule     ScheduleConfig     `json:"schedule"`
+	Export       ExportConfig       `json:"export"`
+	Seed         SeedConfig         `json:"seed"`
+}
+
+type StorageConfig struct {
+	// Type "local" 또는 "s3"
+	Type string `json:"type"`
+
+	// SignSecret 서명 URL 생성용 비밀키, 비어있으면 JWT secret 사용
+	SignSecret string `json:"sign_secret"`
+
+	// SignedUrlTTL 서명 URL 유효 시간 (초)
+	SignedUrlTTL int64 `json:"signed_url_ttl"`
+
+	Local struct {
+		Root      string `json:"root"`
+		PublicUrl string `json:"public_url"`
+	} `json:"local"`
+
+	S3 struct {
+		Endpoint  string `json:"endpoint"`
+		Region    string `json:"region"`
+		Bucket    string `json:"bucket"`
+		AccessKey string `json:"access_key"`
+		SecretKey string `json:"secret_key"`
+		PathStyle bool   `json:"path_style"`
+	} `json:"s3"`
+}
+
+type UploadConfig struct {
+	// MaxSize 업로드 파일 최대 크기 (byte)
+	MaxSize int64 `json:"max_size"`
+
+	// ChunkSize 업로드 청크 크기 (byte)
+	ChunkSize int64 `json:"chunk_size"`
+
+	// AllowedTypes 허용 Content-Type, "video/*" 처럼 와일드카드 가능
+	AllowedTypes []string `json:"allowed_types"`
+}
+
+type SLAConfig struct {
+	// MonitorInterval SLA 검사 주기 (초)
+	MonitorInterval int64 `json:"monitor_interval"`
+
+	// AtRiskHours 마감까지 남은 시간이 이 시간 이하면 위험으로 표시
+	AtRiskHours int64 `json:"at_risk_hours"`
+
+	// StateThresholdHours 상태 코드(OrderStateCode)별로 머무를 수 있는 최대 시간, 넘으면 최고 관리자에게 알림
+	StateThresholdHours map[string]int64 `json:"state_threshold_hours"`
+}
+
+type AssignmentConfig struct {
+	// DefaultMaxConcurrentOrder 편집자가 따로 설정하지 않았을 때 동시에 맡을 수 있는 최대 의뢰 수
+	DefaultMaxConcurrentOrder uint8 `json:"default_max_concurrent_order"`
+
+	// UTCOffsetMinutes 편집자 근무 시간의 요일, 시각을 해석할 시간대, UTC 기준 분
+	UTCOffsetMinutes *int `json:"utc_offset_minutes"`
+}
+
+// Location 편집자 근무 시간의 시간대
+func (a AssignmentConfig) Location() *time.Location {
+	return time.FixedZone("", *a.UTCOffsetMinutes*60)
+}
+
+type PriorityConfig struct {
+	// DueDays 우선순위(OrderPriority)별 기본 마감일, 의뢰일로부터 며칠, 0 이면 정하지 않음
+	DueDays map[string]int `json:"due_days"`
+
+	// ExtraOrderCount 고객이 우선순위를 직접 고를 때 추가로 차감할 의뢰 횟수
+	ExtraOrderCount map[string]uint8 `json:"extra_order_count"`
+}
+
+type RatingConfig struct {
+	// WindowDays 의뢰 완료 후 평가할 수 있는 기간(일)
+	WindowDays int `json:"window_days"`
+
+	// LowScore 이 점수 이하면 최고 관리자에게 알림
+	LowScore uint8 `json:"low_score"`
+
+	// TrendDays 편집자 점수 추세를 비교할 기간(일), 최근 기간과 그 전 기간의 평균 차이
+	TrendDays int `json:"trend_days"`
+}
+
+type AutoCompleteConfig struct {
+	// Interval 자동 완료 검사 주기 (초)
+	Interval int64 `json:"interval"`
+
+	// Days 결과물을 받은 뒤 고객이 이 기간(일) 동안 아무것도 하지 않으면 자동 완료
+	Days int `json:"days"`
+
+	// ReminderDays 자동 완료 이 기간(일) 전에 고객에게 알림
+	ReminderDays int `json:"reminder_days"`
+}
+
+type ScheduleConfig struct {
+	// Interval 정기 의뢰 일정 검사 주기 (초)
+	Interval int64 `json:"interval"`
+
+	// UTCOffsetMinutes 정기 의뢰 일정의 요일, 시각을 해석할 시간대, UTC 기준 분
+	UTCOffsetMinutes *int `json:"utc_offset_minutes"`
+}
+
+// Location 정기 의뢰 일정의 시간대
+func (s ScheduleConfig) Location() *time.Location {
+	return time.FixedZone("", *s.UTCOffsetMinutes*60)
+}
+
+type ExportConfig struct {
+	// Timeout 의뢰 내보내기 제한 시간 (초)
+	Timeout int64 `json:"timeout"`
+
+	// UTCOffsetMinutes 내보낸 파일의 날짜, 시각을 표시할 시간대, UTC 기준 분
+	UTCOffsetMinutes *int `json:"utc_offset_minutes"`
+}
+
+// Location 내보낸 파일의 시간대
+func (e ExportConfig) Location() *time.Location {
+	return time.FixedZone("", *e.UTCOffsetMinutes*60)
+}
+
+type SeedConfig struct {
+	// OnStartup 서버 시작할 때 적용되지 않은 기준 데이터를 넣을지 여부, 끄면 `go run . seed` 로 따로 실행
+	OnStartup *bool `json:"on_startup"`
 }
@@ -4,6 +4,8 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+	"github.com/stockfolioofficial/back-editfolio/domain"
 )
 
 type echoBindWithValidate struct {
@@ -31,10 +33,26 @@ func (e *echoValidator) Validate(i interface{}) error {
 	return e.v.Struct(&wrapper)
 }
 
+// echoJSONSerializer 에러 응답 메시지를 요청 언어로 바꿔서 내보냄
+type echoJSONSerializer struct {
+	echo.DefaultJSONSerializer
+}
+
+func (e echoJSONSerializer) Serialize(c echo.Context, i interface{}, indent string) error {
+	if res, ok := i.(domain.ErrorResponse); ok {
+		if key, ok := errorMessageKeys[res.Message]; ok {
+			res.Message = i18n.T(c.Request().Context(), key)
+			i = res
+		}
+	}
+	return e.DefaultJSONSerializer.Serialize(c, i, indent)
+}
+
 func NewEcho() (e *echo.Echo) {
 	e = echo.New()
 	e.Binder = &echoBindWithValidate{}
 	e.Validator = &echoValidator{v: newValidator()}
+	e.JSONSerializer = echoJSONSerializer{}
 	return
 }
 
@@ -46,7 +64,10 @@ func NewMiddleware() (m middlewares) {
 		AllowOrigins: []string{"*"},
 		AllowHeaders: []string{"*"},
 		AllowMethods: []string{"*"},
+		// 낙관적 동시성 제어용 버전, 수정 요청 시 If-Match 로 돌려받음
+		ExposeHeaders: []string{"ETag", "Content-Language"},
 	}))
+	m = append(m, i18n.Middleware())
 	m = append(m, middleware.Recover())
 	return
 }
@@ -0,0 +1,62 @@
+package di
+
+import (
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+// errorMessageKeys 에러 응답 메시지의 카탈로그 키, 에러 값의 문구에서 만들므로 문구가 바뀌어도 번역이 빠지지 않음
+// 여기 없는 메시지(검증 오류 등)는 번역하지 않고 그대로 내보냄
+var errorMessageKeys = map[string]string{
+	domain.InvalidateTokenResponse.Message:     i18n.ErrorUnauthorized,
+	domain.ServerInternalErrorResponse.Message: i18n.ErrorServerInternal,
+	domain.EmailExistsResponse.Message:         i18n.ErrorEmailExists,
+	domain.ErrItemNotFound.Error():             i18n.ErrorItemNotFound,
+	domain.ErrItemAlreadyExist.Error():         i18n.ErrorItemAlreadyExist,
+	domain.ErrVersionConflict.Error():          i18n.ErrorVersionConflict,
+	domain.ErrWeirdData.Error():                i18n.ErrorWeirdData,
+	domain.ErrNoPermission.Error():             i18n.ErrorNoPermission,
+	domain.ErrUserWrongPassword.Error():        i18n.ErrorWrongPassword,
+	domain.ErrUserNotCustomer.Error():          i18n.ErrorNotCustomer,
+	domain.ErrNoActiveTicket.Error():           i18n.ErrorNoActiveTicket,
+	domain.ErrNotEnoughOrderCount.Error():      i18n.ErrorNotEnoughOrderCount,
+	domain.ErrPriorityNotAllowed.Error():       i18n.ErrorPriorityNotAllowed,
+	domain.ErrEditorUnavailable.Error():        i18n.ErrorEditorUnavailable,
+	domain.ErrOrderClosed.Error():              i18n.ErrorOrderClosed,
+	domain.ErrOrderNotAssigned.Error():         i18n.ErrorOrderNotAssigned,
+	domain.ErrRatingClosed.Error():             i18n.ErrorRatingClosed,
+	domain.ErrOrderStateInUse.Error():          i18n.ErrorOrderStateInUse,
+	domain.ErrSystemOrderState.Error():         i18n.ErrorSystemOrderState,
+	domain.ErrNotApprovedDelivery.Error():      i18n.ErrorNotApprovedDelivery,
+	domain.ErrNotAllowedUpload.Error():         i18n.ErrorNotAllowedUpload,
+	domain.ErrChecksumMismatch.Error():         i18n.ErrorChecksumMismatch,
+	domain.ErrIncompleteUpload.Error():         i18n.ErrorIncompleteUpload,
+	domain.ErrInvalidFeedback.Error():          i18n.ErrorInvalidFeedback,
+	domain.ErrInvalidCursor.Error():            i18n.ErrorInvalidCursor,
+	domain.ErrInvalidReference.Error():         i18n.ErrorInvalidReference,
+	"already assigned order":                   i18n.ErrorAlreadyAssignedOrder,
+	"already completed upload":                 i18n.ErrorAlreadyCompletedUpload,
+	"already rated":                            i18n.ErrorAlreadyRated,
+	"already requested edit":                   i18n.ErrorAlreadyRequestedEdit,
+	"already resolved escalation":              i18n.ErrorAlreadyResolvedEscalation,
+	"already resolved feedback":                i18n.ErrorAlreadyResolvedFeedback,
+	"already reviewed delivery":                i18n.ErrorAlreadyReviewedDelivery,
+	"assign conflict":                          i18n.ErrorAssignConflict,
+	"content length required":                  i18n.ErrorContentLengthRequired,
+	"empty remaining edit count":               i18n.ErrorEmptyRemainingEditCount,
+	"end date before start date":               i18n.ErrorEndBeforeStart,
+	"invalid chunk index or size":              i18n.ErrorInvalidChunk,
+	"invalid feedback timecode range":          i18n.ErrorInvalidFeedbackRange,
+	"invalid parent state":                     i18n.ErrorInvalidParentState,
+	"invalid root state":                       i18n.ErrorInvalidRootState,
+	"invalid working hours":                    i18n.ErrorInvalidWorkingHours,
+	"missing chunks":                           i18n.ErrorMissingChunks,
+	"not allowed file size or type":            i18n.ErrorNotAllowedFile,
+	"not assigned order":                       i18n.ErrorNotAssignedOrder,
+	"not completed upload":                     i18n.ErrorNotCompletedUpload,
+	"not exists order":                         i18n.ErrorNotExistsOrder,
+	"order type not found":                     i18n.ErrorOrderTypeNotFound,
+	"pending delivery exists":                  i18n.ErrorPendingDelivery,
+	"unknown strategy":                         i18n.ErrorUnknownStrategy,
+	"unsupported language":                     i18n.ErrorUnsupportedLanguage,
+}
@@ -0,0 +1,18 @@
+package di
+
+import (
+	"testing"
+
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+)
+
+// 에러 응답 메시지 키는 모든 언어 카탈로그에 있어야 함
+func TestErrorMessageKeys(t *testing.T) {
+	for message, key := range errorMessageKeys {
+		for _, lang := range i18n.Supported {
+			if i18n.Text(lang, key) == key {
+				t.Errorf("%s catalog missing %q for message %q", lang, key, message)
+			}
+		}
+	}
+}
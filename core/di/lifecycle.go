@@ -1,15 +1,30 @@
 package di
 
 import (
+	"context"
+	"time"
+
 	"github.com/labstack/echo/v4"
 	log "github.com/sirupsen/logrus"
 	"github.com/stockfolioofficial/back-editfolio/core/app"
 	"github.com/stockfolioofficial/back-editfolio/core/config"
 	"github.com/stockfolioofficial/back-editfolio/core/di/scope"
+	"github.com/stockfolioofficial/back-editfolio/core/scheduler"
+	"github.com/stockfolioofficial/back-editfolio/core/seed"
+	"github.com/stockfolioofficial/back-editfolio/domain"
 	"github.com/stockfolioofficial/back-editfolio/helloworld/handler"
+	handler11 "github.com/stockfolioofficial/back-editfolio/manager/handler"
 	handler3 "github.com/stockfolioofficial/back-editfolio/order/handler"
+	handler10 "github.com/stockfolioofficial/back-editfolio/orderAssignment/handler"
+	handler6 "github.com/stockfolioofficial/back-editfolio/orderDelivery/handler"
+	handler9 "github.com/stockfolioofficial/back-editfolio/orderEscalation/handler"
+	handler8 "github.com/stockfolioofficial/back-editfolio/orderMessage/handler"
+	handler13 "github.com/stockfolioofficial/back-editfolio/orderRating/handler"
+	handler14 "github.com/stockfolioofficial/back-editfolio/orderSchedule/handler"
 	handler4 "github.com/stockfolioofficial/back-editfolio/orderState/handler"
 	handler5 "github.com/stockfolioofficial/back-editfolio/orderTicket/handler"
+	handler12 "github.com/stockfolioofficial/back-editfolio/orderType/handler"
+	handler7 "github.com/stockfolioofficial/back-editfolio/orderUpload/handler"
 	handler2 "github.com/stockfolioofficial/back-editfolio/user/handler"
 )
 
@@ -21,6 +36,20 @@ func OnStart(
 	order *handler3.OrderController,
 	orderState *handler4.OrderStateController,
 	orderTicket *handler5.OrderTicketController,
+	orderDelivery *handler6.OrderDeliveryController,
+	orderUpload *handler7.OrderUploadController,
+	orderMessage *handler8.OrderMessageController,
+	orderEscalation *handler9.OrderEscalationController,
+	orderAssignment *handler10.OrderAssignmentController,
+	manager *handler11.ManagerController,
+	orderType *handler12.OrderTypeController,
+	orderRating *handler13.OrderRatingController,
+	orderSchedule *handler14.OrderScheduleController,
+	sch *scheduler.Scheduler,
+	seeder *seed.Seeder,
+	orderEscalationUseCase domain.OrderEscalationUseCase,
+	orderDeliveryUseCase domain.OrderDeliveryUseCase,
+	orderScheduleUseCase domain.OrderScheduleUseCase,
 ) app.OnStart {
 	return func() error {
 		logLevel := log.ErrorLevel
@@ -29,6 +58,14 @@ func OnStart(
 		}
 		log.SetLevel(logLevel)
 
+		// reference data
+		if *config.Seed.OnStartup {
+			_, err := seeder.Run(context.Background())
+			if err != nil {
+				return err
+			}
+		}
+
 		// global middleware set
 		e.Use(mw...)
 
@@ -40,7 +77,22 @@ func OnStart(
 			order,
 			orderState,
 			orderTicket,
+			orderDelivery,
+			orderUpload,
+			orderMessage,
+			orderEscalation,
+			orderAssignment,
+			manager,
+			orderType,
+			orderRating,
+			orderSchedule,
 		)
+
+		// background jobs
+		sch.Register("sla-monitor", time.Duration(config.SLA.MonitorInterval)*time.Second, orderEscalationUseCase.MonitorSLA)
+		sch.Register("order-auto-complete", time.Duration(config.AutoComplete.Interval)*time.Second, orderDeliveryUseCase.AutoCompleteInactive)
+		sch.Register("order-schedule", time.Duration(config.Schedule.Interval)*time.Second, orderScheduleUseCase.RunDueSchedules)
+		sch.Start()
 		return nil
 	}
 }
@@ -51,8 +103,8 @@ func bindEcho(e *echo.Echo, binders ...scope.EchoBinder) {
 	}
 }
 
-func OnClose() app.OnClose {
+func OnClose(sch *scheduler.Scheduler) app.OnClose {
 	return func() {
-
+		sch.Stop()
 	}
 }
@@ -4,19 +4,49 @@ import (
 	"github.com/google/wire"
 	"github.com/stockfolioofficial/back-editfolio/core/app"
 	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/core/scheduler"
+	"github.com/stockfolioofficial/back-editfolio/core/seed"
 	repository3 "github.com/stockfolioofficial/back-editfolio/customer/repository"
 	"github.com/stockfolioofficial/back-editfolio/domain"
 	"github.com/stockfolioofficial/back-editfolio/helloworld/handler"
+	handler11 "github.com/stockfolioofficial/back-editfolio/manager/handler"
 	repository2 "github.com/stockfolioofficial/back-editfolio/manager/repository"
+	usecase10 "github.com/stockfolioofficial/back-editfolio/manager/usecase"
+	adapter3 "github.com/stockfolioofficial/back-editfolio/notification/adapter"
 	handler3 "github.com/stockfolioofficial/back-editfolio/order/handler"
 	repository4 "github.com/stockfolioofficial/back-editfolio/order/repository"
 	usecase2 "github.com/stockfolioofficial/back-editfolio/order/usecase"
+	handler10 "github.com/stockfolioofficial/back-editfolio/orderAssignment/handler"
+	repository12 "github.com/stockfolioofficial/back-editfolio/orderAssignment/repository"
+	usecase9 "github.com/stockfolioofficial/back-editfolio/orderAssignment/usecase"
+	handler6 "github.com/stockfolioofficial/back-editfolio/orderDelivery/handler"
+	repository7 "github.com/stockfolioofficial/back-editfolio/orderDelivery/repository"
+	usecase5 "github.com/stockfolioofficial/back-editfolio/orderDelivery/usecase"
+	handler9 "github.com/stockfolioofficial/back-editfolio/orderEscalation/handler"
+	repository11 "github.com/stockfolioofficial/back-editfolio/orderEscalation/repository"
+	usecase8 "github.com/stockfolioofficial/back-editfolio/orderEscalation/usecase"
+	repository10 "github.com/stockfolioofficial/back-editfolio/orderFeedback/repository"
+	handler8 "github.com/stockfolioofficial/back-editfolio/orderMessage/handler"
+	repository9 "github.com/stockfolioofficial/back-editfolio/orderMessage/repository"
+	usecase7 "github.com/stockfolioofficial/back-editfolio/orderMessage/usecase"
+	handler13 "github.com/stockfolioofficial/back-editfolio/orderRating/handler"
+	repository14 "github.com/stockfolioofficial/back-editfolio/orderRating/repository"
+	usecase12 "github.com/stockfolioofficial/back-editfolio/orderRating/usecase"
+	handler14 "github.com/stockfolioofficial/back-editfolio/orderSchedule/handler"
+	repository15 "github.com/stockfolioofficial/back-editfolio/orderSchedule/repository"
+	usecase13 "github.com/stockfolioofficial/back-editfolio/orderSchedule/usecase"
 	handler4 "github.com/stockfolioofficial/back-editfolio/orderState/handler"
 	repository5 "github.com/stockfolioofficial/back-editfolio/orderState/repository"
 	usecase3 "github.com/stockfolioofficial/back-editfolio/orderState/usecase"
 	handler5 "github.com/stockfolioofficial/back-editfolio/orderTicket/handler"
 	repository6 "github.com/stockfolioofficial/back-editfolio/orderTicket/repository"
 	usecase4 "github.com/stockfolioofficial/back-editfolio/orderTicket/usecase"
+	handler12 "github.com/stockfolioofficial/back-editfolio/orderType/handler"
+	repository13 "github.com/stockfolioofficial/back-editfolio/orderType/repository"
+	usecase11 "github.com/stockfolioofficial/back-editfolio/orderType/usecase"
+	handler7 "github.com/stockfolioofficial/back-editfolio/orderUpload/handler"
+	repository8 "github.com/stockfolioofficial/back-editfolio/orderUpload/repository"
+	usecase6 "github.com/stockfolioofficial/back-editfolio/orderUpload/usecase"
 	"github.com/stockfolioofficial/back-editfolio/user/adapter"
 	handler2 "github.com/stockfolioofficial/back-editfolio/user/handler"
 	"github.com/stockfolioofficial/back-editfolio/user/repository"
@@ -38,6 +68,8 @@ var infraSet = wire.NewSet(
 	NewEcho,
 	NewMiddleware,
 	NewDatabase,
+	scheduler.NewScheduler,
+	seed.NewSeeder,
 
 	// todo, 추후 별도로 config로 빼는게 좋을 듯
 	// useCase timeout 3min
@@ -46,6 +78,8 @@ var infraSet = wire.NewSet(
 
 var adapterSet = wire.NewSet(
 	wire.InterfaceValue(new(domain.TokenGenerateAdapter), adapter.NewTokenGenerateAdapter([]byte(config.JWTSecret))),
+	NewStorageAdapter,
+	wire.InterfaceValue(new(domain.NotificationAdapter), adapter3.NewLogNotificationAdapter()),
 )
 
 var repositorySet = wire.NewSet(
@@ -55,6 +89,15 @@ var repositorySet = wire.NewSet(
 	repository4.NewOrderRepository,
 	repository5.NewOrderStateRepository,
 	repository6.NewOrderTicketRepository,
+	repository7.NewOrderDeliveryRepository,
+	repository8.NewOrderUploadRepository,
+	repository9.NewOrderMessageRepository,
+	repository10.NewOrderFeedbackRepository,
+	repository11.NewOrderEscalationRepository,
+	repository12.NewOrderAssignmentRepository,
+	repository13.NewOrderTypeRepository,
+	repository14.NewOrderRatingRepository,
+	repository15.NewOrderScheduleRepository,
 )
 
 var useCaseSet = wire.NewSet(
@@ -62,6 +105,15 @@ var useCaseSet = wire.NewSet(
 	usecase2.NewOrderUseCase,
 	usecase3.NewOrderStateUseCase,
 	usecase4.NewOrderTicketUseCase,
+	usecase5.NewOrderDeliveryUseCase,
+	usecase6.NewOrderUploadUseCase,
+	usecase7.NewOrderMessageUseCase,
+	usecase8.NewOrderEscalationUseCase,
+	usecase9.NewOrderAssignmentUseCase,
+	usecase10.NewManagerUseCase,
+	usecase11.NewOrderTypeUseCase,
+	usecase12.NewOrderRatingUseCase,
+	usecase13.NewOrderScheduleUseCase,
 )
 
 var controllerSet = wire.NewSet(
@@ -70,6 +122,15 @@ var controllerSet = wire.NewSet(
 	handler3.NewOrderController,
 	handler4.NewOrderStateController,
 	handler5.NewOrderTicketController,
+	handler6.NewOrderDeliveryController,
+	handler7.NewOrderUploadController,
+	handler8.NewOrderMessageController,
+	handler9.NewOrderEscalationController,
+	handler10.NewOrderAssignmentController,
+	handler11.NewManagerController,
+	handler12.NewOrderTypeController,
+	handler13.NewOrderRatingController,
+	handler14.NewOrderScheduleController,
 )
 
 var lifecycleSet = wire.NewSet(
@@ -0,0 +1,12 @@
+package di
+
+import (
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/storage/adapter"
+)
+
+// NewStorageAdapter 저장소 설정이 잘못되면 panic 대신 에러로 앱 생성을 멈춤
+func NewStorageAdapter() (domain.StorageAdapter, error) {
+	return adapter.NewStorageAdapter(config.Storage)
+}
@@ -0,0 +1,96 @@
+package i18n
+
+var en = map[string]string{
+	Unknown:           "Unknown",
+	UnknownOrderState: "Unknown state",
+	UnknownEditor:     "Unknown editor",
+
+	AutoCompleteReminderTitle:   "Order will be completed automatically",
+	AutoCompleteReminderMessage: "Order %s will be completed automatically at %s unless you review the delivery.",
+	AutoCompleteTitle:           "Order completed automatically",
+	AutoCompleteMessage:         "Order %s was completed automatically after %d days without review.",
+
+	ScheduleTicketEndedTitle:   "Recurring order schedule paused",
+	ScheduleTicketEndedMessage: "Your recurring order schedule was paused because your subscription ended. Resume the schedule after renewing your subscription.",
+	ScheduleDueTitle:           "Recurring order upload reminder",
+	ScheduleDueMessage:         "Today is your recurring order day. Upload the source video and review draft order %s.",
+
+	EscalationSLATitle:       "Order SLA breach",
+	EscalationLowRatingTitle: "Order low rating",
+	EscalationMessage:        "Order %s, reason %s, state %s, since %s",
+
+	AssignmentReasonRoundRobinNext:  "Round robin, next after previous editor (%s)",
+	AssignmentReasonRoundRobinFirst: "Round robin, first in order",
+	AssignmentReasonLeastLoaded:     "Fewest active orders, %s (max %s)",
+	AssignmentReasonNoSkillMatch:    "No matching skill keywords, fewest active orders, %s (max %s)",
+	AssignmentReasonSkillMatch:      "%s skill keywords matched (%s), %s active orders",
+
+	ExportOrderId:          "Order ID",
+	ExportOrderedAt:        "Ordered at",
+	ExportCustomerName:     "Customer name",
+	ExportCustomerEmail:    "Customer email",
+	ExportChannelName:      "Channel name",
+	ExportAssigneeName:     "Editor name",
+	ExportAssigneeNickname: "Editor nickname",
+	ExportState:            "State",
+	ExportPriority:         "Priority",
+	ExportType:             "Order type",
+	ExportDueDate:          "Due date",
+	ExportDoneAt:           "Done at",
+	ExportCanceledAt:       "Canceled at",
+	ExportUsedEditCount:    "Used edit count",
+	ExportTotalEditCount:   "Total edit count",
+	ExportTicketExOrderId:  "Ticket order number",
+	// 에러 메시지
+	ErrorUnauthorized:              "Unauthorized.",
+	ErrorServerInternal:            "Internal server error.",
+	ErrorEmailExists:               "Email already in use.",
+	ErrorItemNotFound:              "Not found.",
+	ErrorItemAlreadyExist:          "Already exists.",
+	ErrorVersionConflict:           "Modified by someone else. Reload and try again.",
+	ErrorWeirdData:                 "Invalid request.",
+	ErrorNoPermission:              "No permission.",
+	ErrorWrongPassword:             "Wrong password.",
+	ErrorNotCustomer:               "Not a customer.",
+	ErrorNoActiveTicket:            "No active subscription ticket.",
+	ErrorNotEnoughOrderCount:       "Not enough remaining orders.",
+	ErrorPriorityNotAllowed:        "Priority not allowed by your plan.",
+	ErrorEditorUnavailable:         "Editor is away or over capacity.",
+	ErrorOrderClosed:               "Order already done or canceled.",
+	ErrorOrderNotAssigned:          "Order not assigned to an editor.",
+	ErrorRatingClosed:              "Order not done or rating window closed.",
+	ErrorOrderStateInUse:           "Order state in use.",
+	ErrorSystemOrderState:          "System order states cannot be changed.",
+	ErrorNotApprovedDelivery:       "Delivery not approved.",
+	ErrorNotAllowedUpload:          "File not allowed.",
+	ErrorChecksumMismatch:          "File checksum mismatch.",
+	ErrorIncompleteUpload:          "Upload not completed.",
+	ErrorInvalidFeedback:           "Invalid feedback.",
+	ErrorInvalidCursor:             "Invalid cursor.",
+	ErrorInvalidReference:          "Invalid reference link.",
+	ErrorAlreadyAssignedOrder:      "Order already assigned.",
+	ErrorAlreadyCompletedUpload:    "Upload already completed.",
+	ErrorAlreadyRated:              "Order already rated.",
+	ErrorAlreadyRequestedEdit:      "Edit already requested.",
+	ErrorAlreadyResolvedEscalation: "Escalation already resolved.",
+	ErrorAlreadyResolvedFeedback:   "Feedback already resolved.",
+	ErrorAlreadyReviewedDelivery:   "Delivery already reviewed.",
+	ErrorAssignConflict:            "Assigned by someone else first.",
+	ErrorContentLengthRequired:     "Content-Length header required.",
+	ErrorEmptyRemainingEditCount:   "No remaining edits.",
+	ErrorEndBeforeStart:            "End date is before start date.",
+	ErrorInvalidChunk:              "Invalid chunk index or size.",
+	ErrorInvalidFeedbackRange:      "Invalid feedback timecode range.",
+	ErrorInvalidParentState:        "Invalid parent state.",
+	ErrorInvalidRootState:          "Invalid root state.",
+	ErrorInvalidWorkingHours:       "Invalid working hours.",
+	ErrorMissingChunks:             "Missing chunks.",
+	ErrorNotAllowedFile:            "File size or type not allowed.",
+	ErrorNotAssignedOrder:          "Order not assigned to an editor.",
+	ErrorNotCompletedUpload:        "Upload not completed.",
+	ErrorNotExistsOrder:            "Order not found.",
+	ErrorOrderTypeNotFound:         "Order type not found.",
+	ErrorPendingDelivery:           "A delivery is waiting for review.",
+	ErrorUnknownStrategy:           "Unknown assignment strategy.",
+	ErrorUnsupportedLanguage:       "Unsupported language.",
+}
@@ -0,0 +1,97 @@
+package i18n
+
+var ko = map[string]string{
+	Unknown:           "알 수 없음",
+	UnknownOrderState: "알 수 없는 상태",
+	UnknownEditor:     "알 수 없는 편집자",
+
+	AutoCompleteReminderTitle:   "의뢰 자동 완료 예정",
+	AutoCompleteReminderMessage: "의뢰 %s 결과물을 확인하지 않으면 %s 에 자동으로 완료됩니다.",
+	AutoCompleteTitle:           "의뢰 자동 완료",
+	AutoCompleteMessage:         "의뢰 %s 가 %d일 동안 확인되지 않아 자동으로 완료되었습니다.",
+
+	ScheduleTicketEndedTitle:   "정기 의뢰 일정 멈춤",
+	ScheduleTicketEndedMessage: "구독이 끝나서 정기 의뢰 일정이 멈췄습니다. 구독을 다시 시작한 뒤 일정을 재개해주세요.",
+	ScheduleDueTitle:           "정기 의뢰 업로드 알림",
+	ScheduleDueMessage:         "오늘은 정기 의뢰 날입니다. 원본 영상을 올리고 의뢰 초안 %s 을 확인해주세요.",
+
+	EscalationSLATitle:       "의뢰 SLA 위반",
+	EscalationLowRatingTitle: "의뢰 낮은 평점",
+	EscalationMessage:        "의뢰 %s, 사유 %s, 상태 %s, 기준 시각 %s",
+
+	AssignmentReasonRoundRobinNext:  "라운드 로빈, 이전 배정 편집자(%s) 다음 순서",
+	AssignmentReasonRoundRobinFirst: "라운드 로빈, 첫 번째 순서",
+	AssignmentReasonLeastLoaded:     "진행중인 의뢰 %s건으로 가장 적음 (최대 %s건)",
+	AssignmentReasonNoSkillMatch:    "일치하는 작업 키워드 없음, 진행중인 의뢰 %s건으로 가장 적음 (최대 %s건)",
+	AssignmentReasonSkillMatch:      "작업 키워드 %s개 일치 (%s), 진행중인 의뢰 %s건",
+
+	ExportOrderId:          "의뢰 아이디",
+	ExportOrderedAt:        "의뢰 일시",
+	ExportCustomerName:     "고객 이름",
+	ExportCustomerEmail:    "고객 이메일",
+	ExportChannelName:      "채널명",
+	ExportAssigneeName:     "담당 편집자 이름",
+	ExportAssigneeNickname: "담당 편집자 닉네임",
+	ExportState:            "상태",
+	ExportPriority:         "우선순위",
+	ExportType:             "의뢰 종류",
+	ExportDueDate:          "마감일",
+	ExportDoneAt:           "완료 일시",
+	ExportCanceledAt:       "취소 일시",
+	ExportUsedEditCount:    "사용한 수정 횟수",
+	ExportTotalEditCount:   "전체 수정 횟수",
+	ExportTicketExOrderId:  "티켓 주문 번호",
+
+	// 에러 메시지
+	ErrorUnauthorized:              "인증되지 않은 요청입니다.",
+	ErrorServerInternal:            "서버 내부 오류가 발생했습니다.",
+	ErrorEmailExists:               "이미 사용 중인 이메일입니다.",
+	ErrorItemNotFound:              "찾을 수 없습니다.",
+	ErrorItemAlreadyExist:          "이미 있습니다.",
+	ErrorVersionConflict:           "다른 곳에서 먼저 수정되었습니다. 새로 불러온 뒤 다시 시도해주세요.",
+	ErrorWeirdData:                 "잘못된 요청입니다.",
+	ErrorNoPermission:              "권한이 없습니다.",
+	ErrorWrongPassword:             "비밀번호가 틀렸습니다.",
+	ErrorNotCustomer:               "고객이 아닙니다.",
+	ErrorNoActiveTicket:            "사용 중인 구독 티켓이 없습니다.",
+	ErrorNotEnoughOrderCount:       "남은 의뢰 횟수가 부족합니다.",
+	ErrorPriorityNotAllowed:        "구독 플랜에서 고를 수 없는 우선순위입니다.",
+	ErrorEditorUnavailable:         "편집자가 자리를 비웠거나 맡을 수 있는 의뢰 수를 넘었습니다.",
+	ErrorOrderClosed:               "이미 완료되거나 취소된 의뢰입니다.",
+	ErrorOrderNotAssigned:          "편집자가 배정되지 않은 의뢰입니다.",
+	ErrorRatingClosed:              "완료되지 않았거나 평가 기간이 지난 의뢰입니다.",
+	ErrorOrderStateInUse:           "사용 중인 의뢰 상태입니다.",
+	ErrorSystemOrderState:          "시스템 의뢰 상태는 바꿀 수 없습니다.",
+	ErrorNotApprovedDelivery:       "승인되지 않은 결과물입니다.",
+	ErrorNotAllowedUpload:          "올릴 수 없는 파일입니다.",
+	ErrorChecksumMismatch:          "파일 체크섬이 맞지 않습니다.",
+	ErrorIncompleteUpload:          "업로드가 끝나지 않았습니다.",
+	ErrorInvalidFeedback:           "잘못된 피드백입니다.",
+	ErrorInvalidCursor:             "잘못된 커서입니다.",
+	ErrorInvalidReference:          "잘못된 참고 링크입니다.",
+	ErrorAlreadyAssignedOrder:      "이미 편집자가 배정된 의뢰입니다.",
+	ErrorAlreadyCompletedUpload:    "이미 끝난 업로드입니다.",
+	ErrorAlreadyRated:              "이미 평가한 의뢰입니다.",
+	ErrorAlreadyRequestedEdit:      "이미 수정을 요청한 의뢰입니다.",
+	ErrorAlreadyResolvedEscalation: "이미 처리된 에스컬레이션입니다.",
+	ErrorAlreadyResolvedFeedback:   "이미 처리된 피드백입니다.",
+	ErrorAlreadyReviewedDelivery:   "이미 검토한 결과물입니다.",
+	ErrorAssignConflict:            "다른 곳에서 먼저 배정되었습니다.",
+	ErrorContentLengthRequired:     "Content-Length 헤더가 필요합니다.",
+	ErrorEmptyRemainingEditCount:   "남은 수정 횟수가 없습니다.",
+	ErrorEndBeforeStart:            "종료일이 시작일보다 앞섭니다.",
+	ErrorInvalidChunk:              "잘못된 조각 번호나 크기입니다.",
+	ErrorInvalidFeedbackRange:      "잘못된 피드백 타임코드 범위입니다.",
+	ErrorInvalidParentState:        "잘못된 부모 상태입니다.",
+	ErrorInvalidRootState:          "잘못된 작업 시작 상태입니다.",
+	ErrorInvalidWorkingHours:       "잘못된 근무 시간입니다.",
+	ErrorMissingChunks:             "빠진 조각이 있습니다.",
+	ErrorNotAllowedFile:            "허용되지 않는 파일 크기나 형식입니다.",
+	ErrorNotAssignedOrder:          "편집자가 배정되지 않은 의뢰입니다.",
+	ErrorNotCompletedUpload:        "업로드가 끝나지 않았습니다.",
+	ErrorNotExistsOrder:            "없는 의뢰입니다.",
+	ErrorOrderTypeNotFound:         "없는 의뢰 종류입니다.",
+	ErrorPendingDelivery:           "검토 대기 중인 결과물이 있습니다.",
+	ErrorUnknownStrategy:           "알 수 없는 배정 방식입니다.",
+	ErrorUnsupportedLanguage:       "지원하지 않는 언어입니다.",
+}
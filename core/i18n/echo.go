@@ -0,0 +1,27 @@
+package i18n
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerAcceptLanguage  = "Accept-Language"
+	headerContentLanguage = "Content-Language"
+)
+
+// Middleware Accept-Language 로 고른 언어를 요청 context 에 넣고 Content-Language 로 알려줌
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			lang := Negotiate(ctx.Request().Header.Get(headerAcceptLanguage))
+
+			req := ctx.Request()
+			ctx.SetRequest(req.WithContext(WithLang(req.Context(), lang)))
+
+			header := ctx.Response().Header()
+			header.Set(headerContentLanguage, string(lang))
+			header.Add(echo.HeaderVary, headerAcceptLanguage)
+			return next(ctx)
+		}
+	}
+}
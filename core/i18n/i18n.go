@@ -0,0 +1,95 @@
+package i18n
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/text/language"
+)
+
+// Lang 응답 언어, Accept-Language 로 고름
+type Lang string
+
+const (
+	Korean  Lang = "ko"
+	English Lang = "en"
+
+	// Default Accept-Language 가 없거나 지원하지 않는 언어일 때, 의뢰 상태 같은 데이터의 기본 내용도 이 언어
+	Default = Korean
+)
+
+// Supported 앞에 있을수록 우선
+var Supported = []Lang{Korean, English}
+
+var matcher = language.NewMatcher([]language.Tag{language.Korean, language.English})
+
+var catalogs = map[Lang]map[string]string{
+	Korean:  ko,
+	English: en,
+}
+
+// Parse 지원하는 언어 코드인지 확인, 대소문자와 지역(en-US 등)은 구분하지 않음
+func Parse(s string) (lang Lang, ok bool) {
+	tag, err := language.Parse(s)
+	if err != nil {
+		return
+	}
+
+	base, _ := tag.Base()
+	for _, l := range Supported {
+		if string(l) == base.String() {
+			return l, true
+		}
+	}
+	return
+}
+
+// Negotiate Accept-Language 헤더 값에서 가장 알맞은 언어를 고름
+func Negotiate(acceptLanguage string) Lang {
+	if acceptLanguage == "" {
+		return Default
+	}
+
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return Default
+	}
+
+	_, index, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return Default
+	}
+	return Supported[index]
+}
+
+type langKey struct{}
+
+func WithLang(ctx context.Context, lang Lang) context.Context {
+	return context.WithValue(ctx, langKey{}, lang)
+}
+
+// FromContext 요청에서 고른 언어, 요청 밖(스케줄러 등)에서는 기본 언어
+func FromContext(ctx context.Context) Lang {
+	if lang, ok := ctx.Value(langKey{}).(Lang); ok {
+		return lang
+	}
+	return Default
+}
+
+// T 요청 언어로 된 문자열, args 가 있으면 fmt.Sprintf 형식으로 채움
+func T(ctx context.Context, key string, args ...interface{}) string {
+	return Text(FromContext(ctx), key, args...)
+}
+
+// Text 카탈로그에 없으면 키를 그대로 씀
+func Text(lang Lang, key string, args ...interface{}) string {
+	text, ok := catalogs[lang][key]
+	if !ok {
+		text = key
+	}
+
+	if len(args) > 0 {
+		return fmt.Sprintf(text, args...)
+	}
+	return text
+}
@@ -0,0 +1,158 @@
+package i18n
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s      string
+		want   Lang
+		wantOk bool
+	}{
+		{"ko", Korean, true},
+		{"KO", Korean, true},
+		{"ko-KR", Korean, true},
+		{"en", English, true},
+		{"en-US", English, true},
+		{"fr", "", false},
+		{"", "", false},
+		{"not a language", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := Parse(tt.s)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("Parse(%q) = %q, %v, want %q, %v", tt.s, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           Lang
+	}{
+		{"empty", "", Default},
+		{"korean", "ko-KR,ko;q=0.9", Korean},
+		{"english", "en-US,en;q=0.9", English},
+		{"english preferred", "en;q=0.9,ko;q=0.5", English},
+		{"korean preferred", "ko;q=0.9,en;q=0.5", Korean},
+		{"unsupported", "fr", Default},
+		{"unsupported then english", "fr,en;q=0.5", English},
+		{"wildcard", "*", Default},
+		{"broken header", "en;q=abc,,;", Default},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Negotiate(tt.acceptLanguage); got != tt.want {
+				t.Errorf("Negotiate(%q) = %q, want %q", tt.acceptLanguage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		name string
+		lang Lang
+		key  string
+		args []interface{}
+		want string
+	}{
+		{"korean", Korean, UnknownEditor, nil, "알 수 없는 편집자"},
+		{"english", English, UnknownEditor, nil, "Unknown editor"},
+		{"with args", English, AutoCompleteMessage, []interface{}{"abc", 3}, "Order abc was completed automatically after 3 days without review."},
+		{"error message in korean", Korean, ErrorInvalidCursor, nil, "잘못된 커서입니다."},
+		{"error message in english", English, ErrorInvalidCursor, nil, "Invalid cursor."},
+		{"missing key", Korean, "no.such.key", nil, "no.such.key"},
+		{"unsupported lang falls back to key", "fr", UnknownEditor, nil, UnknownEditor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Text(tt.lang, tt.key, tt.args...); got != tt.want {
+				t.Errorf("Text() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestT(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"no lang in context", context.Background(), "알 수 없음"},
+		{"english in context", WithLang(context.Background(), English), "Unknown"},
+		{"korean in context", WithLang(context.Background(), Korean), "알 수 없음"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := T(tt.ctx, Unknown); got != tt.want {
+				t.Errorf("T() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// 모든 문구는 모든 언어에 있어야 하고, 채우는 값 개수도 같아야 함
+func TestCatalogs(t *testing.T) {
+	for key, text := range catalogs[Default] {
+		for lang, catalog := range catalogs {
+			translated, ok := catalog[key]
+			if !ok {
+				t.Errorf("%s catalog missing %q", lang, key)
+				continue
+			}
+			if got, want := strings.Count(translated, "%"), strings.Count(text, "%"); got != want {
+				t.Errorf("%s catalog %q has %d verbs, want %d", lang, key, got, want)
+			}
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		want           Lang
+	}{
+		{"no header", "", Default},
+		{"english", "en-US", English},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.acceptLanguage != "" {
+				req.Header.Set(headerAcceptLanguage, tt.acceptLanguage)
+			}
+			rec := httptest.NewRecorder()
+
+			var got Lang
+			handler := Middleware()(func(ctx echo.Context) error {
+				got = FromContext(ctx.Request().Context())
+				return nil
+			})
+			if err := handler(echo.New().NewContext(req, rec)); err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+
+			if got != tt.want {
+				t.Errorf("FromContext() = %q, want %q", got, tt.want)
+			}
+			if lang := rec.Header().Get(headerContentLanguage); lang != string(tt.want) {
+				t.Errorf("Content-Language = %q, want %q", lang, tt.want)
+			}
+			if vary := rec.Header().Get(echo.HeaderVary); vary != headerAcceptLanguage {
+				t.Errorf("Vary = %q, want %q", vary, headerAcceptLanguage)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package i18n
+
+// 카탈로그 키
+const (
+	Unknown           = "unknown"
+	UnknownOrderState = "order_state.unknown"
+	UnknownEditor     = "editor.unknown"
+
+	AutoCompleteReminderTitle   = "notification.auto_complete_reminder.title"
+	AutoCompleteReminderMessage = "notification.auto_complete_reminder.message"
+	AutoCompleteTitle           = "notification.auto_complete.title"
+	AutoCompleteMessage         = "notification.auto_complete.message"
+
+	ScheduleTicketEndedTitle   = "notification.schedule_ticket_ended.title"
+	ScheduleTicketEndedMessage = "notification.schedule_ticket_ended.message"
+	ScheduleDueTitle           = "notification.schedule_due.title"
+	ScheduleDueMessage         = "notification.schedule_due.message"
+
+	EscalationSLATitle       = "notification.escalation_sla.title"
+	EscalationLowRatingTitle = "notification.escalation_low_rating.title"
+	EscalationMessage        = "notification.escalation.message"
+
+	AssignmentReasonRoundRobinNext  = "assignment_reason.round_robin_next"
+	AssignmentReasonRoundRobinFirst = "assignment_reason.round_robin_first"
+	AssignmentReasonLeastLoaded     = "assignment_reason.least_loaded"
+	AssignmentReasonNoSkillMatch    = "assignment_reason.no_skill_match"
+	AssignmentReasonSkillMatch      = "assignment_reason.skill_match"
+
+	ExportOrderId          = "export.order_id"
+	ExportOrderedAt        = "export.ordered_at"
+	ExportCustomerName     = "export.customer_name"
+	ExportCustomerEmail    = "export.customer_email"
+	ExportChannelName      = "export.channel_name"
+	ExportAssigneeName     = "export.assignee_name"
+	ExportAssigneeNickname = "export.assignee_nickname"
+	ExportState            = "export.state"
+	ExportPriority         = "export.priority"
+	ExportType             = "export.type"
+	ExportDueDate          = "export.due_date"
+	ExportDoneAt           = "export.done_at"
+	ExportCanceledAt       = "export.canceled_at"
+	ExportUsedEditCount    = "export.used_edit_count"
+	ExportTotalEditCount   = "export.total_edit_count"
+	ExportTicketExOrderId  = "export.ticket_ex_order_id"
+)
+
+// 에러 응답 메시지 키, core/di 에서 에러 응답의 Message 를 이 키로 찾아 번역함
+const (
+	ErrorUnauthorized              = "error.unauthorized"
+	ErrorServerInternal            = "error.server_internal"
+	ErrorEmailExists               = "error.email_exists"
+	ErrorItemNotFound              = "error.item_not_found"
+	ErrorItemAlreadyExist          = "error.item_already_exist"
+	ErrorVersionConflict           = "error.version_conflict"
+	ErrorWeirdData                 = "error.weird_data"
+	ErrorNoPermission              = "error.no_permission"
+	ErrorWrongPassword             = "error.wrong_password"
+	ErrorNotCustomer               = "error.not_customer"
+	ErrorNoActiveTicket            = "error.no_active_ticket"
+	ErrorNotEnoughOrderCount       = "error.not_enough_order_count"
+	ErrorPriorityNotAllowed        = "error.priority_not_allowed"
+	ErrorEditorUnavailable         = "error.editor_unavailable"
+	ErrorOrderClosed               = "error.order_closed"
+	ErrorOrderNotAssigned          = "error.order_not_assigned"
+	ErrorRatingClosed              = "error.rating_closed"
+	ErrorOrderStateInUse           = "error.order_state_in_use"
+	ErrorSystemOrderState          = "error.system_order_state"
+	ErrorNotApprovedDelivery       = "error.not_approved_delivery"
+	ErrorNotAllowedUpload          = "error.not_allowed_upload"
+	ErrorChecksumMismatch          = "error.checksum_mismatch"
+	ErrorIncompleteUpload          = "error.incomplete_upload"
+	ErrorInvalidFeedback           = "error.invalid_feedback"
+	ErrorInvalidCursor             = "error.invalid_cursor"
+	ErrorInvalidReference          = "error.invalid_reference"
+	ErrorAlreadyAssignedOrder      = "error.already_assigned_order"
+	ErrorAlreadyCompletedUpload    = "error.already_completed_upload"
+	ErrorAlreadyRated              = "error.already_rated"
+	ErrorAlreadyRequestedEdit      = "error.already_requested_edit"
+	ErrorAlreadyResolvedEscalation = "error.already_resolved_escalation"
+	ErrorAlreadyResolvedFeedback   = "error.already_resolved_feedback"
+	ErrorAlreadyReviewedDelivery   = "error.already_reviewed_delivery"
+	ErrorAssignConflict            = "error.assign_conflict"
+	ErrorContentLengthRequired     = "error.content_length_required"
+	ErrorEmptyRemainingEditCount   = "error.empty_remaining_edit_count"
+	ErrorEndBeforeStart            = "error.end_before_start"
+	ErrorInvalidChunk              = "error.invalid_chunk"
+	ErrorInvalidFeedbackRange      = "error.invalid_feedback_range"
+	ErrorInvalidParentState        = "error.invalid_parent_state"
+	ErrorInvalidRootState          = "error.invalid_root_state"
+	ErrorInvalidWorkingHours       = "error.invalid_working_hours"
+	ErrorMissingChunks             = "error.missing_chunks"
+	ErrorNotAllowedFile            = "error.not_allowed_file"
+	ErrorNotAssignedOrder          = "error.not_assigned_order"
+	ErrorNotCompletedUpload        = "error.not_completed_upload"
+	ErrorNotExistsOrder            = "error.not_exists_order"
+	ErrorOrderTypeNotFound         = "error.order_type_not_found"
+	ErrorPendingDelivery           = "error.pending_delivery"
+	ErrorUnknownStrategy           = "error.unknown_strategy"
+	ErrorUnsupportedLanguage       = "error.unsupported_language"
+)
@@ -0,0 +1,109 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	tag = "[SCHEDULER] "
+)
+
+// Job 주기적으로 실행할 작업, 같은 작업은 이전 실행이 끝나야 다음 실행이 시작됨
+type Job func(ctx context.Context) error
+
+type entry struct {
+	name     string
+	interval time.Duration
+	job      Job
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+type Scheduler struct {
+	mu      sync.Mutex
+	entries []entry
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// Register Start 전에 등록해야함
+func (s *Scheduler) Register(name string, interval time.Duration, job Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = append(s.entries, entry{
+		name:     name,
+		interval: interval,
+		job:      job,
+	})
+}
+
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cancel != nil {
+		return
+	}
+
+	var ctx context.Context
+	ctx, s.cancel = context.WithCancel(context.Background())
+	for i := range s.entries {
+		s.wg.Add(1)
+		go s.loop(ctx, s.entries[i])
+	}
+}
+
+// Stop 실행 중인 작업이 끝날 때까지 기다림
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.cancel = nil
+	s.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, e entry) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.run(ctx, e)
+		}
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, e entry) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.WithField("job", e.name).
+				WithField("panic", r).
+				Error(tag, "job panic")
+		}
+	}()
+
+	err := e.job(ctx)
+	if err != nil {
+		log.WithError(err).
+			WithField("job", e.name).
+			Error(tag, "job failed")
+	}
+}
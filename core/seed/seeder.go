@@ -0,0 +1,125 @@
+package seed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	tag = "[SEED] "
+)
+
+// Set 버전이 붙은 기준 데이터 묶음, 한 번 적용되면 seed_history 에 기록되고 다시 적용되지 않음,
+// 이미 적용된 Set 은 고치지 말고 새 버전을 추가해야함
+type Set struct {
+	Version uint
+	Name    string
+
+	// Models Apply 전에 AutoMigrate 할 모델, MySQL 은 트랜잭션 안에서 DDL 을 실행하면 커밋되므로 밖에서 미리 함
+	Models []interface{}
+
+	// Apply 트랜잭션 안에서 실행됨, 에러면 기록도 함께 롤백
+	Apply func(tx *gorm.DB) error
+}
+
+type history struct {
+	Version   uint      `gorm:"primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"size:100;not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (history) TableName() string {
+	return "seed_history"
+}
+
+func NewSeeder(db *gorm.DB) *Seeder {
+	return &Seeder{db: db, sets: sets}
+}
+
+type Seeder struct {
+	db   *gorm.DB
+	sets []Set
+}
+
+// Run 적용되지 않은 Set 을 버전 순으로 적용하고 이번에 적용한 버전을 돌려줌,
+// 여러 서버가 동시에 실행해도 기록을 먼저 넣은 쪽만 적용함
+func (s *Seeder) Run(ctx context.Context) (applied []uint, err error) {
+	list := make([]Set, len(s.sets))
+	copy(list, s.sets)
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	for i := 1; i < len(list); i++ {
+		if list[i].Version == list[i-1].Version {
+			err = fmt.Errorf("duplicate seed version %d", list[i].Version)
+			return
+		}
+	}
+
+	err = s.db.AutoMigrate(&history{})
+	if err != nil {
+		return
+	}
+
+	var done []uint
+	err = s.db.WithContext(ctx).Model(&history{}).Pluck("version", &done).Error
+	if err != nil {
+		return
+	}
+
+	doneSet := make(map[uint]bool, len(done))
+	for _, v := range done {
+		doneSet[v] = true
+	}
+
+	for _, set := range list {
+		if doneSet[set.Version] {
+			continue
+		}
+
+		var ok bool
+		ok, err = s.apply(ctx, set)
+		if err != nil {
+			err = fmt.Errorf("seed %d %s: %w", set.Version, set.Name, err)
+			return
+		}
+
+		if ok {
+			log.WithField("version", set.Version).Info(tag, "applied ", set.Name)
+			applied = append(applied, set.Version)
+		}
+	}
+	return
+}
+
+func (s *Seeder) apply(ctx context.Context, set Set) (ok bool, err error) {
+	if len(set.Models) > 0 {
+		err = s.db.AutoMigrate(set.Models...)
+		if err != nil {
+			return
+		}
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 기록을 먼저 넣어서 같은 버전을 동시에 적용하려는 쪽은 커밋을 기다렸다가 건너뜀
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&history{
+			Version:   set.Version,
+			Name:      set.Name,
+			AppliedAt: time.Now(),
+		})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+
+		ok = true
+		return set.Apply(tx)
+	})
+	if err != nil {
+		ok = false
+	}
+	return
+}
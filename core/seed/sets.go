@@ -0,0 +1,261 @@
+package seed
+
+import (
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// sets 새 기준 데이터는 여기에 다음 버전으로 추가
+var sets = []Set{
+	{
+		Version: 1,
+		Name:    "order state, order type",
+		Models:  []interface{}{&domain.OrderState{}, &domain.OrderType{}},
+		Apply: func(tx *gorm.DB) (err error) {
+			err = upsertOrderStates(tx, v1OrderStates)
+			if err != nil {
+				return
+			}
+			return upsertOrderTypes(tx, v1OrderTypes)
+		},
+	},
+	{
+		Version: 2,
+		Name:    "order state english",
+		Models:  []interface{}{&domain.OrderStateTranslation{}},
+		Apply: func(tx *gorm.DB) error {
+			return insertOrderStateTranslations(tx, v2OrderStateTranslations)
+		},
+	},
+}
+
+// upsertOrderStates 시스템 상태는 코드와 그룹으로 찾아서 없으면 넣고, 있으면 관리자가 바꿀 수 없는 부모만 맞춤,
+// 이름, 설명, 이모지, 정렬 순서는 관리자가 고쳤을 수 있으므로 건드리지 않음, NONE 상태는 아이디가 없을 때만 넣음
+func upsertOrderStates(tx *gorm.DB, states []domain.OrderState) error {
+	for i := range states {
+		state := states[i]
+		if !state.IsSystem() {
+			err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&state).Error
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		query := tx.Where("code = ?", state.Code)
+		if state.GroupId == nil {
+			query = query.Where("group_id IS NULL")
+		} else {
+			query = query.Where("group_id = ?", *state.GroupId)
+		}
+
+		var exists domain.OrderState
+		err := query.Take(&exists).Error
+		if err == gorm.ErrRecordNotFound {
+			err = tx.Create(&state).Error
+		} else if err == nil {
+			err = tx.Model(&exists).Updates(map[string]interface{}{
+				"parent_id": state.ParentId,
+				"active":    true,
+			}).Error
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// insertOrderStateTranslations 관리자가 넣은 번역이 있거나 상태가 지워졌으면 넘어감
+func insertOrderStateTranslations(tx *gorm.DB, translations []domain.OrderStateTranslation) error {
+	for i := range translations {
+		translation := translations[i]
+		var count int64
+		err := tx.Model(&domain.OrderState{}).Where("`id` = ?", translation.StateId).Count(&count).Error
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			continue
+		}
+
+		err = tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&translation).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// upsertOrderTypes 코드로 찾아서 없을 때만 넣음, 의뢰 종류는 관리자가 모든 값을 고칠 수 있음
+func upsertOrderTypes(tx *gorm.DB, types []domain.OrderType) error {
+	for i := range types {
+		orderType := types[i]
+		var exists domain.OrderType
+		err := tx.Where("code = ?", orderType.Code).Take(&exists).Error
+		if err == gorm.ErrRecordNotFound {
+			err = tx.Create(&orderType).Error
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var v1OrderStates = []domain.OrderState{
+	{
+		Id:          1,
+		Code:        domain.OrderStateCodeDefault,
+		Content:     "편집자 배정 중",
+		LongContent: "영상에 알맞는 편집자를\n 배정 중입니다.",
+		Emoji:       "🤔",
+	},
+	{
+		Id:          2,
+		Code:        domain.OrderStateCodeTake,
+		Content:     "영상 검토 중",
+		LongContent: "배정된 편집자가 영상을\n 열심히 확인하고 있어요",
+		Emoji:       "👀",
+		GroupId:     pointer.Uint8(1),
+	},
+	{
+		Id:          3,
+		Code:        domain.OrderStateCodeNone,
+		Content:     "편집 중",
+		LongContent: "영상을 이쁘게 자르고 붙이는 중...",
+		Emoji:       "😍",
+		ParentId:    pointer.Uint8(2),
+		GroupId:     pointer.Uint8(1),
+	},
+	{
+		Id:          4,
+		Code:        domain.OrderStateCodeNone,
+		Content:     "이펙트 추가 중",
+		LongContent: "아주 환상적인 이펙트를 입히는 중입니다.",
+		Emoji:       "🎇",
+		ParentId:    pointer.Uint8(2),
+		GroupId:     pointer.Uint8(1),
+	},
+	{
+		Id:          5,
+		Code:        domain.OrderStateCodeNone,
+		Content:     "완료",
+		LongContent: "영상편집이 완료되었습니다",
+		Emoji:       "😘",
+		ParentId:    pointer.Uint8(2),
+		GroupId:     pointer.Uint8(1),
+	},
+	{
+		Id:          6,
+		Code:        domain.OrderStateCodeDone,
+		LongContent: "영상편집이 완료되었습니다",
+		Emoji:       "😘",
+		Content:     "최종 완료",
+	},
+	{
+		Id:          7,
+		Code:        domain.OrderStateCodeRequestEdit,
+		Content:     "수정 중",
+		LongContent: "요청하신 수정사항을 작업중입니다.",
+		Emoji:       "🛠",
+		GroupId:     pointer.Uint8(2),
+	},
+	{
+		Id:          8,
+		Code:        domain.OrderStateCodeEditDone,
+		Content:     "수정 완료",
+		LongContent: "영상편집이 완료되었습니다",
+		Emoji:       "😘",
+		ParentId:    pointer.Uint8(7),
+		GroupId:     pointer.Uint8(2),
+	},
+	// 썸네일 의뢰 작업 상태
+	{
+		Id:          9,
+		Code:        domain.OrderStateCodeThumbnailTake,
+		Content:     "썸네일 시안 검토 중",
+		LongContent: "배정된 편집자가 썸네일 시안을\n 확인하고 있어요",
+		Emoji:       "👀",
+		GroupId:     pointer.Uint8(3),
+	},
+	{
+		Id:          10,
+		Code:        domain.OrderStateCodeNone,
+		Content:     "썸네일 제작 중",
+		LongContent: "눈길을 끄는 썸네일을 만드는 중...",
+		Emoji:       "🖼",
+		ParentId:    pointer.Uint8(9),
+		GroupId:     pointer.Uint8(3),
+	},
+	{
+		Id:          11,
+		Code:        domain.OrderStateCodeNone,
+		Content:     "완료",
+		LongContent: "썸네일 제작이 완료되었습니다",
+		Emoji:       "😘",
+		ParentId:    pointer.Uint8(9),
+		GroupId:     pointer.Uint8(3),
+	},
+	{
+		Id:          12,
+		Code:        domain.OrderStateCodeCanceled,
+		Content:     "취소",
+		LongContent: "의뢰가 취소되었습니다",
+		Emoji:       "🙅",
+	},
+}
+
+var v1OrderTypes = []domain.OrderType{
+	{
+		Id:               1,
+		Code:             domain.OrderTypeCodeShorts,
+		Name:             "숏폼",
+		Description:      "1분 이내 세로 영상",
+		DefaultEditCount: 2,
+		TurnaroundDays:   3,
+		CreditCost:       1,
+		RootStateId:      2,
+		Active:           true,
+	},
+	{
+		Id:               2,
+		Code:             domain.OrderTypeCodeLongForm,
+		Name:             "롱폼",
+		Description:      "일반 가로 영상",
+		DefaultEditCount: 0,
+		TurnaroundDays:   7,
+		CreditCost:       2,
+		RootStateId:      2,
+		Active:           true,
+	},
+	{
+		Id:               3,
+		Code:             domain.OrderTypeCodeThumbnail,
+		Name:             "썸네일",
+		Description:      "영상 썸네일 이미지",
+		DefaultEditCount: 3,
+		TurnaroundDays:   2,
+		CreditCost:       1,
+		RootStateId:      9,
+		Active:           true,
+	},
+}
+
+var v2OrderStateTranslations = []domain.OrderStateTranslation{
+	{StateId: 1, Lang: "en", Content: "Assigning editor", LongContent: "We are assigning\n the right editor for your video."},
+	{StateId: 2, Lang: "en", Content: "Reviewing video", LongContent: "Your editor is\n carefully reviewing the video"},
+	{StateId: 3, Lang: "en", Content: "Editing", LongContent: "Cutting and stitching your video..."},
+	{StateId: 4, Lang: "en", Content: "Adding effects", LongContent: "Adding some fantastic effects."},
+	{StateId: 5, Lang: "en", Content: "Done", LongContent: "Video editing is complete"},
+	{StateId: 6, Lang: "en", Content: "Completed", LongContent: "Video editing is complete"},
+	{StateId: 7, Lang: "en", Content: "Revising", LongContent: "Working on the changes you requested."},
+	{StateId: 8, Lang: "en", Content: "Revision done", LongContent: "Video editing is complete"},
+	{StateId: 9, Lang: "en", Content: "Reviewing thumbnail draft", LongContent: "Your editor is\n reviewing the thumbnail draft"},
+	{StateId: 10, Lang: "en", Content: "Making thumbnail", LongContent: "Creating an eye-catching thumbnail..."},
+	{StateId: 11, Lang: "en", Content: "Done", LongContent: "Thumbnail is complete"},
+	{StateId: 12, Lang: "en", Content: "Canceled", LongContent: "The order has been canceled"},
+}
@@ -24,10 +24,10 @@ func CreateCustomer(option CustomerCreateOption) Customer {
 
 type Customer struct {
 	Id           uuid.UUID `gorm:"type:char(36);primaryKey"`
-	Name         string    `gorm:"size:320;index;not null"`
-	ChannelName  string    `gorm:"size:100;index;not null"`
+	Name         string    `gorm:"size:320;index;index:idx_customer_search,class:FULLTEXT,option:WITH PARSER ngram;not null"`
+	ChannelName  string    `gorm:"size:100;index;index:idx_customer_search,class:FULLTEXT,option:WITH PARSER ngram;not null"`
 	ChannelLink  string    `gorm:"size:2048;not null"`
-	Email        string    `gorm:"size:320;index;not null"`
+	Email        string    `gorm:"size:320;index;index:idx_customer_search,class:FULLTEXT,option:WITH PARSER ngram;not null"`
 	Mobile       string    `gorm:"size:24;index;not null"`
 	PersonaLink  string    `gorm:"size:2048;not null"`
 	OnedriveLink string    `gorm:"size:2048;not null"`
@@ -13,11 +13,33 @@ var (
 
 	ErrNoPermission = errors.New("no permission")
 
-	ErrItemAlreadyExist = errors.New("item already exsits")
+	ErrItemAlreadyExist = errors.New("item already exists")
 
 	ErrUserNotCustomer = errors.New("not customer")
 	ErrWeirdData = errors.New("request weird data")
 
+	ErrNotApprovedDelivery = errors.New("not approved delivery")
+
+	ErrNotAllowedUpload = errors.New("not allowed upload")
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+	ErrIncompleteUpload = errors.New("incomplete upload")
+
+	ErrInvalidFeedback = errors.New("invalid feedback")
+
+	ErrEditorUnavailable = errors.New("editor over capacity or away")
+
+	ErrPriorityNotAllowed  = errors.New("priority not allowed by plan")
+	ErrNotEnoughOrderCount = errors.New("not enough order count")
+
+	// ErrNoActiveTicket 사용 중인 구독 티켓이 없음
+	ErrNoActiveTicket = errors.New("no active order ticket")
+
+	// ErrVersionConflict 불러온 뒤 다른 곳에서 먼저 수정함
+	ErrVersionConflict = errors.New("item modified by someone else")
+
+	// ErrOrderClosed 이미 완료되거나 취소된 의뢰
+	ErrOrderClosed = errors.New("order already done or canceled")
+
 	InvalidateTokenResponse = ErrorResponse{
 		ErrorCode: pointer.String("A-1"),
 		Message:   "unauthorized",
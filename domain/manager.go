@@ -2,6 +2,8 @@ package domain
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stockfolioofficial/back-editfolio/util/gormx"
@@ -23,23 +25,265 @@ func CreateManager(option ManagerCreateOption) Manager {
 
 type Manager struct {
 	Id       uuid.UUID `gorm:"type:char(36);primaryKey"`
-	Name     string    `gorm:"size:60;index;not null"`
-	Nickname string    `gorm:"size:60;index;not null"`
+	Name     string    `gorm:"size:60;index;index:idx_manager_search,class:FULLTEXT,option:WITH PARSER ngram;not null"`
+	Nickname string    `gorm:"size:60;index;index:idx_manager_search,class:FULLTEXT,option:WITH PARSER ngram;not null"`
+
+	// MaxConcurrentOrder 동시에 맡을 수 있는 최대 의뢰 수, 0 이면 기본값 사용
+	MaxConcurrentOrder uint8 `gorm:"not null"`
+
+	// Skills 잘하는 작업 키워드, 콤마(,)로 구분
+	Skills string `gorm:"size:500;not null"`
+
+	// Away 휴가 등으로 자동 배정에서 제외
+	Away bool `gorm:"not null"`
 }
 
 func (Manager) TableName() string {
 	return "manager"
 }
 
+func (m *Manager) SkillList() []string {
+	var list []string
+	for _, skill := range strings.Split(m.Skills, ",") {
+		skill = strings.TrimSpace(skill)
+		if len(skill) > 0 {
+			list = append(list, skill)
+		}
+	}
+	return list
+}
+
+func (m *Manager) UpdateSkills(skills []string) {
+	list := make([]string, 0, len(skills))
+	for i := range skills {
+		skill := strings.TrimSpace(strings.ReplaceAll(skills[i], ",", " "))
+		if len(skill) > 0 {
+			list = append(list, skill)
+		}
+	}
+	m.Skills = strings.Join(list, ",")
+}
+
+// ConcurrentOrderLimit 설정하지 않았으면 defaultLimit 사용
+func (m *Manager) ConcurrentOrderLimit(defaultLimit uint8) uint8 {
+	if m.MaxConcurrentOrder == 0 {
+		return defaultLimit
+	}
+	return m.MaxConcurrentOrder
+}
+
+// ManagerWorkingHour 요일별 근무 시간, 분 단위 (0 ~ 1440), Weekday 는 time.Weekday (0: 일요일)
+type ManagerWorkingHour struct {
+	ManagerId   uuid.UUID `gorm:"type:char(36);primaryKey"`
+	Weekday     uint8     `gorm:"primaryKey;autoIncrement:false"`
+	StartMinute uint16    `gorm:"not null"`
+	EndMinute   uint16    `gorm:"not null"`
+}
+
+func (ManagerWorkingHour) TableName() string {
+	return "manager_working_hour"
+}
+
+func (h ManagerWorkingHour) IsValid() bool {
+	return h.Weekday <= uint8(time.Saturday) &&
+		h.StartMinute < h.EndMinute &&
+		h.EndMinute <= 24*60
+}
+
+// IsValidManagerWorkingHours 요일이 겹치거나 시간이 잘못된 항목이 있으면 false
+func IsValidManagerWorkingHours(hours []ManagerWorkingHour) bool {
+	var seen [7]bool
+	for i := range hours {
+		if !hours[i].IsValid() || seen[hours[i].Weekday] {
+			return false
+		}
+		seen[hours[i].Weekday] = true
+	}
+	return true
+}
+
+// HasWorkingTime from ~ to 사이에 근무 시간이 조금이라도 있으면 true,
+// 근무 시간을 하나도 정하지 않은 편집자는 제한이 없는 것으로 보고 항상 true
+func HasWorkingTime(hours []ManagerWorkingHour, from, to time.Time, loc *time.Location) bool {
+	if len(hours) == 0 {
+		return true
+	}
+
+	var byWeekday [7]*ManagerWorkingHour
+	for i := range hours {
+		if hours[i].Weekday <= uint8(time.Saturday) {
+			byWeekday[hours[i].Weekday] = &hours[i]
+		}
+	}
+
+	from, to = from.In(loc), to.In(loc)
+	day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	// 일주일 넘게 봐도 같은 요일이 반복될 뿐이라 8일까지만 확인
+	for i := 0; i < 8 && !day.After(to); i++ {
+		if h := byWeekday[day.Weekday()]; h != nil {
+			start := day.Add(time.Duration(h.StartMinute) * time.Minute)
+			end := day.Add(time.Duration(h.EndMinute) * time.Minute)
+			if !start.After(to) && end.After(from) {
+				return true
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return false
+}
+
+type CreateManagerDayOffOption struct {
+	ManagerId uuid.UUID
+	StartDate time.Time
+	EndDate   time.Time
+	Reason    string
+}
+
+func CreateManagerDayOff(option CreateManagerDayOffOption) ManagerDayOff {
+	return ManagerDayOff{
+		Id:        uuid.New(),
+		ManagerId: option.ManagerId,
+		StartDate: TruncateDate(option.StartDate),
+		EndDate:   TruncateDate(option.EndDate),
+		Reason:    option.Reason,
+		CreatedAt: time.Now(),
+	}
+}
+
+// ManagerDayOff 휴가, 휴무일, StartDate ~ EndDate 양쪽 날짜 포함
+type ManagerDayOff struct {
+	Id        uuid.UUID `gorm:"type:char(36);primaryKey"`
+	ManagerId uuid.UUID `gorm:"type:char(36);index;not null"`
+	StartDate time.Time `gorm:"type:date;index;not null"`
+	EndDate   time.Time `gorm:"type:date;index;not null"`
+	Reason    string    `gorm:"size:200;not null"`
+	CreatedAt time.Time `gorm:"type:datetime(6);not null"`
+}
+
+func (ManagerDayOff) TableName() string {
+	return "manager_day_off"
+}
+
+func (d *ManagerDayOff) IsValidRange() bool {
+	return !d.EndDate.Before(d.StartDate)
+}
+
+// Overlaps from ~ to 날짜 사이에 하루라도 겹치면 true
+func (d *ManagerDayOff) Overlaps(from, to time.Time) bool {
+	return !d.StartDate.After(TruncateDate(to)) && !d.EndDate.Before(TruncateDate(from))
+}
+
+// TruncateDate date 타입 컬럼과 비교하기 위해 UTC 날짜만 남김
+func TruncateDate(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// FetchManagerDayOffOption From ~ To 와 겹치는 휴무일, ManagerIds 가 비어있으면 전체
+type FetchManagerDayOffOption struct {
+	ManagerIds []uuid.UUID
+	From       time.Time
+	To         time.Time
+}
+
 type ManagerRepository interface {
 	Save(ctx context.Context, manager *Manager) error
 	With(tx gormx.Tx) ManagerTxRepository
 
 	GetById(ctx context.Context, userId uuid.UUID) (*Manager, error)
 	FetchByIds(ctx context.Context, ids []uuid.UUID) ([]Manager, error)
+
+	// SaveWorkingHours 기존 근무 시간을 모두 지우고 새로 저장
+	SaveWorkingHours(ctx context.Context, managerId uuid.UUID, hours []ManagerWorkingHour) error
+	FetchWorkingHoursByManagerIds(ctx context.Context, ids []uuid.UUID) ([]ManagerWorkingHour, error)
+
+	SaveDayOff(ctx context.Context, dayOff *ManagerDayOff) error
+	DeleteDayOff(ctx context.Context, dayOff *ManagerDayOff) error
+	GetDayOffById(ctx context.Context, id uuid.UUID) (*ManagerDayOff, error)
+	FetchDayOff(ctx context.Context, option FetchManagerDayOffOption) ([]ManagerDayOff, error)
 }
 
 type ManagerTxRepository interface {
 	ManagerRepository
 	gormx.Tx
 }
+
+type GetEditorProfile struct {
+	UserId   uuid.UUID
+	EditorId uuid.UUID
+}
+
+type UpdateEditorWorkingHours struct {
+	UserId   uuid.UUID
+	EditorId uuid.UUID
+	Hours    []ManagerWorkingHourInfo
+}
+
+type AddEditorDayOff struct {
+	UserId    uuid.UUID
+	EditorId  uuid.UUID
+	StartDate time.Time
+	EndDate   time.Time
+	Reason    string
+}
+
+type DeleteEditorDayOff struct {
+	UserId   uuid.UUID
+	EditorId uuid.UUID
+	DayOffId uuid.UUID
+}
+
+type ManagerWorkingHourInfo struct {
+	Weekday     uint8
+	StartMinute uint16
+	EndMinute   uint16
+}
+
+type ManagerDayOffInfo struct {
+	DayOffId  uuid.UUID
+	StartDate time.Time
+	EndDate   time.Time
+	Reason    string
+}
+
+type EditorProfileInfo struct {
+	EditorId           uuid.UUID
+	Name               string
+	Nickname           string
+	MaxConcurrentOrder uint8
+	Skills             []string
+	Away               bool
+	WorkingHours       []ManagerWorkingHourInfo
+	DayOffs            []ManagerDayOffInfo
+}
+
+type EditorWorkloadOrderInfo struct {
+	OrderId    uuid.UUID
+	OrderedAt  time.Time
+	DueDate    *time.Time
+	OrderState uint8
+	Overdue    bool
+}
+
+type EditorWorkloadInfo struct {
+	EditorId uuid.UUID
+	Name     string
+	Nickname string
+
+	// ConcurrentOrderLimit 기본값이 적용된 최대 동시 의뢰 수
+	ConcurrentOrderLimit uint8
+
+	// Away 자동 배정 제외 중이거나 오늘 휴무
+	Away bool
+
+	Orders []EditorWorkloadOrderInfo
+}
+
+type ManagerUseCase interface {
+	GetEditorProfile(ctx context.Context, in GetEditorProfile) (EditorProfileInfo, error)
+	UpdateEditorWorkingHours(ctx context.Context, in UpdateEditorWorkingHours) error
+	AddEditorDayOff(ctx context.Context, in AddEditorDayOff) (uuid.UUID, error)
+	DeleteEditorDayOff(ctx context.Context, in DeleteEditorDayOff) error
+
+	FetchEditorWorkload(ctx context.Context) ([]EditorWorkloadInfo, error)
+}
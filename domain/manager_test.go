@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHasWorkingTime(t *testing.T) {
+	kst := time.FixedZone("", 9*60*60)
+	// 2021-11-01 월요일
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2021, 11, day, hour, minute, 0, 0, kst)
+	}
+	weekdays := []ManagerWorkingHour{
+		{Weekday: uint8(time.Monday), StartMinute: 9 * 60, EndMinute: 18 * 60},
+		{Weekday: uint8(time.Tuesday), StartMinute: 9 * 60, EndMinute: 18 * 60},
+		{Weekday: uint8(time.Wednesday), StartMinute: 9 * 60, EndMinute: 18 * 60},
+		{Weekday: uint8(time.Thursday), StartMinute: 9 * 60, EndMinute: 18 * 60},
+		{Weekday: uint8(time.Friday), StartMinute: 9 * 60, EndMinute: 18 * 60},
+	}
+
+	tests := []struct {
+		name     string
+		hours    []ManagerWorkingHour
+		from, to time.Time
+		loc      *time.Location
+		want     bool
+	}{
+		{"no hours means no limit", nil, at(6, 3, 0), at(6, 4, 0), kst, true},
+		{"inside shift", weekdays, at(1, 10, 0), at(1, 10, 0), kst, true},
+		{"before shift same day", weekdays, at(1, 7, 0), at(2, 0, 0), kst, true},
+		{"after shift until midnight", weekdays, at(1, 18, 0), at(2, 0, 0), kst, false},
+		{"after shift until next day", weekdays, at(1, 18, 0), at(2, 9, 0), kst, true},
+		{"weekend only", weekdays, at(6, 0, 0), at(8, 0, 0), kst, false},
+		{"weekend until monday", weekdays, at(6, 0, 0), at(8, 12, 0), kst, true},
+		{"long range", weekdays[:1], at(2, 0, 0), at(30, 0, 0), kst, true},
+		{"utc instant in kst shift", weekdays, time.Date(2021, 11, 1, 1, 0, 0, 0, time.UTC),
+			time.Date(2021, 11, 1, 1, 0, 0, 0, time.UTC), kst, true},
+		{"utc instant outside kst shift", weekdays, time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC),
+			time.Date(2021, 11, 1, 14, 0, 0, 0, time.UTC), kst, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasWorkingTime(tt.hours, tt.from, tt.to, tt.loc); got != tt.want {
+				t.Errorf("HasWorkingTime() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
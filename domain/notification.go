@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Notification struct {
+	UserIds []uuid.UUID
+	Title   string
+	Message string
+}
+
+// NotificationAdapter 사용자 알림 발송, 지금은 로그로만 남김
+type NotificationAdapter interface {
+	Notify(ctx context.Context, notification Notification) error
+}
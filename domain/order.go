@@ -8,43 +8,162 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"golang.org/x/sync/errgroup"
 )
 
+type OrderPriority string
+
+const (
+	OrderPriorityNormal OrderPriority = "NORMAL"
+	OrderPriorityHigh   OrderPriority = "HIGH"
+	OrderPriorityRush   OrderPriority = "RUSH"
+)
+
+func (p OrderPriority) IsValid() bool {
+	switch p {
+	case OrderPriorityNormal, OrderPriorityHigh, OrderPriorityRush:
+		return true
+	}
+	return false
+}
+
+// Rank 높을수록 급한 의뢰
+func (p OrderPriority) Rank() int {
+	switch p {
+	case OrderPriorityHigh:
+		return 1
+	case OrderPriorityRush:
+		return 2
+	}
+	return 0
+}
+
+// DefaultDueDate dueDays 가 0 이하면 마감일을 정하지 않음
+func DefaultDueDate(orderedAt time.Time, dueDays int) *time.Time {
+	if dueDays <= 0 {
+		return nil
+	}
+
+	dueDate := TruncateDate(orderedAt).AddDate(0, 0, dueDays)
+	return &dueDate
+}
+
 type CreateOrderOption struct {
 	Orderer     uuid.UUID
 	EditCount   uint8
 	State       uint8
 	Requirement *string
+	Priority    OrderPriority
+	TypeId      uint8
+
+	// ActiveSlot 동시 진행 자리 번호, 0 이면 자리를 차지하지 않음
+	ActiveSlot uint8
+
+	// TicketId 의뢰 횟수를 차감한 티켓
+	TicketId uuid.UUID
+
+	// UsedOrderCount 티켓에서 차감한 의뢰 횟수
+	UsedOrderCount uint8
+
+	// DueDays 기본 마감일, 의뢰일로부터 며칠
+	DueDays int
 }
 
 func CreateOrder(option CreateOrderOption) Order {
-	return Order{
+	now := time.Now()
+	priority := option.Priority
+	if !priority.IsValid() {
+		priority = OrderPriorityNormal
+	}
+
+	var activeSlot *uint8
+	if option.ActiveSlot > 0 {
+		activeSlot = pointer.Uint8(option.ActiveSlot)
+	}
+
+	order := Order{
 		Id:             uuid.New(),
-		OrderedAt:      time.Now(),
+		OrderedAt:      now,
 		Orderer:        option.Orderer,
 		TotalEditCount: option.EditCount,
 		State:          option.State,
+		StateChangedAt: &now,
 		Requirement:    option.Requirement,
+		Priority:       priority,
+		TypeId:         option.TypeId,
+		ActiveSlot:     activeSlot,
+		DueDate:        DefaultDueDate(now, option.DueDays),
 	}
+	if option.TicketId != uuid.Nil {
+		ticketId := option.TicketId
+		order.TicketId = &ticketId
+		order.UsedOrderCount = option.UsedOrderCount
+	}
+	order.recordState(now, OrderStateActorUser)
+	return order
 }
 
 type Order struct {
 	Id             uuid.UUID  `gorm:"type:char(36);primaryKey"`
 	OrderedAt      time.Time  `gorm:"type:datetime(6);index;not null"`
-	Orderer        uuid.UUID  `gorm:"type:char(36);index;not null"`
+	Orderer        uuid.UUID  `gorm:"type:char(36);index;uniqueIndex:idx_order_active_slot,priority:1;not null"`
 	EditCount      uint8      `gorm:"not null"`
 	TotalEditCount uint8      `gorm:"not null"`
 	State          uint8      `gorm:"not null"`
 	DueDate        *time.Time `gorm:"type:date"`
 	Assignee       *uuid.UUID `gorm:"type:char(36);index"`
-	Requirement    *string    `gorm:"size:2000"`
+	Requirement    *string    `gorm:"size:2000;index:idx_order_search,class:FULLTEXT,option:WITH PARSER ngram"`
 	DoneAt         *time.Time `gorm:"type:datetime(6);index"`
+
+	// TicketId 의뢰 횟수를 차감한 티켓, 티켓 기록 전에 접수된 의뢰는 nil
+	TicketId *uuid.UUID `gorm:"type:char(36);index"`
+
+	// UsedOrderCount TicketId 티켓에서 차감한 의뢰 횟수, 취소할 때 돌려줌, 기록 전에 접수된 의뢰는 0 이라 돌려주지 않음
+	UsedOrderCount uint8 `gorm:"not null;default:0"`
+
+	// CanceledAt 관리자가 취소한 시각, 취소한 의뢰는 DoneAt 도 같이 기록되어 끝난 의뢰로 취급
+	CanceledAt *time.Time `gorm:"type:datetime(6)"`
+
+	// FootageUploadedAt 고객 원본 영상 업로드가 마지막으로 완료된 시각
+	FootageUploadedAt *time.Time `gorm:"type:datetime(6)"`
+
+	// StateChangedAt 현재 상태로 바뀐 시각, SLA 검사에 사용
+	StateChangedAt *time.Time `gorm:"type:datetime(6);index"`
+
+	Priority OrderPriority `gorm:"size:10;index;not null;default:'NORMAL'"`
+
+	// TypeId 의뢰 종류, 0 이면 종류가 생기기 전에 접수된 의뢰
+	TypeId uint8 `gorm:"index;not null;default:0"`
+
+	// ActiveSlot 진행 중인 의뢰가 차지한 동시 진행 자리 번호, 완료되면 nil,
+	// 고객별로 unique 해서 동시에 들어온 의뢰가 동시 진행 한도를 넘지 못하게 막음
+	ActiveSlot *uint8 `gorm:"uniqueIndex:idx_order_active_slot,priority:2"`
+
+	// Version 저장할 때마다 올라감, 다른 곳에서 먼저 저장했으면 저장 실패
+	Version uint `gorm:"not null"`
+
+	// NewStateHistories 아직 저장되지 않은 상태 변경 기록, 의뢰를 저장할 때 같이 저장되고 비워짐
+	NewStateHistories []OrderStateHistory `gorm:"-"`
+
+	// NewReferences 새로 바꿀 참고 링크, nil 이 아니면 의뢰를 저장할 때 기존 링크를 모두 바꾸고 비워짐
+	NewReferences []OrderReference `gorm:"-"`
 }
 
 func (Order) TableName() string {
 	return "order"
 }
 
+// ReplaceReferences 빈 목록이면 저장할 때 기존 링크를 모두 지움
+func (o *Order) ReplaceReferences(inputs []OrderReferenceInput) error {
+	list, err := CreateOrderReferenceList(o.Id, inputs)
+	if err != nil {
+		return err
+	}
+
+	o.NewReferences = list
+	return nil
+}
+
 func (o *Order) IsEmptyEditCount() bool {
 	return o.RemainingEditCount() == 0
 }
@@ -59,12 +178,126 @@ func (o *Order) UseEdit() {
 
 func (o *Order) Done() {
 	o.DoneAt = pointer.Time(time.Now())
+	o.ActiveSlot = nil
 }
 
 func (o *Order) IsDone() bool {
 	return o.DoneAt != nil
 }
 
+// Cancel 끝난 의뢰처럼 동시 진행 자리를 비움, 차감된 의뢰 횟수는 저장할 때 티켓에 돌려줘야함
+func (o *Order) Cancel() {
+	now := time.Now()
+	o.DoneAt = &now
+	o.CanceledAt = &now
+	o.ActiveSlot = nil
+}
+
+func (o *Order) IsCanceled() bool {
+	return o.CanceledAt != nil
+}
+
+// ChangePriority 새 우선순위의 기본 마감일이 지금 마감일보다 빠르면 마감일도 당김
+func (o *Order) ChangePriority(priority OrderPriority, dueDays int) {
+	o.Priority = priority
+
+	dueDate := DefaultDueDate(o.OrderedAt, dueDays)
+	if dueDate != nil && (o.DueDate == nil || dueDate.Before(*o.DueDate)) {
+		o.DueDate = dueDate
+	}
+}
+
+// IsParticipant 의뢰한 고객, 담당 편집자, 최고 관리자
+func (o *Order) IsParticipant(user User) bool {
+	switch {
+	case user.IsSuperAdmin():
+		return true
+	case user.IsCustomer():
+		return o.Orderer == user.Id
+	case user.IsAdmin():
+		return o.Assignee != nil && *o.Assignee == user.Id
+	}
+	return false
+}
+
+// IsVersion version 이 없으면 확인하지 않음
+func (o *Order) IsVersion(version *uint) bool {
+	return version == nil || *version == o.Version
+}
+
+// TakeStateId 편집자가 맡았을 때의 상태, 의뢰 종류가 없으면 기본 TAKE 상태
+func (o *Order) TakeStateId(orderType *OrderType, defaultTakeStateId uint8) uint8 {
+	if orderType == nil || orderType.Id != o.TypeId || orderType.RootStateId == 0 {
+		return defaultTakeStateId
+	}
+	return orderType.RootStateId
+}
+
+// ChangeState 상태가 실제로 바뀔 때만 StateChangedAt 을 갱신
+func (o *Order) ChangeState(state uint8) {
+	o.ChangeStateBy(state, OrderStateActorUser)
+}
+
+// ChangeStateBy 누가 바꿨는지 상태 기록에 남김
+func (o *Order) ChangeStateBy(state uint8, actor OrderStateActor) {
+	if o.State == state {
+		return
+	}
+
+	now := time.Now()
+	o.State = state
+	o.StateChangedAt = &now
+	o.recordState(now, actor)
+}
+
+func (o *Order) recordState(at time.Time, actor OrderStateActor) {
+	o.NewStateHistories = append(o.NewStateHistories, OrderStateHistory{
+		OrderId:   o.Id,
+		StateId:   o.State,
+		ChangedAt: at,
+		Actor:     actor,
+	})
+}
+
+// StateSince 현재 상태가 시작된 시각, 기록이 없는 기존 의뢰는 의뢰 일자로 대신함
+func (o *Order) StateSince() time.Time {
+	if o.StateChangedAt != nil {
+		return *o.StateChangedAt
+	}
+	return o.OrderedAt
+}
+
+// Deadline DueDate 는 날짜만 있으므로 그 날이 끝나는 시각을 마감으로 봄
+func (o *Order) Deadline() *time.Time {
+	if o.DueDate == nil {
+		return nil
+	}
+
+	deadline := o.DueDate.AddDate(0, 0, 1)
+	return &deadline
+}
+
+func (o *Order) RemainingTime(now time.Time) *time.Duration {
+	deadline := o.Deadline()
+	if deadline == nil {
+		return nil
+	}
+
+	remaining := deadline.Sub(now)
+	return &remaining
+}
+
+func (o *Order) IsOverdue(now time.Time) bool {
+	remaining := o.RemainingTime(now)
+	return !o.IsDone() && remaining != nil && *remaining < 0
+}
+
+// IsAtRisk 마감 전이지만 남은 시간이 window 이하
+func (o *Order) IsAtRisk(now time.Time, window time.Duration) bool {
+	remaining := o.RemainingTime(now)
+	return !o.IsDone() && remaining != nil && *remaining >= 0 && *remaining <= window
+}
+
 type OrderGeneralState uint8
 
 const (
@@ -76,15 +309,142 @@ const (
 
 	// OrderGeneralStateDone 의뢰가 끝난 리스트
 	OrderGeneralStateDone
+
+	// OrderGeneralStateNotDone 요청, 진행 중인 의뢰 모두
+	OrderGeneralStateNotDone
+
+	// OrderGeneralStateAll 끝난 의뢰까지 모두
+	OrderGeneralStateAll
+)
+
+type OrderSLAFilter string
+
+const (
+	OrderSLAFilterNone    OrderSLAFilter = ""
+	OrderSLAFilterOverdue OrderSLAFilter = "overdue"
+	OrderSLAFilterAtRisk  OrderSLAFilter = "atRisk"
+)
+
+// OrderSort 비어있으면 요청, 진행 중 목록은 우선순위 다음 의뢰 일자 순, 완료 목록은 의뢰 일자 최신 순
+type OrderSort string
+
+const (
+	OrderSortDefault   OrderSort = ""
+	OrderSortOrderedAt OrderSort = "orderedAt"
+	OrderSortName      OrderSort = "name"
+	OrderSortAssignee  OrderSort = "assignee"
+	OrderSortState     OrderSort = "state"
 )
 
 type FetchOrderOption struct {
 	OrderState OrderGeneralState
-	Query      string
-	Assignee   *uuid.UUID
-	//TODO Sort OrderedAt, Name, Assignee, State
-	//TODO Pagination
-	//Limit    int64
+
+	// Query 고객 이름, 채널명, 이메일, 요청사항, 담당 편집자 이름/닉네임, 의뢰 아이디 앞자리로 검색
+	Query    string
+	Assignee *uuid.UUID
+
+	// Orderer 이 고객의 의뢰만
+	Orderer *uuid.UUID
+
+	// Viewer 안 읽은 메시지 수를 셀 사용자
+	Viewer *uuid.UUID
+
+	// SLA 마감 초과 또는 마감 임박 의뢰만 보기
+	SLA OrderSLAFilter
+
+	// AtRiskWindow 마감 임박으로 볼 남은 시간
+	AtRiskWindow time.Duration
+
+	// OrderedFrom, OrderedTo 의뢰 일시 범위, 둘 다 포함
+	OrderedFrom *time.Time
+	OrderedTo   *time.Time
+
+	// DueFrom, DueTo 마감일 범위, 둘 다 포함
+	DueFrom *time.Time
+	DueTo   *time.Time
+
+	// DoneFrom, DoneTo 완료 일시 범위, 둘 다 포함
+	DoneFrom *time.Time
+	DoneTo   *time.Time
+
+	StateId *uint8
+
+	Sort OrderSort
+	Desc bool
+
+	// Page FetchPage 에서만 사용, nil 이면 첫 페이지
+	Page *PageOption
+
+	// Lang Export 에서만 사용, 상태 이름을 이 언어의 번역으로 내보내고 번역이 없으면 그대로 씀
+	Lang string
+}
+
+// CheckOrderParticipant 의뢰가 없으면 ErrItemNotFound, 의뢰에 참여한 사용자가 아니면 ErrNoPermission
+func CheckOrderParticipant(ctx context.Context, userRepo UserRepository, orderRepo OrderRepository, userId, orderId uuid.UUID) (err error) {
+	var (
+		user  *User
+		order *Order
+	)
+	g, gc := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		user, err = userRepo.GetById(gc, userId)
+		if err != nil {
+			return
+		}
+
+		if !CheckUserAlive(user) {
+			err = ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		order, err = orderRepo.GetById(gc, orderId)
+		if err != nil {
+			return
+		}
+
+		if order == nil {
+			err = ErrItemNotFound
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	if !order.IsParticipant(*user) {
+		err = ErrNoPermission
+	}
+	return
+}
+
+// FetchSLABreachedOption StuckBefore 상태 아이디별로 이 시각 전에 들어온 의뢰는 너무 오래 머문 것으로 봄
+type FetchSLABreachedOption struct {
+	StuckBefore map[uint8]time.Time
+}
+
+// GetCustomerOrder 고객 본인의 의뢰를 가져옴, orderId 가 uuid.Nil 이면 가장 최근 진행중인 의뢰
+func GetCustomerOrder(ctx context.Context, orderRepo OrderRepository, userId, orderId uuid.UUID) (order *Order, err error) {
+	if orderId == uuid.Nil {
+		order, err = orderRepo.GetRecentProcessingByOrdererId(ctx, userId)
+	} else {
+		order, err = orderRepo.GetById(ctx, orderId)
+	}
+	if err != nil {
+		return
+	}
+
+	if order == nil {
+		err = ErrItemNotFound
+		return
+	}
+
+	if order.Orderer != userId {
+		order = nil
+		err = ErrNoPermission
+	}
+	return
 }
 
 type OrderRepository interface {
@@ -94,8 +454,32 @@ type OrderRepository interface {
 
 	GetById(ctx context.Context, orderId uuid.UUID) (*Order, error)
 	GetRecentByOrdererId(ctx context.Context, ordererId uuid.UUID) (*Order, error)
+	GetRecentProcessingByOrdererId(ctx context.Context, ordererId uuid.UUID) (*Order, error)
+	CountProcessingByOrdererId(ctx context.Context, ordererId uuid.UUID) (int64, error)
+	CountProcessingByAssignee(ctx context.Context, assignee uuid.UUID) (int64, error)
+
+	// FetchActiveSlotByOrdererId 진행 중인 의뢰들이 차지한 동시 진행 자리 번호
+	FetchActiveSlotByOrdererId(ctx context.Context, ordererId uuid.UUID) ([]uint8, error)
+
+	// FetchStateHistoryByOrderId 오래된 순
+	FetchStateHistoryByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderStateHistory, error)
+
+	// FetchReferenceByOrderId 입력한 순
+	FetchReferenceByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderReference, error)
+
+	// FetchSLABreached 끝나지 않은 의뢰 중 마감이 지났거나, StuckBefore 의 상태에 그 시각 전부터 머문 의뢰
+	FetchSLABreached(ctx context.Context, option FetchSLABreachedOption) ([]Order, error)
+
+	// CountProcessingGroupByAssignee 담당자별 진행중인 의뢰 수
+	CountProcessingGroupByAssignee(ctx context.Context) (map[uuid.UUID]int64, error)
 
 	Fetch(ctx context.Context, option FetchOrderOption) ([]Order, error)
+
+	// FetchPage option.Page 만큼 커서 페이지로 조회
+	FetchPage(ctx context.Context, option FetchOrderOption) ([]Order, Page, error)
+
+	// Export 의뢰 일자 순으로 한 줄씩 읽으면서 fn 호출, fn 이 error 를 반환하면 멈춤, option.Page 는 무시
+	Export(ctx context.Context, option FetchOrderOption, fn func(row OrderExportRow) error) error
 }
 
 type OrderTxRepository interface {
@@ -103,31 +487,107 @@ type OrderTxRepository interface {
 	gormx.Tx
 }
 
+// RequestOrder Priority 가 비어있으면 NORMAL
 type RequestOrder struct {
 	UserId      uuid.UUID
+	TypeId      uint8
 	Requirement string
+	Priority    OrderPriority
+	References  []OrderReferenceInput
 }
 
+// RequestEditOrder OrderId 가 uuid.Nil 이면 가장 최근 진행중인 의뢰를 대상으로 함,
+// Version 이 있으면 의뢰의 현재 버전과 같을 때만 요청, 검토 대기 중인 결과물은 반려됨
 type RequestEditOrder struct {
-	UserId uuid.UUID
+	UserId    uuid.UUID
+	OrderId   uuid.UUID
+	Feedbacks []OrderFeedbackItem
+	Version   *uint
 }
 
+// OrderDone OrderId 가 uuid.Nil 이면 가장 최근 진행중인 의뢰를 대상으로 함
 type OrderDone struct {
-	UserId uuid.UUID
+	UserId  uuid.UUID
+	OrderId uuid.UUID
 }
 
+// UpdateOrderInfo Version 이 있으면 의뢰의 현재 버전과 같을 때만 수정
+// UpdateOrderInfo References 가 nil 이면 참고 링크는 바꾸지 않음
 type UpdateOrderInfo struct {
 	OrderId    uuid.UUID
 	DueDate    time.Time
 	Assignee   uuid.UUID
 	OrderState uint8
+	References *[]OrderReferenceInput
+	Version    *uint
+}
+
+type BulkOrderAction string
+
+const (
+	// BulkOrderActionAssign 편집자 배정, 아직 맡은 편집자가 없던 의뢰는 작업 시작 상태로 바뀜
+	BulkOrderActionAssign BulkOrderAction = "ASSIGN"
+
+	// BulkOrderActionDueDate 마감일 변경
+	BulkOrderActionDueDate BulkOrderAction = "DUE_DATE"
+
+	// BulkOrderActionState 상태 변경, 완료와 취소는 따로 처리해야함, 편집자가 없는 의뢰는 작업 시작 상태로 바뀜
+	BulkOrderActionState BulkOrderAction = "STATE"
+
+	// BulkOrderActionCancel 의뢰 취소, 차감했던 의뢰 횟수는 티켓에 돌려줌
+	BulkOrderActionCancel BulkOrderAction = "CANCEL"
+)
+
+// BulkUpdateOrder 의뢰마다 따로 검사하고 저장하므로 일부만 실패할 수 있음,
+// Assignee, Force 는 ASSIGN, DueDate 는 DUE_DATE, OrderState 는 STATE 에서만 사용
+type BulkUpdateOrder struct {
+	UserId     uuid.UUID
+	OrderIds   []uuid.UUID
+	Action     BulkOrderAction
+	Assignee   uuid.UUID
+	Force      bool
+	DueDate    time.Time
+	OrderState uint8
+}
+
+// BulkOrderResult Err 가 nil 이면 성공, Warnings 는 ASSIGN 에서 편집자가 바쁠 때
+type BulkOrderResult struct {
+	OrderId  uuid.UUID
+	Err      error
+	Warnings []OrderAssignWarning
+}
+
+// UpdateOrderPriority Version 이 있으면 의뢰의 현재 버전과 같을 때만 수정
+type UpdateOrderPriority struct {
+	UserId   uuid.UUID
+	OrderId  uuid.UUID
+	Priority OrderPriority
+	Version  *uint
+}
+
+// OrderUploadCompleted 원본 영상 업로드 완료 이벤트
+type OrderUploadCompleted struct {
+	OrderId  uuid.UUID
+	UploadId uuid.UUID
 }
 
+// OrderAssignSelf Force 가 true 이면 경고가 있어도 배정함
 type OrderAssignSelf struct {
 	OrderId  uuid.UUID
 	Assignee uuid.UUID
+	Force    bool
 }
 
+type OrderAssignWarning string
+
+const (
+	// OrderAssignWarningOverCapacity 최대 동시 의뢰 수를 넘음
+	OrderAssignWarningOverCapacity OrderAssignWarning = "OVER_CAPACITY"
+
+	// OrderAssignWarningAway 자동 배정 제외 중이거나, 마감일 전에 휴무일이 있거나, 마감일까지 근무 시간이 없음
+	OrderAssignWarningAway OrderAssignWarning = "AWAY"
+)
+
 type OrderInfo struct {
 	OrderId            uuid.UUID
 	OrderedAt          time.Time
@@ -139,6 +599,56 @@ type OrderInfo struct {
 	OrderState         uint8
 	OrderStateContent  string
 	DoneAt             *time.Time
+	UnreadMessageCount int64
+	Priority           OrderPriority
+	TypeId             uint8
+	DueDate            *time.Time
+	RemainingTime      *time.Duration
+	Overdue            bool
+	AtRisk             bool
+
+	// Highlights 검색어가 있을 때 일치한 항목
+	Highlights []OrderSearchHighlight
+}
+
+// OrderExportRow 보고서 한 줄, 고객, 편집자, 상태 이름은 내보내는 시점 기준
+type OrderExportRow struct {
+	OrderId            uuid.UUID
+	OrderedAt          time.Time
+	OrdererName        *string
+	OrdererEmail       *string
+	OrdererChannelName *string
+	AssigneeName       *string
+	AssigneeNickname   *string
+	OrderStateContent  *string
+	Priority           OrderPriority
+	TypeId             uint8
+	DueDate            *time.Time
+	DoneAt             *time.Time
+	CanceledAt         *time.Time
+	EditCount          uint8
+	TotalEditCount     uint8
+
+	// TicketExOrderId 의뢰 횟수를 차감한 티켓의 외부 주문 번호, 티켓 기록 전에 접수된 의뢰는 nil
+	TicketExOrderId *string
+}
+
+type OrderSearchField string
+
+const (
+	OrderSearchFieldOrdererName      OrderSearchField = "ORDERER_NAME"
+	OrderSearchFieldChannelName      OrderSearchField = "CHANNEL_NAME"
+	OrderSearchFieldEmail            OrderSearchField = "EMAIL"
+	OrderSearchFieldRequirement      OrderSearchField = "REQUIREMENT"
+	OrderSearchFieldAssigneeName     OrderSearchField = "ASSIGNEE_NAME"
+	OrderSearchFieldAssigneeNickname OrderSearchField = "ASSIGNEE_NICKNAME"
+	OrderSearchFieldOrderId          OrderSearchField = "ORDER_ID"
+)
+
+// OrderSearchHighlight Fragment 는 검색어와 일치한 부분을 <em> 으로 감싼 일부분
+type OrderSearchHighlight struct {
+	Field    OrderSearchField
+	Fragment string
 }
 
 type RecentOrderInfo struct {
@@ -150,6 +660,33 @@ type RecentOrderInfo struct {
 	OrderStateContent  string
 	OrderStateEmoji    string
 	RemainingEditCount uint8
+	UnreadMessageCount int64
+	Priority           OrderPriority
+	TypeId             uint8
+	Version            uint
+	DoneAt             *time.Time
+}
+
+// MyOrderDetailInfo 고객에게 보여주는 의뢰 상세
+type MyOrderDetailInfo struct {
+	RecentOrderInfo
+	UsedEditCount  uint8
+	TotalEditCount uint8
+	Deliveries     []OrderDeliveryInfo
+
+	// StateTimeline 오래된 순
+	StateTimeline []OrderStateTimelineInfo
+}
+
+type FetchMyOrderOption struct {
+	UserId uuid.UUID
+
+	// OrderState OrderGeneralStateNotDone, OrderGeneralStateDone, OrderGeneralStateAll 중 하나
+	OrderState  OrderGeneralState
+	StateId     *uint8
+	OrderedFrom *time.Time
+	OrderedTo   *time.Time
+	Page        *PageOption
 }
 
 type OrderAssigneeInfo struct {
@@ -168,6 +705,11 @@ type OrderDetailInfo struct {
 	OrderStateContent  string
 	RemainingEditCount uint8
 	Requirement        string
+	Priority           OrderPriority
+	TypeId             uint8
+	Version            uint
+	Feedbacks          []OrderFeedbackInfo
+	References         []OrderReferenceInfo
 }
 
 type OrderUseCase interface {
@@ -177,10 +719,26 @@ type OrderUseCase interface {
 	OrderDone(ctx context.Context, in OrderDone) (uuid.UUID, error)
 
 	UpdateOrderInfo(ctx context.Context, in UpdateOrderInfo) error
-	OrderAssignSelf(ctx context.Context, in OrderAssignSelf) error
+	UpdateOrderPriority(ctx context.Context, in UpdateOrderPriority) error
+	// BulkUpdateOrder 요청 자체가 잘못되면 error, 의뢰별 실패는 결과에 담김
+	BulkUpdateOrder(ctx context.Context, in BulkUpdateOrder) ([]BulkOrderResult, error)
+	// OrderAssignSelf 경고가 있는데 Force 가 아니면 ErrEditorUnavailable 과 함께 경고 반환
+	OrderAssignSelf(ctx context.Context, in OrderAssignSelf) ([]OrderAssignWarning, error)
+	OrderUploadCompleted(ctx context.Context, in OrderUploadCompleted) error
+	ResolveOrderFeedback(ctx context.Context, in ResolveOrderFeedback) error
 
 	GetRecentProcessingOrder(ctx context.Context, userId uuid.UUID) (RecentOrderInfo, error)
+	GetMyOrder(ctx context.Context, userId, orderId uuid.UUID) (MyOrderDetailInfo, error)
+	FetchMyOrder(ctx context.Context, option FetchMyOrderOption) ([]RecentOrderInfo, Page, error)
 	GetOrderDetailInfo(ctx context.Context, orderId uuid.UUID) (OrderDetailInfo, error)
 
-	Fetch(ctx context.Context, option FetchOrderOption) ([]OrderInfo, error)
+	Fetch(ctx context.Context, option FetchOrderOption) ([]OrderInfo, Page, error)
+
+	// FetchBoard 상태별 칸과 칸마다 카드 첫 페이지, option.StateId 가 있으면 그 칸만, option.Page 는 칸마다 적용
+	FetchBoard(ctx context.Context, option FetchOrderOption) ([]OrderBoardColumn, error)
+	// MoveOrderCard 편집자가 없는 의뢰는 ErrOrderNotAssigned
+	MoveOrderCard(ctx context.Context, in MoveOrderCard) error
+
+	// Export 조건에 맞는 의뢰를 모두 메모리에 올리지 않고 한 줄씩 fn 으로 넘김
+	Export(ctx context.Context, option FetchOrderOption, fn func(row OrderExportRow) error) error
 }
\ No newline at end of file
@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+)
+
+type OrderAssignmentStrategy string
+
+const (
+	// OrderAssignmentStrategyRoundRobin 편집자 순서대로 돌아가며 배정
+	OrderAssignmentStrategyRoundRobin OrderAssignmentStrategy = "ROUND_ROBIN"
+
+	// OrderAssignmentStrategyLeastLoaded 진행중인 의뢰가 가장 적은 편집자에게 배정
+	OrderAssignmentStrategyLeastLoaded OrderAssignmentStrategy = "LEAST_LOADED"
+
+	// OrderAssignmentStrategySkillMatch 요구사항과 겹치는 작업 키워드가 가장 많은 편집자에게 배정
+	OrderAssignmentStrategySkillMatch OrderAssignmentStrategy = "SKILL_MATCH"
+)
+
+func (s OrderAssignmentStrategy) IsValid() bool {
+	switch s {
+	case OrderAssignmentStrategyRoundRobin,
+		OrderAssignmentStrategyLeastLoaded,
+		OrderAssignmentStrategySkillMatch:
+		return true
+	}
+	return false
+}
+
+type OrderAssignmentTrigger string
+
+const (
+	// OrderAssignmentTriggerOrderRequested 의뢰 요청 직후 자동 배정
+	OrderAssignmentTriggerOrderRequested OrderAssignmentTrigger = "ORDER_REQUESTED"
+
+	// OrderAssignmentTriggerManual 최고 관리자가 직접 실행
+	OrderAssignmentTriggerManual OrderAssignmentTrigger = "MANUAL"
+)
+
+// OrderAssignmentReason 편집자를 고른 이유, 이유마다 정해진 순서의 값(ReasonArgs)과 함께 기록하고 보여줄 때 문장으로 바꿈
+type OrderAssignmentReason string
+
+const (
+	// OrderAssignmentReasonRoundRobinNext 이전 배정 편집자 다음 순서, 값: 이전 배정 편집자
+	OrderAssignmentReasonRoundRobinNext OrderAssignmentReason = "ROUND_ROBIN_NEXT"
+
+	// OrderAssignmentReasonRoundRobinFirst 첫 번째 순서
+	OrderAssignmentReasonRoundRobinFirst OrderAssignmentReason = "ROUND_ROBIN_FIRST"
+
+	// OrderAssignmentReasonLeastLoaded 진행중인 의뢰가 가장 적음, 값: 진행중인 의뢰 수, 최대 동시 의뢰 수
+	OrderAssignmentReasonLeastLoaded OrderAssignmentReason = "LEAST_LOADED"
+
+	// OrderAssignmentReasonNoSkillMatch 일치하는 작업 키워드가 없어 진행중인 의뢰가 가장 적은 편집자, 값: LEAST_LOADED 와 같음
+	OrderAssignmentReasonNoSkillMatch OrderAssignmentReason = "NO_SKILL_MATCH"
+
+	// OrderAssignmentReasonSkillMatch 작업 키워드가 가장 많이 일치, 값: 일치한 수, 일치한 키워드, 진행중인 의뢰 수
+	OrderAssignmentReasonSkillMatch OrderAssignmentReason = "SKILL_MATCH"
+)
+
+// orderAssignmentReasonArgSep 값에 콤마가 들어갈 수 있으므로(키워드 목록) 줄바꿈으로 구분
+const orderAssignmentReasonArgSep = "\n"
+
+const orderAssignmentSettingId uint8 = 1
+
+func DefaultOrderAssignmentSetting() OrderAssignmentSetting {
+	return OrderAssignmentSetting{
+		Id:        orderAssignmentSettingId,
+		Enabled:   false,
+		Strategy:  OrderAssignmentStrategyLeastLoaded,
+		UpdatedAt: time.Now(),
+	}
+}
+
+// OrderAssignmentSetting 자동 배정 설정, 한 행만 사용함
+type OrderAssignmentSetting struct {
+	Id       uint8                   `gorm:"primaryKey;autoIncrement:false"`
+	Enabled  bool                    `gorm:"not null"`
+	Strategy OrderAssignmentStrategy `gorm:"size:20;not null"`
+
+	// LastAssignee 라운드 로빈에서 마지막으로 배정된 편집자
+	LastAssignee *uuid.UUID `gorm:"type:char(36)"`
+
+	UpdatedAt time.Time  `gorm:"type:datetime(6);not null"`
+	UpdatedBy *uuid.UUID `gorm:"type:char(36)"`
+}
+
+func (OrderAssignmentSetting) TableName() string {
+	return "order_assignment_setting"
+}
+
+func (s *OrderAssignmentSetting) Update(enabled bool, strategy OrderAssignmentStrategy, userId uuid.UUID) {
+	s.Enabled = enabled
+	s.Strategy = strategy
+	s.UpdatedAt = time.Now()
+	s.UpdatedBy = &userId
+}
+
+type CreateOrderAssignmentLogOption struct {
+	OrderId    uuid.UUID
+	Assignee   uuid.UUID
+	Strategy   OrderAssignmentStrategy
+	Trigger    OrderAssignmentTrigger
+	Reason     OrderAssignmentReason
+	ReasonArgs []string
+	Load       int64
+}
+
+func CreateOrderAssignmentLog(option CreateOrderAssignmentLogOption) OrderAssignmentLog {
+	return OrderAssignmentLog{
+		OrderId:    option.OrderId,
+		Assignee:   option.Assignee,
+		Strategy:   option.Strategy,
+		Trigger:    option.Trigger,
+		Reason:     option.Reason,
+		ReasonArgs: strings.Join(option.ReasonArgs, orderAssignmentReasonArgSep),
+		Load:       option.Load,
+		CreatedAt:  time.Now(),
+	}
+}
+
+// OrderAssignmentLog 자동 배정 기록, Reason 에 해당 편집자를 고른 이유를 남김
+type OrderAssignmentLog struct {
+	Id       uint64                  `gorm:"primaryKey"`
+	OrderId  uuid.UUID               `gorm:"type:char(36);index;not null"`
+	Assignee uuid.UUID               `gorm:"type:char(36);index;not null"`
+	Strategy OrderAssignmentStrategy `gorm:"size:20;not null"`
+	Trigger  OrderAssignmentTrigger  `gorm:"size:20;not null"`
+	Reason   OrderAssignmentReason   `gorm:"size:20;not null"`
+
+	// ReasonArgs 이유를 문장으로 바꿀 때 채우는 값, 줄바꿈으로 구분
+	ReasonArgs string `gorm:"size:500;not null"`
+
+	// Load 배정 직전 편집자가 진행중이던 의뢰 수
+	Load int64 `gorm:"not null"`
+
+	CreatedAt time.Time `gorm:"type:datetime(6);index;not null"`
+}
+
+func (OrderAssignmentLog) TableName() string {
+	return "order_assignment_log"
+}
+
+func (l *OrderAssignmentLog) ReasonArgList() []string {
+	if l.ReasonArgs == "" {
+		return nil
+	}
+	return strings.Split(l.ReasonArgs, orderAssignmentReasonArgSep)
+}
+
+type OrderAssignmentRepository interface {
+	Transaction(ctx context.Context, fn func(orderAssignmentRepo OrderAssignmentTxRepository) error, options ...*sql.TxOptions) error
+	With(tx gormx.Tx) OrderAssignmentTxRepository
+
+	// GetSetting 저장된 설정이 없으면 기본 설정 반환
+	GetSetting(ctx context.Context) (OrderAssignmentSetting, error)
+	SaveSetting(ctx context.Context, setting *OrderAssignmentSetting) error
+
+	SaveLog(ctx context.Context, log *OrderAssignmentLog) error
+	FetchLogByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderAssignmentLog, error)
+}
+
+type OrderAssignmentTxRepository interface {
+	OrderAssignmentRepository
+	gormx.Tx
+}
+
+type UpdateOrderAssignmentSetting struct {
+	UserId   uuid.UUID
+	Enabled  bool
+	Strategy OrderAssignmentStrategy
+}
+
+type UpdateEditorAssignmentProfile struct {
+	UserId             uuid.UUID
+	EditorId           uuid.UUID
+	MaxConcurrentOrder uint8
+	Skills             []string
+	Away               bool
+}
+
+// RunAutoAssignOrder OrderId 가 uuid.Nil 이면 배정 대기중인 모든 의뢰를 대상으로 함
+type RunAutoAssignOrder struct {
+	UserId  uuid.UUID
+	OrderId uuid.UUID
+}
+
+type OrderAssignmentSettingInfo struct {
+	Enabled   bool
+	Strategy  OrderAssignmentStrategy
+	UpdatedAt time.Time
+	UpdatedBy *uuid.UUID
+}
+
+type OrderAssignmentLogInfo struct {
+	OrderId  uuid.UUID
+	Assignee uuid.UUID
+	Strategy OrderAssignmentStrategy
+	Trigger  OrderAssignmentTrigger
+
+	// Reason 편집자를 고른 이유 코드, ReasonText 는 이를 문장으로 바꾼 것
+	Reason     OrderAssignmentReason
+	ReasonText string
+
+	Load      int64
+	CreatedAt time.Time
+}
+
+type OrderAssignmentUseCase interface {
+	// AssignRequestedOrder 의뢰 요청 직후 호출, 자동 배정이 꺼져 있으면 아무것도 하지 않음
+	AssignRequestedOrder(ctx context.Context, orderId uuid.UUID) error
+
+	// RunAutoAssignOrder 최고 관리자가 직접 실행, 자동 배정 설정과 관계없이 현재 전략으로 배정
+	RunAutoAssignOrder(ctx context.Context, in RunAutoAssignOrder) ([]OrderAssignmentLogInfo, error)
+
+	GetSetting(ctx context.Context) (OrderAssignmentSettingInfo, error)
+	UpdateSetting(ctx context.Context, in UpdateOrderAssignmentSetting) error
+	UpdateEditorProfile(ctx context.Context, in UpdateEditorAssignmentProfile) error
+
+	FetchLogByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderAssignmentLogInfo, error)
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// ErrOrderNotAssigned 편집자가 배정되지 않은 의뢰는 요청 칸에서 옮길 수 없음
+var ErrOrderNotAssigned = errors.New("order not assigned")
+
+// OrderBoardColumn 상태 하나가 보드의 칸 하나, Depth 는 상태 트리에서의 깊이
+type OrderBoardColumn struct {
+	StateId  uint8
+	Code     OrderStateCode
+	Content  string
+	Emoji    string
+	ParentId *uint8
+	GroupId  *uint8
+	Depth    int
+	Active   bool
+
+	// Cards 칸의 첫 페이지, Page.Total 이 칸의 전체 의뢰 수
+	Cards []OrderInfo
+	Page  Page
+}
+
+// SortOrderBoardColumns 그룹이 없는 시작 상태, 그룹별 상태 트리, 완료와 취소 순,
+// 부모 상태 바로 뒤에 자식 상태가 정렬 순서, 아이디 순으로 옴
+func SortOrderBoardColumns(states []OrderState) []OrderBoardColumn {
+	children := make(map[uint8][]OrderState)
+	exists := make(map[uint8]bool, len(states))
+	for _, s := range states {
+		exists[s.Id] = true
+	}
+
+	var roots []OrderState
+	for _, s := range states {
+		if s.ParentId != nil && exists[*s.ParentId] {
+			children[*s.ParentId] = append(children[*s.ParentId], s)
+		} else {
+			roots = append(roots, s)
+		}
+	}
+
+	sort.SliceStable(roots, func(i, j int) bool {
+		ri, rj := orderBoardRootRank(roots[i]), orderBoardRootRank(roots[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return orderStateLess(roots[i], roots[j])
+	})
+
+	columns := make([]OrderBoardColumn, 0, len(states))
+	var walk func(s OrderState, depth int)
+	walk = func(s OrderState, depth int) {
+		columns = append(columns, OrderBoardColumn{
+			StateId:  s.Id,
+			Code:     s.Code,
+			Content:  s.Content,
+			Emoji:    s.Emoji,
+			ParentId: s.ParentId,
+			GroupId:  s.GroupId,
+			Depth:    depth,
+			Active:   s.Active,
+		})
+
+		list := children[s.Id]
+		sort.SliceStable(list, func(i, j int) bool { return orderStateLess(list[i], list[j]) })
+		for _, child := range list {
+			walk(child, depth+1)
+		}
+	}
+	for _, root := range roots {
+		walk(root, 0)
+	}
+	return columns
+}
+
+func orderStateLess(a, b OrderState) bool {
+	if a.SortOrder != b.SortOrder {
+		return a.SortOrder < b.SortOrder
+	}
+	return a.Id < b.Id
+}
+
+// orderBoardRootRank 그룹 없는 상태 0, 그룹 있는 상태 1, 완료, 취소 2
+func orderBoardRootRank(s OrderState) int {
+	switch {
+	case s.Code == OrderStateCodeDone, s.Code == OrderStateCodeCanceled:
+		return 2
+	case s.GroupId == nil:
+		return 0
+	}
+	return 1
+}
+
+// MoveOrderCard 보드에서 카드를 다른 칸으로 옮김, 의뢰 정보 수정과 같은 상태 변경 검사를 거침
+type MoveOrderCard struct {
+	UserId     uuid.UUID
+	OrderId    uuid.UUID
+	OrderState uint8
+	Version    *uint
+}
@@ -0,0 +1,205 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+)
+
+type OrderDeliveryState string
+
+const (
+	// OrderDeliveryStatePending 고객 검토 대기
+	OrderDeliveryStatePending OrderDeliveryState = "PENDING"
+
+	// OrderDeliveryStateApproved 고객 승인
+	OrderDeliveryStateApproved OrderDeliveryState = "APPROVED"
+
+	// OrderDeliveryStateRejected 고객 반려, 수정 요청으로 이어짐
+	OrderDeliveryStateRejected OrderDeliveryState = "REJECTED"
+)
+
+type CreateOrderDeliveryOption struct {
+	OrderId   uuid.UUID
+	Version   uint8
+	Files     []OrderDeliveryFile
+	Note      *string
+	CreatedBy uuid.UUID
+}
+
+func CreateOrderDelivery(option CreateOrderDeliveryOption) OrderDelivery {
+	return OrderDelivery{
+		Id:        uuid.New(),
+		OrderId:   option.OrderId,
+		Version:   option.Version,
+		State:     OrderDeliveryStatePending,
+		Note:      option.Note,
+		CreatedBy: option.CreatedBy,
+		CreatedAt: time.Now(),
+		Files:     option.Files,
+	}
+}
+
+// OrderDelivery 편집자가 전달한 결과물, 수정 요청마다 Version 이 하나씩 증가
+type OrderDelivery struct {
+	Id         uuid.UUID           `gorm:"type:char(36);primaryKey"`
+	OrderId    uuid.UUID           `gorm:"type:char(36);uniqueIndex:ux_order_delivery_version;not null"`
+	Version    uint8               `gorm:"uniqueIndex:ux_order_delivery_version;not null"`
+	State      OrderDeliveryState  `gorm:"size:20;index;not null"`
+	Note       *string             `gorm:"size:2000"`
+	CreatedBy  uuid.UUID           `gorm:"type:char(36);index;not null"`
+	CreatedAt  time.Time           `gorm:"type:datetime(6);not null"`
+	ReviewedAt *time.Time          `gorm:"type:datetime(6)"`
+	Files      []OrderDeliveryFile `gorm:"foreignKey:DeliveryId"`
+
+	// RemindedAt 자동 완료 전에 고객에게 알림을 보낸 시각, 결과물마다 한 번만 보냄
+	RemindedAt *time.Time `gorm:"type:datetime(6)"`
+}
+
+func (OrderDelivery) TableName() string {
+	return "order_delivery"
+}
+
+func (d *OrderDelivery) IsPending() bool {
+	return d.State == OrderDeliveryStatePending
+}
+
+func (d *OrderDelivery) Approve() {
+	d.State = OrderDeliveryStateApproved
+	d.ReviewedAt = pointer.Time(time.Now())
+}
+
+func (d *OrderDelivery) Reject() {
+	d.State = OrderDeliveryStateRejected
+	d.ReviewedAt = pointer.Time(time.Now())
+}
+
+func (d *OrderDelivery) IsReminded() bool {
+	return d.RemindedAt != nil
+}
+
+func (d *OrderDelivery) Remind() {
+	d.RemindedAt = pointer.Time(time.Now())
+}
+
+// AutoCompleteAt since 부터 days 가 지나면 자동 완료,
+// 알림이 늦게 나갔으면 알림을 받은 고객이 reminderDays 동안 확인할 수 있도록 그만큼 미룸
+func (d *OrderDelivery) AutoCompleteAt(since time.Time, days, reminderDays int) time.Time {
+	completeAt := since.AddDate(0, 0, days)
+	if d.RemindedAt != nil {
+		if earliest := d.RemindedAt.AddDate(0, 0, reminderDays); earliest.After(completeAt) {
+			return earliest
+		}
+	}
+	return completeAt
+}
+
+// LastActivityAt 결과물 전달 또는 고객 승인 중 나중 시각
+func (d *OrderDelivery) LastActivityAt() time.Time {
+	if d.ReviewedAt != nil && d.ReviewedAt.After(d.CreatedAt) {
+		return *d.ReviewedAt
+	}
+	return d.CreatedAt
+}
+
+func (d *OrderDelivery) Info() OrderDeliveryInfo {
+	files := make([]OrderDeliveryFileInfo, len(d.Files))
+	for i := range d.Files {
+		files[i] = OrderDeliveryFileInfo{
+			Name: d.Files[i].Name,
+			Link: d.Files[i].Link,
+		}
+	}
+
+	return OrderDeliveryInfo{
+		Version:    d.Version,
+		State:      d.State,
+		Files:      files,
+		Note:       d.Note,
+		CreatedBy:  d.CreatedBy,
+		CreatedAt:  d.CreatedAt,
+		ReviewedAt: d.ReviewedAt,
+	}
+}
+
+type OrderDeliveryFile struct {
+	Id         uint64    `gorm:"primaryKey"`
+	DeliveryId uuid.UUID `gorm:"type:char(36);index;not null"`
+	Name       string    `gorm:"size:300;not null"`
+	Link       string    `gorm:"size:2048;not null"`
+}
+
+func (OrderDeliveryFile) TableName() string {
+	return "order_delivery_file"
+}
+
+type OrderDeliveryRepository interface {
+	Save(ctx context.Context, delivery *OrderDelivery) error
+
+	// Create 같은 의뢰에 같은 버전이 이미 있으면 ErrItemAlreadyExist
+	Create(ctx context.Context, delivery *OrderDelivery) error
+	Transaction(ctx context.Context, fn func(deliveryRepo OrderDeliveryTxRepository) error, options ...*sql.TxOptions) error
+	With(tx gormx.Tx) OrderDeliveryTxRepository
+
+	GetByOrderIdAndVersion(ctx context.Context, orderId uuid.UUID, version uint8) (*OrderDelivery, error)
+	GetLatestByOrderId(ctx context.Context, orderId uuid.UUID) (*OrderDelivery, error)
+	ExistsApprovedByOrderId(ctx context.Context, orderId uuid.UUID) (bool, error)
+
+	FetchByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderDelivery, error)
+
+	// FetchAwaitingCompletion 완료되지 않은 의뢰의 검토 대기, 승인된 결과물, 고객의 완료 처리를 기다리는 중,
+	// 수정 요청(REQUEST_EDIT) 중인 의뢰는 다음 결과물을 기다리므로 뺌
+	FetchAwaitingCompletion(ctx context.Context) ([]OrderDelivery, error)
+}
+
+type OrderDeliveryTxRepository interface {
+	OrderDeliveryRepository
+	gormx.Tx
+}
+
+type OrderDeliveryFileInfo struct {
+	Name string
+	Link string
+}
+
+type DeliverOrder struct {
+	UserId  uuid.UUID
+	OrderId uuid.UUID
+	Files   []OrderDeliveryFileInfo
+	Note    *string
+}
+
+// ReviewOrderDelivery Feedbacks 는 반려할 때만 사용
+type ReviewOrderDelivery struct {
+	UserId    uuid.UUID
+	OrderId   uuid.UUID
+	Version   uint8
+	Feedbacks []OrderFeedbackItem
+}
+
+type OrderDeliveryInfo struct {
+	Version    uint8
+	State      OrderDeliveryState
+	Files      []OrderDeliveryFileInfo
+	Note       *string
+	CreatedBy  uuid.UUID
+	CreatedAt  time.Time
+	ReviewedAt *time.Time
+}
+
+type OrderDeliveryUseCase interface {
+	DeliverOrder(ctx context.Context, in DeliverOrder) (uint8, error)
+
+	ApproveOrderDelivery(ctx context.Context, in ReviewOrderDelivery) error
+	RejectOrderDelivery(ctx context.Context, in ReviewOrderDelivery) error
+
+	FetchByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderDeliveryInfo, error)
+	FetchMyOrderDelivery(ctx context.Context, userId, orderId uuid.UUID) ([]OrderDeliveryInfo, error)
+
+	// AutoCompleteInactive 결과물을 받고 고객이 일정 기간 아무것도 하지 않은 의뢰를 자동 완료, 완료 전에 한 번 알림
+	AutoCompleteInactive(ctx context.Context) error
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOrderDelivery_AutoCompleteAt(t *testing.T) {
+	since := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	at := func(day int) *time.Time {
+		t := time.Date(2021, 11, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	tests := []struct {
+		name       string
+		remindedAt *time.Time
+		want       time.Time
+	}{
+		{"not reminded", nil, *at(8)},
+		{"reminded on time", at(6), *at(8)},
+		{"reminded late", at(7), *at(9)},
+		{"reminded after deadline", at(10), *at(12)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := OrderDelivery{RemindedAt: tt.remindedAt}
+			if got := d.AutoCompleteAt(since, 7, 2); !got.Equal(tt.want) {
+				t.Errorf("AutoCompleteAt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
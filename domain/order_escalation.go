@@ -0,0 +1,112 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+)
+
+type OrderEscalationReason string
+
+const (
+	// OrderEscalationReasonOverdue 마감 초과
+	OrderEscalationReasonOverdue OrderEscalationReason = "OVERDUE"
+
+	// OrderEscalationReasonStuck 한 상태에 너무 오래 머무름
+	OrderEscalationReasonStuck OrderEscalationReason = "STUCK"
+
+	// OrderEscalationReasonLowRating 고객이 낮은 평점을 줌
+	OrderEscalationReasonLowRating OrderEscalationReason = "LOW_RATING"
+)
+
+type CreateOrderEscalationOption struct {
+	Order  Order
+	State  OrderState
+	Reason OrderEscalationReason
+	Since  time.Time
+}
+
+func CreateOrderEscalation(option CreateOrderEscalationOption) OrderEscalation {
+	return OrderEscalation{
+		OrderId:   option.Order.Id,
+		Reason:    option.Reason,
+		Since:     option.Since,
+		StateId:   option.State.Id,
+		StateCode: option.State.Code,
+		Assignee:  option.Order.Assignee,
+		CreatedAt: time.Now(),
+	}
+}
+
+// OrderEscalation 최고 관리자에게 올라간 SLA 위반, 낮은 평점 기록,
+// 같은 의뢰, 같은 사유, 같은 시작 시각(Since)으로는 한 번만 생성됨
+type OrderEscalation struct {
+	Id         uint64                `gorm:"primaryKey"`
+	OrderId    uuid.UUID             `gorm:"type:char(36);uniqueIndex:ux_order_escalation;not null"`
+	Reason     OrderEscalationReason `gorm:"size:20;uniqueIndex:ux_order_escalation;not null"`
+	Since      time.Time             `gorm:"type:datetime(6);uniqueIndex:ux_order_escalation;not null"`
+	StateId    uint8                 `gorm:"not null"`
+	StateCode  OrderStateCode        `gorm:"size:20;not null"`
+	Assignee   *uuid.UUID            `gorm:"type:char(36)"`
+	CreatedAt  time.Time             `gorm:"type:datetime(6);index;not null"`
+	ResolvedAt *time.Time            `gorm:"type:datetime(6);index"`
+	ResolvedBy *uuid.UUID            `gorm:"type:char(36)"`
+}
+
+func (OrderEscalation) TableName() string {
+	return "order_escalation"
+}
+
+func (e *OrderEscalation) IsResolved() bool {
+	return e.ResolvedAt != nil
+}
+
+func (e *OrderEscalation) Resolve(userId uuid.UUID) {
+	e.ResolvedAt = pointer.Time(time.Now())
+	e.ResolvedBy = &userId
+}
+
+type FetchOrderEscalationOption struct {
+	OnlyOpen bool
+}
+
+type OrderEscalationRepository interface {
+	Save(ctx context.Context, escalation *OrderEscalation) error
+
+	// CreateIfNotExists 이미 같은 기록이 있으면 만들지 않고 false 반환
+	CreateIfNotExists(ctx context.Context, escalation *OrderEscalation) (bool, error)
+
+	GetById(ctx context.Context, id uint64) (*OrderEscalation, error)
+	Fetch(ctx context.Context, option FetchOrderEscalationOption) ([]OrderEscalation, error)
+}
+
+type ResolveOrderEscalation struct {
+	UserId       uuid.UUID
+	EscalationId uint64
+}
+
+type OrderEscalationInfo struct {
+	EscalationId uint64
+	OrderId      uuid.UUID
+	Reason       OrderEscalationReason
+	Since        time.Time
+	StateId      uint8
+	StateCode    OrderStateCode
+	Assignee     *uuid.UUID
+	CreatedAt    time.Time
+	ResolvedAt   *time.Time
+	ResolvedBy   *uuid.UUID
+}
+
+type OrderEscalationUseCase interface {
+	// MonitorSLA 스케줄러에서 주기적으로 호출
+	MonitorSLA(ctx context.Context) error
+
+	// Escalate 이미 같은 기록이 없을 때만 만들고 최고 관리자에게 알림
+	Escalate(ctx context.Context, escalation OrderEscalation) error
+
+	ResolveOrderEscalation(ctx context.Context, in ResolveOrderEscalation) error
+	Fetch(ctx context.Context, option FetchOrderEscalationOption) ([]OrderEscalationInfo, error)
+}
@@ -0,0 +1,181 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+)
+
+type OrderFeedbackCategory string
+
+const (
+	OrderFeedbackCategoryCut      OrderFeedbackCategory = "CUT"
+	OrderFeedbackCategorySubtitle OrderFeedbackCategory = "SUBTITLE"
+	OrderFeedbackCategoryEffect   OrderFeedbackCategory = "EFFECT"
+	OrderFeedbackCategoryAudio    OrderFeedbackCategory = "AUDIO"
+)
+
+type CreateOrderFeedbackOption struct {
+	OrderId   uuid.UUID
+	Revision  uint8
+	Item      OrderFeedbackItem
+	CreatedBy uuid.UUID
+}
+
+func CreateOrderFeedback(option CreateOrderFeedbackOption) OrderFeedback {
+	return OrderFeedback{
+		Id:          uuid.New(),
+		OrderId:     option.OrderId,
+		Revision:    option.Revision,
+		StartMs:     option.Item.StartMs,
+		EndMs:       option.Item.EndMs,
+		Category:    option.Item.Category,
+		Description: option.Item.Description,
+		CreatedBy:   option.CreatedBy,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// OrderFeedback 수정 요청 항목, Revision 은 몇 번째 수정 요청인지 (1부터 시작)
+type OrderFeedback struct {
+	Id          uuid.UUID             `gorm:"type:char(36);primaryKey"`
+	OrderId     uuid.UUID             `gorm:"type:char(36);index:ix_order_feedback_revision;not null"`
+	Revision    uint8                 `gorm:"index:ix_order_feedback_revision;not null"`
+	StartMs     *uint32               `gorm:"type:int unsigned"`
+	EndMs       *uint32               `gorm:"type:int unsigned"`
+	Category    OrderFeedbackCategory `gorm:"size:20;not null"`
+	Description string                `gorm:"size:1000;not null"`
+	CreatedBy   uuid.UUID             `gorm:"type:char(36);not null"`
+	CreatedAt   time.Time             `gorm:"type:datetime(6);not null"`
+	ResolvedAt  *time.Time            `gorm:"type:datetime(6)"`
+	ResolvedBy  *uuid.UUID            `gorm:"type:char(36)"`
+}
+
+func (OrderFeedback) TableName() string {
+	return "order_feedback"
+}
+
+func (f *OrderFeedback) IsResolved() bool {
+	return f.ResolvedAt != nil
+}
+
+func (f *OrderFeedback) Resolve(userId uuid.UUID) {
+	f.ResolvedAt = pointer.Time(time.Now())
+	f.ResolvedBy = &userId
+}
+
+// OrderFeedbackItem 고객이 입력하는 수정 요청 항목, 타임코드 구간은 선택
+type OrderFeedbackItem struct {
+	StartMs     *uint32
+	EndMs       *uint32
+	Category    OrderFeedbackCategory
+	Description string
+}
+
+// IsValidRange 끝 시각만 있거나, 끝이 시작보다 앞서면 잘못된 구간
+func (i OrderFeedbackItem) IsValidRange() bool {
+	if i.EndMs == nil {
+		return true
+	}
+
+	return i.StartMs != nil && *i.StartMs <= *i.EndMs
+}
+
+func (c OrderFeedbackCategory) IsValid() bool {
+	switch c {
+	case OrderFeedbackCategoryCut,
+		OrderFeedbackCategorySubtitle,
+		OrderFeedbackCategoryEffect,
+		OrderFeedbackCategoryAudio:
+		return true
+	}
+	return false
+}
+
+func IsValidOrderFeedbackItems(items []OrderFeedbackItem) bool {
+	for i := range items {
+		if !items[i].Category.IsValid() || !items[i].IsValidRange() || items[i].Description == "" {
+			return false
+		}
+	}
+	return true
+}
+
+type OrderFeedbackItemRequest struct {
+	// StartMs 수정할 구간 시작 (영상 기준 밀리초), 선택
+	StartMs *uint32 `json:"startMs" example:"83000"`
+
+	// EndMs 수정할 구간 끝 (영상 기준 밀리초), 선택, 있으면 startMs 도 있어야함
+	EndMs *uint32 `json:"endMs" example:"90500"`
+
+	// Category 수정 분류
+	// * CUT - 컷 편집
+	// * SUBTITLE - 자막
+	// * EFFECT - 효과
+	// * AUDIO - 오디오
+	Category OrderFeedbackCategory `json:"category" validate:"required,oneof=CUT SUBTITLE EFFECT AUDIO" example:"SUBTITLE" enums:"CUT,SUBTITLE,EFFECT,AUDIO"`
+
+	Description string `json:"description" validate:"required,max=1000" example:"자막 오타 수정 부탁드려요"`
+} // @name OrderFeedbackItemRequest
+
+func ToOrderFeedbackItems(list []OrderFeedbackItemRequest) []OrderFeedbackItem {
+	items := make([]OrderFeedbackItem, len(list))
+	for i := range list {
+		items[i] = OrderFeedbackItem{
+			StartMs:     list[i].StartMs,
+			EndMs:       list[i].EndMs,
+			Category:    list[i].Category,
+			Description: list[i].Description,
+		}
+	}
+	return items
+}
+
+type OrderFeedbackRepository interface {
+	Save(ctx context.Context, feedback *OrderFeedback) error
+	SaveAll(ctx context.Context, feedbacks []OrderFeedback) error
+	With(tx gormx.Tx) OrderFeedbackTxRepository
+
+	GetById(ctx context.Context, id uuid.UUID) (*OrderFeedback, error)
+	FetchByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderFeedback, error)
+}
+
+type OrderFeedbackTxRepository interface {
+	OrderFeedbackRepository
+	gormx.Tx
+}
+
+type ResolveOrderFeedback struct {
+	UserId     uuid.UUID
+	OrderId    uuid.UUID
+	FeedbackId uuid.UUID
+}
+
+type OrderFeedbackInfo struct {
+	FeedbackId  uuid.UUID
+	Revision    uint8
+	StartMs     *uint32
+	EndMs       *uint32
+	Category    OrderFeedbackCategory
+	Description string
+	CreatedAt   time.Time
+	ResolvedAt  *time.Time
+	ResolvedBy  *uuid.UUID
+}
+
+// CreateOrderFeedbackList 한 번의 수정 요청에 들어온 항목들을 같은 Revision 으로 묶음
+func CreateOrderFeedbackList(orderId uuid.UUID, revision uint8, createdBy uuid.UUID, items []OrderFeedbackItem) []OrderFeedback {
+	list := make([]OrderFeedback, len(items))
+	for i := range items {
+		list[i] = CreateOrderFeedback(CreateOrderFeedbackOption{
+			OrderId:   orderId,
+			Revision:  revision,
+			Item:      items[i],
+			CreatedBy: createdBy,
+		})
+	}
+	return list
+}
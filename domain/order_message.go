@@ -0,0 +1,142 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+)
+
+type CreateOrderMessageOption struct {
+	OrderId     uuid.UUID
+	SenderId    uuid.UUID
+	Content     string
+	Attachments []OrderMessageAttachment
+}
+
+func CreateOrderMessage(option CreateOrderMessageOption) OrderMessage {
+	return OrderMessage{
+		Id:          uuid.New(),
+		OrderId:     option.OrderId,
+		SenderId:    option.SenderId,
+		Content:     option.Content,
+		CreatedAt:   time.Now(),
+		Attachments: option.Attachments,
+	}
+}
+
+// OrderMessage 의뢰별 고객 - 담당 편집자 간 메시지
+type OrderMessage struct {
+	Id          uuid.UUID                `gorm:"type:char(36);primaryKey"`
+	OrderId     uuid.UUID                `gorm:"type:char(36);index:ix_order_message_order_created;not null"`
+	SenderId    uuid.UUID                `gorm:"type:char(36);index;not null"`
+	Content     string                   `gorm:"size:4000;not null"`
+	CreatedAt   time.Time                `gorm:"type:datetime(6);index:ix_order_message_order_created;not null"`
+	Attachments []OrderMessageAttachment `gorm:"foreignKey:MessageId"`
+}
+
+func (OrderMessage) TableName() string {
+	return "order_message"
+}
+
+// OrderMessageAttachment 첨부 파일 참조, 파일 자체는 외부 링크 또는 업로드 저장소에 있음
+type OrderMessageAttachment struct {
+	Id        uint64    `gorm:"primaryKey"`
+	MessageId uuid.UUID `gorm:"type:char(36);index;not null"`
+	Name      string    `gorm:"size:300;not null"`
+	Link      string    `gorm:"size:2048;not null"`
+}
+
+func (OrderMessageAttachment) TableName() string {
+	return "order_message_attachment"
+}
+
+// OrderMessageRead 참여자별 읽음 표시, LastReadAt 이후 상대가 보낸 메시지가 안 읽은 메시지
+type OrderMessageRead struct {
+	OrderId    uuid.UUID `gorm:"type:char(36);primaryKey"`
+	UserId     uuid.UUID `gorm:"type:char(36);primaryKey"`
+	LastReadAt time.Time `gorm:"type:datetime(6);not null"`
+}
+
+func (OrderMessageRead) TableName() string {
+	return "order_message_read"
+}
+
+type FetchOrderMessageOption struct {
+	OrderId uuid.UUID
+	Offset  int
+	Limit   int
+}
+
+type OrderMessageRepository interface {
+	Save(ctx context.Context, message *OrderMessage) error
+	SaveRead(ctx context.Context, read *OrderMessageRead) error
+	Transaction(ctx context.Context, fn func(messageRepo OrderMessageTxRepository) error, options ...*sql.TxOptions) error
+	With(tx gormx.Tx) OrderMessageTxRepository
+
+	// Fetch 최신 메시지부터 가져옴
+	Fetch(ctx context.Context, option FetchOrderMessageOption) ([]OrderMessage, error)
+	CountByOrderId(ctx context.Context, orderId uuid.UUID) (int64, error)
+
+	FetchReadByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderMessageRead, error)
+	CountUnreadByOrderIds(ctx context.Context, orderIds []uuid.UUID, userId uuid.UUID) (map[uuid.UUID]int64, error)
+}
+
+type OrderMessageTxRepository interface {
+	OrderMessageRepository
+	gormx.Tx
+}
+
+type OrderMessageAttachmentInfo struct {
+	Name string
+	Link string
+}
+
+type SendOrderMessage struct {
+	UserId      uuid.UUID
+	OrderId     uuid.UUID
+	Content     string
+	Attachments []OrderMessageAttachmentInfo
+}
+
+type FetchOrderMessage struct {
+	UserId  uuid.UUID
+	OrderId uuid.UUID
+	Page    int
+	Size    int
+}
+
+type ReadOrderMessage struct {
+	UserId  uuid.UUID
+	OrderId uuid.UUID
+}
+
+type OrderMessageInfo struct {
+	MessageId   uuid.UUID
+	SenderId    uuid.UUID
+	Content     string
+	Attachments []OrderMessageAttachmentInfo
+	CreatedAt   time.Time
+}
+
+type OrderMessageReadInfo struct {
+	UserId     uuid.UUID
+	LastReadAt time.Time
+}
+
+type OrderMessagePageInfo struct {
+	Messages []OrderMessageInfo
+	Reads    []OrderMessageReadInfo
+	Total    int64
+	Page     int
+	Size     int
+}
+
+type OrderMessageUseCase interface {
+	SendOrderMessage(ctx context.Context, in SendOrderMessage) (uuid.UUID, error)
+	ReadOrderMessage(ctx context.Context, in ReadOrderMessage) error
+
+	FetchOrderMessage(ctx context.Context, in FetchOrderMessage) (OrderMessagePageInfo, error)
+}
@@ -0,0 +1,201 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrRatingClosed = errors.New("order not done or rating window closed")
+
+type OrderRatingTag string
+
+const (
+	// OrderRatingTagQuality 결과물 품질
+	OrderRatingTagQuality OrderRatingTag = "QUALITY"
+
+	// OrderRatingTagSpeed 작업 속도
+	OrderRatingTagSpeed OrderRatingTag = "SPEED"
+
+	// OrderRatingTagCommunication 소통
+	OrderRatingTagCommunication OrderRatingTag = "COMMUNICATION"
+
+	// OrderRatingTagCreativity 창의성
+	OrderRatingTagCreativity OrderRatingTag = "CREATIVITY"
+
+	// OrderRatingTagRevision 수정 요청 반영
+	OrderRatingTagRevision OrderRatingTag = "REVISION"
+)
+
+// RatingDeadline 완료된 의뢰만 평가 가능, 완료 후 window 가 지나면 평가 불가, 취소한 의뢰는 평가 불가
+func (o *Order) RatingDeadline(window time.Duration) *time.Time {
+	if o.DoneAt == nil || o.IsCanceled() {
+		return nil
+	}
+
+	deadline := o.DoneAt.Add(window)
+	return &deadline
+}
+
+func (o *Order) CanRate(now time.Time, window time.Duration) bool {
+	deadline := o.RatingDeadline(window)
+	return deadline != nil && now.Before(*deadline)
+}
+
+type CreateOrderRatingOption struct {
+	Order   Order
+	Score   uint8
+	Tags    []OrderRatingTag
+	Comment *string
+}
+
+func CreateOrderRating(option CreateOrderRatingOption) OrderRating {
+	tags := make([]string, 0, len(option.Tags))
+	seen := make(map[OrderRatingTag]bool, len(option.Tags))
+	for _, tag := range option.Tags {
+		if !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, string(tag))
+		}
+	}
+
+	return OrderRating{
+		OrderId:   option.Order.Id,
+		Orderer:   option.Order.Orderer,
+		Assignee:  option.Order.Assignee,
+		Score:     option.Score,
+		Tags:      strings.Join(tags, ","),
+		Comment:   option.Comment,
+		CreatedAt: time.Now(),
+	}
+}
+
+// OrderRating 완료된 의뢰에 대한 고객 평가, 의뢰당 한 번만 가능
+type OrderRating struct {
+	OrderId  uuid.UUID  `gorm:"type:char(36);primaryKey"`
+	Orderer  uuid.UUID  `gorm:"type:char(36);index;not null"`
+	Assignee *uuid.UUID `gorm:"type:char(36);index:idx_order_rating_assignee,priority:1"`
+
+	// Score 1 ~ 5
+	Score uint8 `gorm:"not null"`
+
+	// Tags OrderRatingTag, 콤마(,)로 구분
+	Tags      string    `gorm:"size:200;not null"`
+	Comment   *string   `gorm:"size:1000"`
+	CreatedAt time.Time `gorm:"type:datetime(6);index:idx_order_rating_assignee,priority:2;not null"`
+}
+
+func (OrderRating) TableName() string {
+	return "order_rating"
+}
+
+func (r *OrderRating) TagList() []OrderRatingTag {
+	var list []OrderRatingTag
+	for _, tag := range strings.Split(r.Tags, ",") {
+		if len(tag) > 0 {
+			list = append(list, OrderRatingTag(tag))
+		}
+	}
+	return list
+}
+
+func (r *OrderRating) IsLow(lowScore uint8) bool {
+	return r.Score <= lowScore
+}
+
+// EditorScore 편집자별 평점 집계, Recent 는 최근 기간, Previous 는 그 전 같은 길이의 기간
+type EditorScore struct {
+	ManagerId       uuid.UUID
+	Average         float64
+	Count           int64
+	RecentAverage   float64
+	RecentCount     int64
+	PreviousAverage float64
+	PreviousCount   int64
+}
+
+// Trend 최근 기간 평균 - 이전 기간 평균, 두 기간 중 하나라도 평가가 없으면 0
+func (s *EditorScore) Trend() float64 {
+	if s.RecentCount == 0 || s.PreviousCount == 0 {
+		return 0
+	}
+	return s.RecentAverage - s.PreviousAverage
+}
+
+type FetchEditorScoreOption struct {
+	// RecentFrom 최근 기간 시작, 이전 기간은 RecentFrom 에서 같은 길이만큼 앞
+	RecentFrom time.Time
+	Now        time.Time
+}
+
+type FetchOrderRatingOption struct {
+	Assignee *uuid.UUID
+
+	// MaxScore 이 점수 이하만
+	MaxScore *uint8
+}
+
+type OrderRatingRepository interface {
+	// Create 이미 평가한 의뢰면 ErrItemAlreadyExist
+	Create(ctx context.Context, rating *OrderRating) error
+
+	GetByOrderId(ctx context.Context, orderId uuid.UUID) (*OrderRating, error)
+
+	// Fetch 최신 순
+	Fetch(ctx context.Context, option FetchOrderRatingOption) ([]OrderRating, error)
+	FetchEditorScore(ctx context.Context, option FetchEditorScoreOption) ([]EditorScore, error)
+}
+
+type RateOrder struct {
+	UserId  uuid.UUID
+	OrderId uuid.UUID
+	Score   uint8
+	Tags    []OrderRatingTag
+	Comment *string
+}
+
+type GetMyOrderRating struct {
+	UserId  uuid.UUID
+	OrderId uuid.UUID
+}
+
+type FetchOrderRating struct {
+	UserId   uuid.UUID
+	Assignee *uuid.UUID
+	MaxScore *uint8
+}
+
+type OrderRatingInfo struct {
+	OrderId   uuid.UUID
+	Orderer   uuid.UUID
+	Assignee  *uuid.UUID
+	Score     uint8
+	Tags      []OrderRatingTag
+	Comment   *string
+	CreatedAt time.Time
+}
+
+type EditorScoreInfo struct {
+	EditorId        uuid.UUID
+	Name            string
+	Nickname        string
+	Average         float64
+	Count           int64
+	RecentAverage   float64
+	RecentCount     int64
+	PreviousAverage float64
+	PreviousCount   int64
+	Trend           float64
+}
+
+type OrderRatingUseCase interface {
+	// RateOrder 평점이 config.Rating.LowScore 이하면 최고 관리자에게 알림
+	RateOrder(ctx context.Context, in RateOrder) error
+	GetMyOrderRating(ctx context.Context, in GetMyOrderRating) (OrderRatingInfo, error)
+
+	Fetch(ctx context.Context, in FetchOrderRating) ([]OrderRatingInfo, error)
+	FetchEditorScore(ctx context.Context, userId uuid.UUID) ([]EditorScoreInfo, error)
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidReference = errors.New("invalid reference link")
+
+// MaxOrderReferenceCount 의뢰 하나에 첨부할 수 있는 참고 링크 수
+const MaxOrderReferenceCount = 20
+
+type OrderReferenceKind string
+
+const (
+	// OrderReferenceKindFootageFolder 원본 영상 폴더 (OneDrive, Google Drive 등)
+	OrderReferenceKindFootageFolder OrderReferenceKind = "FOOTAGE_FOLDER"
+
+	// OrderReferenceKindReferenceVideo 참고 영상
+	OrderReferenceKindReferenceVideo OrderReferenceKind = "REFERENCE_VIDEO"
+
+	// OrderReferenceKindThumbnail 참고 썸네일
+	OrderReferenceKindThumbnail OrderReferenceKind = "THUMBNAIL_REFERENCE"
+
+	// OrderReferenceKindMusic 배경 음악
+	OrderReferenceKindMusic OrderReferenceKind = "MUSIC"
+)
+
+func (k OrderReferenceKind) IsValid() bool {
+	switch k {
+	case OrderReferenceKindFootageFolder,
+		OrderReferenceKindReferenceVideo,
+		OrderReferenceKindThumbnail,
+		OrderReferenceKindMusic:
+		return true
+	}
+	return false
+}
+
+var youtubeIdPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+
+// ParseYoutubeId youtube.com/watch?v=, youtu.be/, /shorts/, /embed/, /live/ 형식의 URL 에서 영상 아이디를 꺼냄
+func ParseYoutubeId(link *url.URL) (id string, ok bool) {
+	host := strings.TrimPrefix(strings.ToLower(link.Hostname()), "www.")
+	path := strings.Trim(link.Path, "/")
+	switch host {
+	case "youtu.be":
+		id = strings.SplitN(path, "/", 2)[0]
+	case "youtube.com", "m.youtube.com", "music.youtube.com", "youtube-nocookie.com":
+		parts := strings.Split(path, "/")
+		switch {
+		case parts[0] == "watch":
+			id = link.Query().Get("v")
+		case len(parts) >= 2 && (parts[0] == "shorts" || parts[0] == "embed" || parts[0] == "live" || parts[0] == "v"):
+			id = parts[1]
+		}
+	}
+
+	if !youtubeIdPattern.MatchString(id) {
+		return "", false
+	}
+	return id, true
+}
+
+// OrderReference 의뢰에 첨부된 참고 링크, Seq 는 고객이 입력한 순서
+type OrderReference struct {
+	Id        uint64             `gorm:"primaryKey"`
+	OrderId   uuid.UUID          `gorm:"type:char(36);index:idx_order_reference_order,priority:1;not null"`
+	Seq       uint8              `gorm:"index:idx_order_reference_order,priority:2;not null"`
+	Kind      OrderReferenceKind `gorm:"size:30;not null"`
+	Url       string             `gorm:"size:2048;not null"`
+	YoutubeId *string            `gorm:"size:11"`
+	Label     *string            `gorm:"size:200"`
+}
+
+func (OrderReference) TableName() string {
+	return "order_reference"
+}
+
+func (r *OrderReference) Info() OrderReferenceInfo {
+	return OrderReferenceInfo{
+		Kind:      r.Kind,
+		Url:       r.Url,
+		YoutubeId: r.YoutubeId,
+		Label:     r.Label,
+	}
+}
+
+type OrderReferenceInput struct {
+	Kind  OrderReferenceKind
+	Url   string
+	Label *string
+}
+
+type OrderReferenceInfo struct {
+	Kind      OrderReferenceKind
+	Url       string
+	YoutubeId *string
+	Label     *string
+}
+
+// CreateOrderReferenceList http, https 링크만 허용, 유튜브 링크는 영상 아이디도 저장
+func CreateOrderReferenceList(orderId uuid.UUID, inputs []OrderReferenceInput) ([]OrderReference, error) {
+	if len(inputs) > MaxOrderReferenceCount {
+		return nil, ErrInvalidReference
+	}
+
+	list := make([]OrderReference, len(inputs))
+	for i, input := range inputs {
+		if !input.Kind.IsValid() {
+			return nil, ErrInvalidReference
+		}
+
+		raw := strings.TrimSpace(input.Url)
+		link, err := url.Parse(raw)
+		if err != nil || (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+			return nil, ErrInvalidReference
+		}
+
+		list[i] = OrderReference{
+			OrderId: orderId,
+			Seq:     uint8(i),
+			Kind:    input.Kind,
+			Url:     raw,
+			Label:   input.Label,
+		}
+
+		if id, ok := ParseYoutubeId(link); ok {
+			list[i].YoutubeId = &id
+		}
+	}
+	return list, nil
+}
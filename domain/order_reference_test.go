@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestParseYoutubeId(t *testing.T) {
+	tests := []struct {
+		name   string
+		link   string
+		want   string
+		wantOk bool
+	}{
+		{"watch", "https://www.youtube.com/watch?v=dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"watch with extra query", "https://youtube.com/watch?list=PL1&v=dQw4w9WgXcQ&t=42", "dQw4w9WgXcQ", true},
+		{"mobile", "https://m.youtube.com/watch?v=dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"music", "https://music.youtube.com/watch?v=dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"short link", "https://youtu.be/dQw4w9WgXcQ?t=10", "dQw4w9WgXcQ", true},
+		{"upper case host", "https://WWW.YouTube.com/watch?v=dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"shorts", "https://www.youtube.com/shorts/a_b-C1d2E3f", "a_b-C1d2E3f", true},
+		{"embed", "https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"live", "https://www.youtube.com/live/dQw4w9WgXcQ?feature=share", "dQw4w9WgXcQ", true},
+		{"old v path", "https://www.youtube.com/v/dQw4w9WgXcQ", "dQw4w9WgXcQ", true},
+		{"channel", "https://www.youtube.com/c/editfolio", "", false},
+		{"watch without id", "https://www.youtube.com/watch", "", false},
+		{"id too short", "https://youtu.be/dQw4w9WgXc", "", false},
+		{"id with invalid char", "https://www.youtube.com/watch?v=dQw4w9WgX.Q", "", false},
+		{"other host", "https://vimeo.com/dQw4w9WgXcQ", "", false},
+		{"lookalike host", "https://notyoutube.com/watch?v=dQw4w9WgXcQ", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			link, err := url.Parse(tt.link)
+			if err != nil {
+				t.Fatalf("url.Parse() error = %v", err)
+			}
+			got, ok := ParseYoutubeId(link)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("ParseYoutubeId(%s) = %q, %v, want %q, %v", tt.link, got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestCreateOrderReferenceList(t *testing.T) {
+	orderId := uuid.New()
+
+	tests := []struct {
+		name        string
+		inputs      []OrderReferenceInput
+		wantErr     error
+		wantYoutube []bool
+	}{
+		{"empty", nil, nil, []bool{}},
+		{
+			"youtube and folder",
+			[]OrderReferenceInput{
+				{Kind: OrderReferenceKindReferenceVideo, Url: " https://youtu.be/dQw4w9WgXcQ "},
+				{Kind: OrderReferenceKindFootageFolder, Url: "https://drive.google.com/drive/folders/abc"},
+			},
+			nil,
+			[]bool{true, false},
+		},
+		{"invalid kind", []OrderReferenceInput{{Kind: "VIDEO", Url: "https://youtu.be/dQw4w9WgXcQ"}}, ErrInvalidReference, nil},
+		{"not http", []OrderReferenceInput{{Kind: OrderReferenceKindMusic, Url: "ftp://example.com/a.mp3"}}, ErrInvalidReference, nil},
+		{"no host", []OrderReferenceInput{{Kind: OrderReferenceKindMusic, Url: "https:///a.mp3"}}, ErrInvalidReference, nil},
+		{"javascript", []OrderReferenceInput{{Kind: OrderReferenceKindMusic, Url: "javascript:alert(1)"}}, ErrInvalidReference, nil},
+		{"too many", make([]OrderReferenceInput, MaxOrderReferenceCount+1), ErrInvalidReference, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list, err := CreateOrderReferenceList(orderId, tt.inputs)
+			if err != tt.wantErr {
+				t.Fatalf("CreateOrderReferenceList() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(list) != len(tt.wantYoutube) {
+				t.Fatalf("len = %d, want %d", len(list), len(tt.wantYoutube))
+			}
+			for i, ref := range list {
+				if ref.OrderId != orderId || ref.Seq != uint8(i) {
+					t.Errorf("reference[%d] = %+v", i, ref)
+				}
+				if (ref.YoutubeId != nil) != tt.wantYoutube[i] {
+					t.Errorf("reference[%d].YoutubeId = %v, want set %v", i, ref.YoutubeId, tt.wantYoutube[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+)
+
+type OrderSchedulePauseReason string
+
+const (
+	// OrderSchedulePauseReasonUser 고객이나 관리자가 멈춤
+	OrderSchedulePauseReasonUser OrderSchedulePauseReason = "USER"
+
+	// OrderSchedulePauseReasonTicketEnded 구독 티켓이 끝나서 자동으로 멈춤
+	OrderSchedulePauseReasonTicketEnded OrderSchedulePauseReason = "TICKET_ENDED"
+)
+
+// IsValidOrderScheduleWeekdays 요일이 하나 이상이고 모두 0: 일요일 ~ 6: 토요일
+func IsValidOrderScheduleWeekdays(weekdays []uint8) bool {
+	if len(weekdays) == 0 {
+		return false
+	}
+
+	for _, weekday := range weekdays {
+		if weekday > uint8(time.Saturday) {
+			return false
+		}
+	}
+	return true
+}
+
+type CreateOrderScheduleOption struct {
+	Owner       uuid.UUID
+	Weekdays    []uint8
+	Minute      uint16
+	TypeId      uint8
+	Priority    OrderPriority
+	Requirement *string
+	CreatedBy   uuid.UUID
+	Now         time.Time
+	Location    *time.Location
+}
+
+func CreateOrderSchedule(option CreateOrderScheduleOption) OrderSchedule {
+	priority := option.Priority
+	if !priority.IsValid() {
+		priority = OrderPriorityNormal
+	}
+
+	schedule := OrderSchedule{
+		Id:          uuid.New(),
+		Owner:       option.Owner,
+		Minute:      option.Minute,
+		TypeId:      option.TypeId,
+		Priority:    priority,
+		Requirement: option.Requirement,
+		CreatedBy:   option.CreatedBy,
+		CreatedAt:   option.Now,
+	}
+	schedule.SetWeekdays(option.Weekdays)
+	schedule.NextRunAt = schedule.NextOccurrence(option.Now, option.Location)
+	return schedule
+}
+
+// OrderSchedule 구독 고객의 정기 의뢰 일정, 정해진 요일과 시각마다 의뢰 초안을 만들고 고객에게 업로드 알림
+type OrderSchedule struct {
+	Id    uuid.UUID `gorm:"type:char(36);primaryKey"`
+	Owner uuid.UUID `gorm:"type:char(36);index;not null"`
+
+	// Weekdays 요일 비트, 1 << time.Weekday
+	Weekdays uint8 `gorm:"not null"`
+
+	// Minute 0시부터 분 단위 (0 ~ 1439), config.Schedule.UTCOffsetMinutes 기준
+	Minute uint16 `gorm:"not null"`
+
+	// TypeId, Priority, Requirement 초안의 기본 값
+	TypeId      uint8         `gorm:"not null"`
+	Priority    OrderPriority `gorm:"size:10;not null;default:'NORMAL'"`
+	Requirement *string       `gorm:"size:2000"`
+
+	// NextRunAt 다음에 초안을 만들 시각
+	NextRunAt   time.Time                 `gorm:"type:datetime(6);index;not null"`
+	PausedAt    *time.Time                `gorm:"type:datetime(6)"`
+	PauseReason *OrderSchedulePauseReason `gorm:"size:20"`
+
+	CreatedBy uuid.UUID `gorm:"type:char(36);not null"`
+	CreatedAt time.Time `gorm:"type:datetime(6);not null"`
+}
+
+func (OrderSchedule) TableName() string {
+	return "order_schedule"
+}
+
+func (s *OrderSchedule) SetWeekdays(weekdays []uint8) {
+	s.Weekdays = 0
+	for _, weekday := range weekdays {
+		s.Weekdays |= 1 << weekday
+	}
+}
+
+func (s *OrderSchedule) WeekdayList() []uint8 {
+	var list []uint8
+	for weekday := uint8(time.Sunday); weekday <= uint8(time.Saturday); weekday++ {
+		if s.HasWeekday(time.Weekday(weekday)) {
+			list = append(list, weekday)
+		}
+	}
+	return list
+}
+
+func (s *OrderSchedule) HasWeekday(weekday time.Weekday) bool {
+	return s.Weekdays&(1<<uint8(weekday)) != 0
+}
+
+// NextOccurrence after 이후 첫 일정 시각, 요일이 없으면 일주일 뒤
+func (s *OrderSchedule) NextOccurrence(after time.Time, loc *time.Location) time.Time {
+	local := after.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	for day := 0; day <= 7; day++ {
+		at := midnight.AddDate(0, 0, day).Add(time.Duration(s.Minute) * time.Minute)
+		if at.After(after) && s.HasWeekday(at.Weekday()) {
+			return at
+		}
+	}
+	return after.AddDate(0, 0, 7)
+}
+
+// Advance 밀린 일정은 건너뛰고 now 이후 첫 일정으로 넘어감
+func (s *OrderSchedule) Advance(now time.Time, loc *time.Location) {
+	s.NextRunAt = s.NextOccurrence(now, loc)
+}
+
+func (s *OrderSchedule) IsPaused() bool {
+	return s.PausedAt != nil
+}
+
+func (s *OrderSchedule) Pause(reason OrderSchedulePauseReason) {
+	if s.IsPaused() {
+		return
+	}
+
+	s.PausedAt = pointer.Time(time.Now())
+	s.PauseReason = &reason
+}
+
+// Resume 멈춘 동안의 일정은 건너뜀
+func (s *OrderSchedule) Resume(now time.Time, loc *time.Location) {
+	if !s.IsPaused() {
+		return
+	}
+
+	s.PausedAt = nil
+	s.PauseReason = nil
+	s.Advance(now, loc)
+}
+
+type OrderDraftState string
+
+const (
+	// OrderDraftStatePending 고객 확인 대기
+	OrderDraftStatePending OrderDraftState = "PENDING"
+
+	// OrderDraftStateConfirmed 고객이 확인해서 의뢰가 됨
+	OrderDraftStateConfirmed OrderDraftState = "CONFIRMED"
+
+	// OrderDraftStateDismissed 고객이 이번 일정은 넘김
+	OrderDraftStateDismissed OrderDraftState = "DISMISSED"
+)
+
+func CreateOrderDraft(schedule OrderSchedule) OrderDraft {
+	return OrderDraft{
+		Id:           uuid.New(),
+		ScheduleId:   schedule.Id,
+		OccurrenceAt: schedule.NextRunAt,
+		Owner:        schedule.Owner,
+		TypeId:       schedule.TypeId,
+		Priority:     schedule.Priority,
+		Requirement:  schedule.Requirement,
+		State:        OrderDraftStatePending,
+		CreatedAt:    time.Now(),
+	}
+}
+
+// OrderDraft 정기 의뢰 일정으로 만들어진 의뢰 초안, 고객이 확인해야 의뢰가 되고 의뢰 횟수가 차감됨
+type OrderDraft struct {
+	Id           uuid.UUID       `gorm:"type:char(36);primaryKey"`
+	ScheduleId   uuid.UUID       `gorm:"type:char(36);uniqueIndex:ux_order_draft_occurrence,priority:1;not null"`
+	OccurrenceAt time.Time       `gorm:"type:datetime(6);uniqueIndex:ux_order_draft_occurrence,priority:2;not null"`
+	Owner        uuid.UUID       `gorm:"type:char(36);index:idx_order_draft_owner,priority:1;not null"`
+	TypeId       uint8           `gorm:"not null"`
+	Priority     OrderPriority   `gorm:"size:10;not null;default:'NORMAL'"`
+	Requirement  *string         `gorm:"size:2000"`
+	State        OrderDraftState `gorm:"size:20;index:idx_order_draft_owner,priority:2;not null"`
+	OrderId      *uuid.UUID      `gorm:"type:char(36)"`
+	CreatedAt    time.Time       `gorm:"type:datetime(6);not null"`
+	ConfirmedAt  *time.Time      `gorm:"type:datetime(6)"`
+}
+
+func (OrderDraft) TableName() string {
+	return "order_draft"
+}
+
+func (d *OrderDraft) IsPending() bool {
+	return d.State == OrderDraftStatePending
+}
+
+func (d *OrderDraft) Confirm(orderId uuid.UUID) {
+	d.State = OrderDraftStateConfirmed
+	d.OrderId = &orderId
+	d.ConfirmedAt = pointer.Time(time.Now())
+}
+
+func (d *OrderDraft) Dismiss() {
+	d.State = OrderDraftStateDismissed
+}
+
+type FetchOrderScheduleOption struct {
+	Owner *uuid.UUID
+}
+
+type OrderScheduleRepository interface {
+	Save(ctx context.Context, schedule *OrderSchedule) error
+	Delete(ctx context.Context, schedule *OrderSchedule) error
+	GetById(ctx context.Context, id uuid.UUID) (*OrderSchedule, error)
+
+	// Fetch 만든 순
+	Fetch(ctx context.Context, option FetchOrderScheduleOption) ([]OrderSchedule, error)
+
+	// FetchDue 멈추지 않았고 다음 일정 시각이 now 이전
+	FetchDue(ctx context.Context, now time.Time) ([]OrderSchedule, error)
+
+	// CreateDraft 같은 일정의 같은 시각 초안이 이미 있으면 ErrItemAlreadyExist
+	CreateDraft(ctx context.Context, draft *OrderDraft) error
+	SaveDraft(ctx context.Context, draft *OrderDraft) error
+
+	// ChangeDraftState 초안이 from 상태일 때만 바꿈, 바꿨으면 true
+	ChangeDraftState(ctx context.Context, id uuid.UUID, from, to OrderDraftState) (bool, error)
+	GetDraftById(ctx context.Context, id uuid.UUID) (*OrderDraft, error)
+
+	// FetchPendingDraftByOwner 오래된 순
+	FetchPendingDraftByOwner(ctx context.Context, owner uuid.UUID) ([]OrderDraft, error)
+}
+
+// AddOrderSchedule CustomerId 는 관리자가 고객 대신 만들 때만 사용
+type AddOrderSchedule struct {
+	UserId      uuid.UUID
+	CustomerId  *uuid.UUID
+	Weekdays    []uint8
+	Minute      uint16
+	TypeId      uint8
+	Priority    OrderPriority
+	Requirement *string
+}
+
+// UpdateOrderSchedule Paused 를 false 로 바꾸면 다음 일정부터 다시 시작, 사용 중인 티켓이 있어야함
+type UpdateOrderSchedule struct {
+	UserId      uuid.UUID
+	ScheduleId  uuid.UUID
+	Weekdays    []uint8
+	Minute      uint16
+	TypeId      uint8
+	Priority    OrderPriority
+	Requirement *string
+	Paused      bool
+}
+
+type DeleteOrderSchedule struct {
+	UserId     uuid.UUID
+	ScheduleId uuid.UUID
+}
+
+// FetchOrderSchedule 고객은 CustomerId 와 상관없이 자신의 일정만
+type FetchOrderSchedule struct {
+	UserId     uuid.UUID
+	CustomerId *uuid.UUID
+}
+
+// ConfirmOrderDraft Requirement 가 있으면 초안의 요구사항 대신 사용
+type ConfirmOrderDraft struct {
+	UserId      uuid.UUID
+	DraftId     uuid.UUID
+	Requirement *string
+}
+
+type DismissOrderDraft struct {
+	UserId  uuid.UUID
+	DraftId uuid.UUID
+}
+
+type OrderScheduleInfo struct {
+	Id          uuid.UUID
+	Owner       uuid.UUID
+	Weekdays    []uint8
+	Minute      uint16
+	TypeId      uint8
+	Priority    OrderPriority
+	Requirement *string
+	NextRunAt   time.Time
+	PausedAt    *time.Time
+	PauseReason *OrderSchedulePauseReason
+	CreatedBy   uuid.UUID
+	CreatedAt   time.Time
+}
+
+type OrderDraftInfo struct {
+	Id           uuid.UUID
+	ScheduleId   uuid.UUID
+	OccurrenceAt time.Time
+	TypeId       uint8
+	Priority     OrderPriority
+	Requirement  *string
+	CreatedAt    time.Time
+}
+
+type OrderScheduleUseCase interface {
+	AddOrderSchedule(ctx context.Context, in AddOrderSchedule) (uuid.UUID, error)
+	UpdateOrderSchedule(ctx context.Context, in UpdateOrderSchedule) error
+	DeleteOrderSchedule(ctx context.Context, in DeleteOrderSchedule) error
+	Fetch(ctx context.Context, in FetchOrderSchedule) ([]OrderScheduleInfo, error)
+
+	FetchMyOrderDraft(ctx context.Context, userId uuid.UUID) ([]OrderDraftInfo, error)
+
+	// ConfirmOrderDraft 일반 의뢰 요청과 같이 티켓의 의뢰 횟수를 차감
+	ConfirmOrderDraft(ctx context.Context, in ConfirmOrderDraft) (uuid.UUID, error)
+	DismissOrderDraft(ctx context.Context, in DismissOrderDraft) error
+
+	// RunDueSchedules 일정 시각이 된 일정마다 초안을 만들고 고객에게 알림, 티켓이 끝난 일정은 멈춤
+	RunDueSchedules(ctx context.Context) error
+}
@@ -1,6 +1,19 @@
 package domain
 
-import "context"
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrSystemOrderState 코드가 있는 시스템 상태는 지우거나 끄거나 옮길 수 없음
+	ErrSystemOrderState = errors.New("system order state")
+
+	// ErrOrderStateInUse 의뢰, 상태 기록, 하위 상태, 의뢰 종류에서 쓰고 있는 상태는 끌 수만 있음
+	ErrOrderStateInUse = errors.New("order state in use")
+)
 
 type OrderStateCode string
 
@@ -8,27 +21,92 @@ const (
 	OrderStateCodeNone OrderStateCode = "NONE"
 	OrderStateCodeDefault OrderStateCode = "DEFAULT"
 	OrderStateCodeTake OrderStateCode = "TAKE"
+	// OrderStateCodeThumbnailTake 썸네일 의뢰 종류의 맡은 상태, 코드로 찾을 때 영상 의뢰의 TAKE 와 섞이지 않도록 따로 둠
+	OrderStateCodeThumbnailTake OrderStateCode = "THUMBNAIL_TAKE"
 	OrderStateCodeRequestEdit OrderStateCode = "REQUEST_EDIT"
 	OrderStateCodeEditDone OrderStateCode = "EDIT_DONE"
 	OrderStateCodeDone OrderStateCode = "DONE"
+	OrderStateCodeCanceled OrderStateCode = "CANCELED"
 )
 
+type CreateOrderStateOption struct {
+	Content     string
+	LongContent string
+	Emoji       string
+	ParentId    *uint8
+	GroupId     *uint8
+	SortOrder   uint16
+}
+
+// CreateOrderState 관리자가 추가하는 상태는 모두 NONE 코드
+func CreateOrderState(option CreateOrderStateOption) OrderState {
+	return OrderState{
+		Code:        OrderStateCodeNone,
+		Content:     option.Content,
+		LongContent: option.LongContent,
+		Emoji:       option.Emoji,
+		ParentId:    option.ParentId,
+		GroupId:     option.GroupId,
+		SortOrder:   option.SortOrder,
+		Active:      true,
+	}
+}
+
 type OrderState struct {
 	Id          uint8          `gorm:"primaryKey"`
 	Code        OrderStateCode `gorm:"size:20;index;not null"`
 	Content     string         `gorm:"size:150;index;not null"`
 	LongContent string         `gorm:"size:300;not null"`
-	Emoji       string         `gorm:"size:1;not null"`
+	Emoji       string         `gorm:"size:16;not null"`
 	ParentId    *uint8         `gorm:"index"`
 	GroupId     *uint8         `gorm:"index"`
 	Parent      *OrderState    `gorm:"foreignKey:ParentId"`
 	Orders      []Order        `gorm:"foreignKey:State"`
+
+	// SortOrder 같은 부모 아래에서 작은 순, 같으면 아이디 순
+	SortOrder uint16 `gorm:"not null;default:0"`
+
+	// Active 꺼진 상태로는 의뢰를 옮길 수 없음, 이미 그 상태인 의뢰는 그대로
+	Active bool `gorm:"not null;default:true"`
 }
 
 func (OrderState) TableName() string {
 	return "order_state"
 }
 
+// IsSystem 코드로 찾아 쓰는 상태
+func (s *OrderState) IsSystem() bool {
+	return s.Code != OrderStateCodeNone
+}
+
+// IsTake 편집자가 맡고 시안을 검토하는 상태, 의뢰 종류마다 뿌리 상태가 따로 있음
+func (s *OrderState) IsTake() bool {
+	return s.Code == OrderStateCodeTake || s.Code == OrderStateCodeThumbnailTake
+}
+
+func (s *OrderState) Update(option CreateOrderStateOption, active bool) {
+	s.Content = option.Content
+	s.LongContent = option.LongContent
+	s.Emoji = option.Emoji
+	s.ParentId = option.ParentId
+	s.GroupId = option.GroupId
+	s.SortOrder = option.SortOrder
+	s.Active = active
+}
+
+// OrderStateTranslation 기본 언어가 아닌 언어의 이름, 설명, 번역이 없는 언어는 기본 내용을 보여줌
+type OrderStateTranslation struct {
+	StateId     uint8  `gorm:"primaryKey;autoIncrement:false"`
+	Lang        string `gorm:"primaryKey;size:8"`
+	Content     string `gorm:"size:150;not null"`
+	LongContent string `gorm:"size:300;not null"`
+}
+
+func (OrderStateTranslation) TableName() string {
+	return "order_state_translation"
+}
+
+// OrderStateRepository 상태를 읽을 때 이름, 설명은 context 의 요청 언어로 번역되어 있음
 type OrderStateRepository interface {
 	GetById(ctx context.Context, id uint8) (*OrderState, error)
 
@@ -38,6 +116,19 @@ type OrderStateRepository interface {
 	GetByCode(ctx context.Context, code OrderStateCode) (*OrderState, error)
 	FetchByParentId(ctx context.Context, parentId uint8) ([]OrderState, error)
 	FetchByGroupId(ctx context.Context, groupId uint8) ([]OrderState, error)
+
+	Save(ctx context.Context, state *OrderState) error
+	Delete(ctx context.Context, id uint8) error
+
+	// IsInUse 의뢰, 상태 기록, 하위 상태, 의뢰 종류 중 한 곳이라도 쓰고 있는지 여부
+	IsInUse(ctx context.Context, id uint8) (bool, error)
+
+	// UpdateSortOrder ids 순서대로 0 부터 정렬 순서를 매김
+	UpdateSortOrder(ctx context.Context, ids []uint8) error
+
+	FetchTranslations(ctx context.Context) ([]OrderStateTranslation, error)
+	SaveTranslation(ctx context.Context, translation *OrderStateTranslation) error
+	DeleteTranslation(ctx context.Context, stateId uint8, lang string) error
 }
 
 type OrderStateInfo struct {
@@ -45,7 +136,89 @@ type OrderStateInfo struct {
 	Content string
 }
 
+type OrderStateDetailInfo struct {
+	Id          uint8
+	Code        OrderStateCode
+	Content     string
+	LongContent string
+	Emoji       string
+	ParentId    *uint8
+	GroupId     *uint8
+	SortOrder   uint16
+	Active      bool
+
+	Translations []OrderStateTranslationInfo
+}
+
+type OrderStateTranslationInfo struct {
+	Lang        string
+	Content     string
+	LongContent string
+}
+
+type AddOrderState struct {
+	UserId      uuid.UUID
+	Content     string
+	LongContent string
+	Emoji       string
+	ParentId    *uint8
+	GroupId     *uint8
+	SortOrder   uint16
+}
+
+type UpdateOrderState struct {
+	UserId      uuid.UUID
+	StateId     uint8
+	Content     string
+	LongContent string
+	Emoji       string
+	ParentId    *uint8
+	GroupId     *uint8
+	SortOrder   uint16
+	Active      bool
+}
+
+type SortOrderState struct {
+	UserId   uuid.UUID
+	StateIds []uint8
+}
+
+type DeleteOrderState struct {
+	UserId  uuid.UUID
+	StateId uint8
+}
+
+type SaveOrderStateTranslation struct {
+	UserId      uuid.UUID
+	StateId     uint8
+	Lang        string
+	Content     string
+	LongContent string
+}
+
+type DeleteOrderStateTranslation struct {
+	UserId  uuid.UUID
+	StateId uint8
+	Lang    string
+}
+
 type OrderStateUseCase interface {
 	FetchFull(ctx context.Context) ([]OrderStateInfo, error)
 	FetchByParentId(ctx context.Context, parentId uint8) ([]OrderStateInfo, error)
+
+	// FetchDetail 꺼진 상태까지 모두, 최고 관리자만
+	FetchDetail(ctx context.Context, userId uuid.UUID) ([]OrderStateDetailInfo, error)
+
+	AddOrderState(ctx context.Context, in AddOrderState) (uint8, error)
+
+	// UpdateOrderState 시스템 상태는 이름, 설명, 이모지, 정렬 순서만 바꿀 수 있음
+	UpdateOrderState(ctx context.Context, in UpdateOrderState) error
+	SortOrderState(ctx context.Context, in SortOrderState) error
+
+	// DeleteOrderState 시스템 상태는 ErrSystemOrderState, 쓰고 있는 상태는 ErrOrderStateInUse
+	DeleteOrderState(ctx context.Context, in DeleteOrderState) error
+
+	// SaveOrderStateTranslation 기본 언어는 상태의 내용 자체라 번역으로 넣을 수 없음, 지원하지 않는 언어와 함께 ErrWeirdData
+	SaveOrderStateTranslation(ctx context.Context, in SaveOrderStateTranslation) error
+	DeleteOrderStateTranslation(ctx context.Context, in DeleteOrderStateTranslation) error
 }
\ No newline at end of file
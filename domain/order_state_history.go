@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderStateActor string
+
+const (
+	// OrderStateActorUser 고객, 편집자, 관리자가 직접 바꿈
+	OrderStateActorUser OrderStateActor = "USER"
+
+	// OrderStateActorSystem 자동 완료처럼 예약 작업이 바꿈
+	OrderStateActorSystem OrderStateActor = "SYSTEM"
+)
+
+// OrderStateHistory 의뢰 상태가 바뀐 기록, 고객 의뢰 상세의 상태 타임라인에 사용
+type OrderStateHistory struct {
+	Id        uint64          `gorm:"primaryKey"`
+	OrderId   uuid.UUID       `gorm:"type:char(36);index:idx_order_state_history_order,priority:1;not null"`
+	StateId   uint8           `gorm:"not null"`
+	ChangedAt time.Time       `gorm:"type:datetime(6);index:idx_order_state_history_order,priority:2;not null"`
+	Actor     OrderStateActor `gorm:"size:10;not null;default:'USER'"`
+}
+
+func (OrderStateHistory) TableName() string {
+	return "order_state_history"
+}
+
+type OrderStateTimelineInfo struct {
+	StateId   uint8
+	Content   string
+	Emoji     string
+	ChangedAt time.Time
+	Actor     OrderStateActor
+}
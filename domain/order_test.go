@@ -0,0 +1,246 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+)
+
+func TestOrder_ChangeState(t *testing.T) {
+	changedAt := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		from        uint8
+		to          uint8
+		wantChanged bool
+	}{
+		{"changed", 1, 2, true},
+		{"same state", 2, 2, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := Order{Id: uuid.New(), State: tt.from, StateChangedAt: &changedAt}
+			order.ChangeState(tt.to)
+
+			if order.State != tt.to {
+				t.Fatalf("State = %d, want %d", order.State, tt.to)
+			}
+
+			if !tt.wantChanged {
+				if !order.StateChangedAt.Equal(changedAt) || len(order.NewStateHistories) != 0 {
+					t.Fatalf("unchanged state recorded, StateChangedAt = %v, histories = %d",
+						order.StateChangedAt, len(order.NewStateHistories))
+				}
+				return
+			}
+
+			if !order.StateChangedAt.After(changedAt) {
+				t.Fatalf("StateChangedAt = %v, want after %v", order.StateChangedAt, changedAt)
+			}
+			if len(order.NewStateHistories) != 1 {
+				t.Fatalf("histories = %d, want 1", len(order.NewStateHistories))
+			}
+
+			history := order.NewStateHistories[0]
+			if history.OrderId != order.Id || history.StateId != tt.to ||
+				!history.ChangedAt.Equal(*order.StateChangedAt) {
+				t.Fatalf("history = %+v", history)
+			}
+		})
+	}
+}
+
+func TestOrder_StateHistories(t *testing.T) {
+	order := CreateOrder(CreateOrderOption{State: 1})
+	order.ChangeState(2)
+	order.ChangeState(3)
+
+	if len(order.NewStateHistories) != 3 {
+		t.Fatalf("histories = %d, want 3", len(order.NewStateHistories))
+	}
+	for i, want := range []uint8{1, 2, 3} {
+		if h := order.NewStateHistories[i]; h.StateId != want || h.Actor != OrderStateActorUser {
+			t.Errorf("history[%d] = %+v, want state %d by user", i, h, want)
+		}
+	}
+}
+
+func TestOrder_ChangeStateBy(t *testing.T) {
+	tests := []struct {
+		name  string
+		actor OrderStateActor
+	}{
+		{"user", OrderStateActorUser},
+		{"system", OrderStateActorSystem},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := Order{Id: uuid.New(), State: 2}
+			order.ChangeStateBy(6, tt.actor)
+
+			if len(order.NewStateHistories) != 1 {
+				t.Fatalf("histories = %d, want 1", len(order.NewStateHistories))
+			}
+			if h := order.NewStateHistories[0]; h.StateId != 6 || h.Actor != tt.actor {
+				t.Fatalf("history = %+v, want state 6 by %s", h, tt.actor)
+			}
+		})
+	}
+}
+
+func TestOrder_StateSince(t *testing.T) {
+	orderedAt := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	changedAt := orderedAt.Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		stateChangedAt *time.Time
+		want           time.Time
+	}{
+		{"changed", &changedAt, changedAt},
+		{"legacy order", nil, orderedAt},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := Order{OrderedAt: orderedAt, StateChangedAt: tt.stateChangedAt}
+			if got := order.StateSince(); !got.Equal(tt.want) {
+				t.Errorf("StateSince() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrder_Deadline(t *testing.T) {
+	dueDate := time.Date(2021, 11, 3, 0, 0, 0, 0, time.UTC)
+	doneAt := time.Date(2021, 11, 2, 0, 0, 0, 0, time.UTC)
+	at := func(day, hour int) time.Time {
+		return time.Date(2021, 11, day, hour, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		dueDate     *time.Time
+		doneAt      *time.Time
+		now         time.Time
+		wantOverdue bool
+		wantAtRisk  bool
+	}{
+		{"no due date", nil, nil, at(10, 0), false, false},
+		{"plenty of time", &dueDate, nil, at(1, 0), false, false},
+		{"at risk", &dueDate, nil, at(3, 12), false, true},
+		{"last moment of due date", &dueDate, nil, at(3, 23), false, true},
+		{"overdue", &dueDate, nil, at(4, 1), true, false},
+		{"done is never overdue", &dueDate, &doneAt, at(10, 0), false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := Order{DueDate: tt.dueDate, DoneAt: tt.doneAt}
+			if got := order.IsOverdue(tt.now); got != tt.wantOverdue {
+				t.Errorf("IsOverdue() = %v, want %v", got, tt.wantOverdue)
+			}
+			if got := order.IsAtRisk(tt.now, 24*time.Hour); got != tt.wantAtRisk {
+				t.Errorf("IsAtRisk() = %v, want %v", got, tt.wantAtRisk)
+			}
+		})
+	}
+}
+
+func TestDefaultDueDate(t *testing.T) {
+	orderedAt := time.Date(2021, 11, 1, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		dueDays int
+		want    *time.Time
+	}{
+		{"unset", 0, nil},
+		{"negative", -1, nil},
+		{"date only", 3, pointer.Time(time.Date(2021, 11, 4, 0, 0, 0, 0, time.UTC))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DefaultDueDate(orderedAt, tt.dueDays)
+			if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+				t.Errorf("DefaultDueDate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrder_ChangePriority(t *testing.T) {
+	orderedAt := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	day := func(d int) *time.Time {
+		return pointer.Time(time.Date(2021, 11, d, 0, 0, 0, 0, time.UTC))
+	}
+
+	tests := []struct {
+		name    string
+		dueDate *time.Time
+		dueDays int
+		want    *time.Time
+	}{
+		{"earlier due date", day(8), 3, day(4)},
+		{"keep earlier due date", day(3), 7, day(3)},
+		{"set missing due date", nil, 5, day(6)},
+		{"no default due date", day(8), 0, day(8)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := Order{OrderedAt: orderedAt, DueDate: tt.dueDate, Priority: OrderPriorityNormal}
+			order.ChangePriority(OrderPriorityRush, tt.dueDays)
+			if order.Priority != OrderPriorityRush {
+				t.Errorf("Priority = %s, want %s", order.Priority, OrderPriorityRush)
+			}
+			if (order.DueDate == nil) != (tt.want == nil) || (order.DueDate != nil && !order.DueDate.Equal(*tt.want)) {
+				t.Errorf("DueDate = %v, want %v", order.DueDate, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderTicket_AllowsPriority(t *testing.T) {
+	tests := []struct {
+		maxPriority OrderPriority
+		priority    OrderPriority
+		want        bool
+	}{
+		{"", OrderPriorityNormal, true},
+		{"", OrderPriorityHigh, false},
+		{OrderPriorityHigh, OrderPriorityHigh, true},
+		{OrderPriorityHigh, OrderPriorityRush, false},
+		{OrderPriorityRush, OrderPriorityRush, true},
+	}
+	for _, tt := range tests {
+		ticket := OrderTicket{MaxPriority: tt.maxPriority}
+		if got := ticket.AllowsPriority(tt.priority); got != tt.want {
+			t.Errorf("AllowsPriority(max %q, %s) = %v, want %v", tt.maxPriority, tt.priority, got, tt.want)
+		}
+	}
+}
+
+func TestOrder_IsParticipant(t *testing.T) {
+	orderer, assignee := uuid.New(), uuid.New()
+	order := Order{Id: uuid.New(), Orderer: orderer, Assignee: &assignee}
+
+	tests := []struct {
+		name string
+		user User
+		want bool
+	}{
+		{"orderer", User{Id: orderer, Role: CustomerUserRole}, true},
+		{"other customer", User{Id: uuid.New(), Role: CustomerUserRole}, false},
+		{"assignee", User{Id: assignee, Role: AdminUserRole}, true},
+		{"other admin", User{Id: uuid.New(), Role: AdminUserRole}, false},
+		{"super admin", User{Id: uuid.New(), Role: SuperAdminUserRole}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := order.IsParticipant(tt.user); got != tt.want {
+				t.Fatalf("IsParticipant() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,43 +9,87 @@ import (
 )
 
 type CreateOrderTicketOption struct {
-	ExOrderId       string
-	OwnerId         uuid.UUID
-	TotalOrderCount uint8
-	EditCount       uint8
-	StartAt         *time.Time
-	EndAt           *time.Time
+	ExOrderId            string
+	OwnerId              uuid.UUID
+	TotalOrderCount      uint8
+	EditCount            uint8
+	ConcurrentOrderCount uint8
+	MaxPriority          OrderPriority
+	StartAt              *time.Time
+	EndAt                *time.Time
 }
 
 func CreateOrderTicket(option CreateOrderTicketOption) OrderTicket {
+	concurrent := option.ConcurrentOrderCount
+	if concurrent == 0 {
+		concurrent = 1
+	}
+
+	maxPriority := option.MaxPriority
+	if !maxPriority.IsValid() {
+		maxPriority = OrderPriorityNormal
+	}
+
 	return OrderTicket{
-		Id:              uuid.New(),
-		ExOrderId:       option.ExOrderId,
-		OwnerId:         option.OwnerId,
-		TotalOrderCount: option.TotalOrderCount,
-		EditCount:       option.EditCount,
-		CreatedAt:       time.Now(),
-		StartAt:         option.StartAt,
-		EndAt:           option.EndAt,
+		Id:                   uuid.New(),
+		ExOrderId:            option.ExOrderId,
+		OwnerId:              option.OwnerId,
+		TotalOrderCount:      option.TotalOrderCount,
+		EditCount:            option.EditCount,
+		ConcurrentOrderCount: concurrent,
+		MaxPriority:          maxPriority,
+		CreatedAt:            time.Now(),
+		StartAt:              option.StartAt,
+		EndAt:                option.EndAt,
 	}
 }
 
 type OrderTicket struct {
-	Id              uuid.UUID  `gorm:"type:char(36);primaryKey"`
-	ExOrderId       string     `gorm:"size:90;unique;not null"`
-	OwnerId         uuid.UUID  `gorm:"type:char(36);index;not null"`
-	OrderCount      uint8      `gorm:"not null"`
-	TotalOrderCount uint8      `gorm:"not null"`
-	EditCount       uint8      `gorm:"not null"`
-	CreatedAt       time.Time  `gorm:"size:datetime(6);index;not null"`
-	StartAt         *time.Time `gorm:"size:datetime(6);index"`
-	EndAt           *time.Time `gorm:"type:datetime(6);index"`
+	Id                   uuid.UUID     `gorm:"type:char(36);primaryKey"`
+	ExOrderId            string        `gorm:"size:90;unique;not null"`
+	OwnerId              uuid.UUID     `gorm:"type:char(36);index;not null"`
+	OrderCount           uint8         `gorm:"not null"`
+	TotalOrderCount      uint8         `gorm:"not null"`
+	EditCount            uint8         `gorm:"not null"`
+	ConcurrentOrderCount uint8         `gorm:"not null;default:1"`                // 구독 플랜별 동시 진행 가능한 의뢰 수
+	MaxPriority          OrderPriority `gorm:"size:10;not null;default:'NORMAL'"` // 구독 플랜별 고객이 고를 수 있는 최고 우선순위
+	CreatedAt            time.Time     `gorm:"size:datetime(6);index;not null"`
+	StartAt              *time.Time    `gorm:"size:datetime(6);index"`
+	EndAt                *time.Time    `gorm:"type:datetime(6);index"`
+	Version              uint          `gorm:"not null"` // 저장할 때마다 올라감, 다른 곳에서 먼저 저장했으면 저장 실패
 }
 
 func (o *OrderTicket) UseOrder() {
 	o.OrderCount++
 }
 
+// UseOrderWithExtra 급한 의뢰는 extra 만큼 의뢰 횟수를 더 차감
+func (o *OrderTicket) UseOrderWithExtra(extra uint8) {
+	o.OrderCount += 1 + extra
+}
+
+// RefundOrder 취소된 의뢰가 차감했던 횟수를 돌려줌
+func (o *OrderTicket) RefundOrder(count uint8) {
+	if count > o.OrderCount {
+		count = o.OrderCount
+	}
+	o.OrderCount -= count
+}
+
+// HasOrderCount extra 만큼 추가 차감해도 남은 의뢰 횟수가 충분한지 여부
+func (o OrderTicket) HasOrderCount(extra uint8) bool {
+	return int(o.RemainingOrderCount()) >= 1+int(extra)
+}
+
+// AllowsPriority 고객이 직접 고른 우선순위가 플랜에서 허용되는지 여부
+func (o OrderTicket) AllowsPriority(priority OrderPriority) bool {
+	maxPriority := o.MaxPriority
+	if !maxPriority.IsValid() {
+		maxPriority = OrderPriorityNormal
+	}
+	return priority.Rank() <= maxPriority.Rank()
+}
+
 func (o OrderTicket) RemainingOrderCount() uint8 {
 	return o.TotalOrderCount - o.OrderCount
 }
@@ -54,6 +98,33 @@ func (o OrderTicket) IsEmptyOrderCount() bool {
 	return o.RemainingOrderCount() == 0
 }
 
+// IsFullConcurrentOrder 진행중인 의뢰 수가 플랜의 동시 진행 한도에 도달 했는지 여부
+func (o OrderTicket) IsFullConcurrentOrder(processingCount int64) bool {
+	return processingCount >= int64(o.concurrentOrderLimit())
+}
+
+// FreeActiveSlot 동시 진행 한도 안에서 진행 중인 의뢰가 쓰지 않는 자리 번호, 1부터 시작
+func (o OrderTicket) FreeActiveSlot(usedSlots []uint8) (slot uint8, ok bool) {
+	used := make(map[uint8]bool, len(usedSlots))
+	for _, s := range usedSlots {
+		used[s] = true
+	}
+
+	for slot = 1; slot <= o.concurrentOrderLimit(); slot++ {
+		if !used[slot] {
+			return slot, true
+		}
+	}
+	return 0, false
+}
+
+func (o OrderTicket) concurrentOrderLimit() uint8 {
+	if o.ConcurrentOrderCount == 0 {
+		return 1
+	}
+	return o.ConcurrentOrderCount
+}
+
 type OrderTicketRepository interface {
 	Save(ctx context.Context, orderTicket *OrderTicket) error
 	Transaction(ctx context.Context, fn func(orderTicketRepo OrderTicketTxRepository) error, options ...*sql.TxOptions) error
@@ -67,6 +138,12 @@ type OrderTicketRepository interface {
 type OrderTicketTxRepository interface {
 	OrderTicketRepository
 	gormx.Tx
+
+	// LockByOwnerIdBetweenStartAndEnd 트랜잭션이 끝날 때까지 다른 의뢰 요청이 같은 티켓을 읽지 못하게 잠금 (SELECT ... FOR UPDATE)
+	LockByOwnerIdBetweenStartAndEnd(ctx context.Context, id uuid.UUID, at time.Time) (*OrderTicket, error)
+
+	// LockById 의뢰 취소로 횟수를 돌려줄 때 의뢰 요청과 겹치지 않도록 잠금 (SELECT ... FOR UPDATE)
+	LockById(ctx context.Context, id uuid.UUID) (*OrderTicket, error)
 }
 
 type SubscribeUnit string
@@ -77,12 +154,14 @@ const (
 )
 
 type CreateSubscribeTicket struct {
-	ExOrderId  string
-	Username   string
-	Value      uint16
-	Unit       SubscribeUnit
-	OrderCount uint8
-	EditCount  uint8
+	ExOrderId            string
+	Username             string
+	Value                uint16
+	Unit                 SubscribeUnit
+	OrderCount           uint8
+	EditCount            uint8
+	ConcurrentOrderCount uint8
+	MaxPriority          OrderPriority
 }
 
 type OrderTicketUseCase interface {
@@ -0,0 +1,26 @@
+package domain
+
+import "testing"
+
+func TestOrderTicket_RefundOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		orderCount uint8
+		refund     uint8
+		want       uint8
+	}{
+		{"single", 3, 1, 2},
+		{"with extra", 3, 3, 0},
+		{"never below zero", 1, 3, 0},
+		{"nothing", 2, 0, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ticket := OrderTicket{OrderCount: tt.orderCount, TotalOrderCount: 5}
+			ticket.RefundOrder(tt.refund)
+			if ticket.OrderCount != tt.want {
+				t.Errorf("OrderCount = %d, want %d", ticket.OrderCount, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type OrderTypeCode string
+
+const (
+	OrderTypeCodeShorts    OrderTypeCode = "SHORTS"
+	OrderTypeCodeLongForm  OrderTypeCode = "LONG_FORM"
+	OrderTypeCodeThumbnail OrderTypeCode = "THUMBNAIL"
+)
+
+type CreateOrderTypeOption struct {
+	Code             OrderTypeCode
+	Name             string
+	Description      string
+	DefaultEditCount uint8
+	TurnaroundDays   int
+	CreditCost       uint8
+	RootStateId      uint8
+}
+
+func CreateOrderType(option CreateOrderTypeOption) OrderType {
+	return OrderType{
+		Code:             option.Code,
+		Name:             option.Name,
+		Description:      option.Description,
+		DefaultEditCount: option.DefaultEditCount,
+		TurnaroundDays:   option.TurnaroundDays,
+		CreditCost:       option.CreditCost,
+		RootStateId:      option.RootStateId,
+		Active:           true,
+	}
+}
+
+// OrderType 의뢰 종류 (숏폼, 롱폼, 썸네일 등)
+type OrderType struct {
+	Id          uint8         `gorm:"primaryKey"`
+	Code        OrderTypeCode `gorm:"size:20;unique;not null"`
+	Name        string        `gorm:"size:60;not null"`
+	Description string        `gorm:"size:300;not null"`
+
+	// DefaultEditCount 수정 가능 횟수, 0 이면 구독 플랜의 수정 횟수 사용
+	DefaultEditCount uint8 `gorm:"not null"`
+
+	// TurnaroundDays 기본 작업 기간, 의뢰일로부터 며칠
+	TurnaroundDays int `gorm:"not null"`
+
+	// CreditCost 의뢰 한 건에 차감할 의뢰 횟수
+	CreditCost uint8 `gorm:"not null;default:1"`
+
+	// RootStateId 편집자가 의뢰를 맡았을 때의 상태, 이 상태의 하위 상태로 작업이 진행됨
+	RootStateId uint8 `gorm:"not null"`
+
+	// Active 고객이 고를 수 있는지 여부
+	Active bool `gorm:"not null"`
+}
+
+func (OrderType) TableName() string {
+	return "order_type"
+}
+
+// Cost 0 으로 설정되어 있어도 최소 한 건은 차감
+func (t *OrderType) Cost() uint8 {
+	if t.CreditCost == 0 {
+		return 1
+	}
+	return t.CreditCost
+}
+
+// EditCount 종류별 수정 횟수가 없으면 구독 플랜의 수정 횟수
+func (t *OrderType) EditCount(ticketEditCount uint8) uint8 {
+	if t.DefaultEditCount == 0 {
+		return ticketEditCount
+	}
+	return t.DefaultEditCount
+}
+
+// DueDays 종류별 작업 기간과 우선순위별 마감일 중 짧은 쪽, 0 이하는 정하지 않은 것으로 봄
+func (t *OrderType) DueDays(priorityDueDays int) int {
+	switch {
+	case t.TurnaroundDays <= 0:
+		return priorityDueDays
+	case priorityDueDays <= 0:
+		return t.TurnaroundDays
+	case priorityDueDays < t.TurnaroundDays:
+		return priorityDueDays
+	}
+	return t.TurnaroundDays
+}
+
+func (t *OrderType) Update(option CreateOrderTypeOption, active bool) {
+	t.Name = option.Name
+	t.Description = option.Description
+	t.DefaultEditCount = option.DefaultEditCount
+	t.TurnaroundDays = option.TurnaroundDays
+	t.CreditCost = option.CreditCost
+	t.RootStateId = option.RootStateId
+	t.Active = active
+}
+
+type OrderTypeRepository interface {
+	Save(ctx context.Context, orderType *OrderType) error
+
+	GetById(ctx context.Context, id uint8) (*OrderType, error)
+	GetByCode(ctx context.Context, code OrderTypeCode) (*OrderType, error)
+	FetchAll(ctx context.Context, onlyActive bool) ([]OrderType, error)
+}
+
+type AddOrderType struct {
+	UserId           uuid.UUID
+	Code             OrderTypeCode
+	Name             string
+	Description      string
+	DefaultEditCount uint8
+	TurnaroundDays   int
+	CreditCost       uint8
+	RootStateId      uint8
+}
+
+type UpdateOrderType struct {
+	UserId           uuid.UUID
+	TypeId           uint8
+	Name             string
+	Description      string
+	DefaultEditCount uint8
+	TurnaroundDays   int
+	CreditCost       uint8
+	RootStateId      uint8
+	Active           bool
+}
+
+type OrderTypeInfo struct {
+	Id               uint8
+	Code             OrderTypeCode
+	Name             string
+	Description      string
+	DefaultEditCount uint8
+	TurnaroundDays   int
+	CreditCost       uint8
+	RootStateId      uint8
+	Active           bool
+}
+
+type OrderTypeUseCase interface {
+	// Fetch 고객에게는 고를 수 있는 종류만 보여줌
+	Fetch(ctx context.Context, userId uuid.UUID) ([]OrderTypeInfo, error)
+
+	AddOrderType(ctx context.Context, in AddOrderType) (uint8, error)
+	UpdateOrderType(ctx context.Context, in UpdateOrderType) error
+}
@@ -0,0 +1,106 @@
+package domain
+
+import "testing"
+
+func TestOrder_TakeStateId(t *testing.T) {
+	const defaultTake = 2
+
+	tests := []struct {
+		name      string
+		typeId    uint8
+		orderType *OrderType
+		want      uint8
+	}{
+		{"no type", 0, nil, defaultTake},
+		{"type root", 3, &OrderType{Id: 3, RootStateId: 9}, 9},
+		{"other type", 1, &OrderType{Id: 3, RootStateId: 9}, defaultTake},
+		{"type without root", 3, &OrderType{Id: 3}, defaultTake},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			order := Order{TypeId: tt.typeId}
+			if got := order.TakeStateId(tt.orderType, defaultTake); got != tt.want {
+				t.Errorf("TakeStateId() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderState_IsTake(t *testing.T) {
+	tests := []struct {
+		code OrderStateCode
+		want bool
+	}{
+		{OrderStateCodeTake, true},
+		{OrderStateCodeThumbnailTake, true},
+		{OrderStateCodeDefault, false},
+		{OrderStateCodeNone, false},
+		{OrderStateCodeRequestEdit, false},
+	}
+	for _, tt := range tests {
+		state := OrderState{Code: tt.code}
+		if got := state.IsTake(); got != tt.want {
+			t.Errorf("IsTake(%s) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestOrderType_Cost(t *testing.T) {
+	tests := []struct {
+		creditCost uint8
+		want       uint8
+	}{
+		{0, 1},
+		{1, 1},
+		{3, 3},
+	}
+	for _, tt := range tests {
+		orderType := OrderType{CreditCost: tt.creditCost}
+		if got := orderType.Cost(); got != tt.want {
+			t.Errorf("Cost(%d) = %d, want %d", tt.creditCost, got, tt.want)
+		}
+	}
+}
+
+func TestOrderType_EditCount(t *testing.T) {
+	tests := []struct {
+		name             string
+		defaultEditCount uint8
+		ticketEditCount  uint8
+		want             uint8
+	}{
+		{"plan edit count", 0, 2, 2},
+		{"type edit count", 3, 2, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderType := OrderType{DefaultEditCount: tt.defaultEditCount}
+			if got := orderType.EditCount(tt.ticketEditCount); got != tt.want {
+				t.Errorf("EditCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderType_DueDays(t *testing.T) {
+	tests := []struct {
+		name            string
+		turnaroundDays  int
+		priorityDueDays int
+		want            int
+	}{
+		{"both unset", 0, 0, 0},
+		{"priority only", 0, 5, 5},
+		{"turnaround only", 3, 0, 3},
+		{"priority shorter", 7, 2, 2},
+		{"turnaround shorter", 3, 7, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orderType := OrderType{TurnaroundDays: tt.turnaroundDays}
+			if got := orderType.DueDays(tt.priorityDueDays); got != tt.want {
+				t.Errorf("DueDays() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
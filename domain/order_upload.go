@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+)
+
+type OrderUploadState string
+
+const (
+	OrderUploadStateUploading OrderUploadState = "UPLOADING"
+	OrderUploadStateCompleted OrderUploadState = "COMPLETED"
+)
+
+type CreateOrderUploadOption struct {
+	OrderId     uuid.UUID
+	UploaderId  uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	ChunkSize   int64
+	Checksum    string
+}
+
+func CreateOrderUpload(option CreateOrderUploadOption) OrderUpload {
+	return OrderUpload{
+		Id:          uuid.New(),
+		OrderId:     option.OrderId,
+		UploaderId:  option.UploaderId,
+		FileName:    option.FileName,
+		ContentType: option.ContentType,
+		Size:        option.Size,
+		ChunkSize:   option.ChunkSize,
+		Checksum:    option.Checksum,
+		State:       OrderUploadStateUploading,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// OrderUpload 의뢰에 첨부되는 원본 영상, 청크 단위로 이어서 올릴 수 있음
+type OrderUpload struct {
+	Id          uuid.UUID          `gorm:"type:char(36);primaryKey"`
+	OrderId     uuid.UUID          `gorm:"type:char(36);index;not null"`
+	UploaderId  uuid.UUID          `gorm:"type:char(36);index;not null"`
+	FileName    string             `gorm:"size:300;not null"`
+	ContentType string             `gorm:"size:100;not null"`
+	Size        int64              `gorm:"not null"`
+	ChunkSize   int64              `gorm:"not null"`
+	Checksum    string             `gorm:"size:64;not null"` // sha256 hex
+	StorageKey  *string            `gorm:"size:1024"`
+	State       OrderUploadState   `gorm:"size:20;index;not null"`
+	CreatedAt   time.Time          `gorm:"type:datetime(6);not null"`
+	CompletedAt *time.Time         `gorm:"type:datetime(6)"`
+	Chunks      []OrderUploadChunk `gorm:"foreignKey:UploadId"`
+}
+
+func (OrderUpload) TableName() string {
+	return "order_upload"
+}
+
+func (u *OrderUpload) ChunkCount() uint32 {
+	return uint32((u.Size + u.ChunkSize - 1) / u.ChunkSize)
+}
+
+// ExpectedChunkSize 마지막 청크만 ChunkSize 보다 작을 수 있음
+func (u *OrderUpload) ExpectedChunkSize(index uint32) int64 {
+	count := u.ChunkCount()
+	if index+1 < count {
+		return u.ChunkSize
+	}
+	return u.Size - int64(count-1)*u.ChunkSize
+}
+
+func (u *OrderUpload) IsCompleted() bool {
+	return u.State == OrderUploadStateCompleted
+}
+
+// IsReceivedAll 모든 청크가 올라왔는지 여부
+func (u *OrderUpload) IsReceivedAll() bool {
+	received := make(map[uint32]bool, len(u.Chunks))
+	for _, chunk := range u.Chunks {
+		received[chunk.ChunkIndex] = true
+	}
+
+	for i := uint32(0); i < u.ChunkCount(); i++ {
+		if !received[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (u *OrderUpload) ChunkKey(index uint32) string {
+	return "upload/" + u.Id.String() + "/chunk/" + strconv.FormatUint(uint64(index), 10)
+}
+
+func (u *OrderUpload) FileKey() string {
+	return "order/" + u.OrderId.String() + "/upload/" + u.Id.String()
+}
+
+func (u *OrderUpload) Complete(key string) {
+	u.StorageKey = &key
+	u.State = OrderUploadStateCompleted
+	u.CompletedAt = pointer.Time(time.Now())
+}
+
+type OrderUploadChunk struct {
+	UploadId   uuid.UUID `gorm:"type:char(36);primaryKey"`
+	ChunkIndex uint32    `gorm:"primaryKey;autoIncrement:false"`
+	Size       int64     `gorm:"not null"`
+	Checksum   string    `gorm:"size:64;not null"` // sha256 hex
+	CreatedAt  time.Time `gorm:"type:datetime(6);not null"`
+}
+
+func (OrderUploadChunk) TableName() string {
+	return "order_upload_chunk"
+}
+
+type OrderUploadRepository interface {
+	Save(ctx context.Context, upload *OrderUpload) error
+	SaveChunk(ctx context.Context, chunk *OrderUploadChunk) error
+	DeleteChunks(ctx context.Context, uploadId uuid.UUID) error
+	Transaction(ctx context.Context, fn func(uploadRepo OrderUploadTxRepository) error, options ...*sql.TxOptions) error
+	With(tx gormx.Tx) OrderUploadTxRepository
+
+	GetById(ctx context.Context, id uuid.UUID) (*OrderUpload, error)
+	FetchByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderUpload, error)
+}
+
+type OrderUploadTxRepository interface {
+	OrderUploadRepository
+	gormx.Tx
+}
+
+type StartOrderUpload struct {
+	UserId      uuid.UUID
+	OrderId     uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	Checksum    string
+}
+
+type UploadOrderChunk struct {
+	UserId   uuid.UUID
+	UploadId uuid.UUID
+	Index    uint32
+	Size     int64
+	Checksum *string
+	Body     io.Reader
+}
+
+type CompleteOrderUpload struct {
+	UserId   uuid.UUID
+	UploadId uuid.UUID
+}
+
+type OrderUploadInfo struct {
+	UploadId       uuid.UUID
+	OrderId        uuid.UUID
+	FileName       string
+	ContentType    string
+	Size           int64
+	ChunkSize      int64
+	ChunkCount     uint32
+	ReceivedChunks []uint32
+	Checksum       string
+	State          OrderUploadState
+	CreatedAt      time.Time
+	CompletedAt    *time.Time
+}
+
+type OrderUploadUseCase interface {
+	StartOrderUpload(ctx context.Context, in StartOrderUpload) (OrderUploadInfo, error)
+	UploadOrderChunk(ctx context.Context, in UploadOrderChunk) error
+	CompleteOrderUpload(ctx context.Context, in CompleteOrderUpload) (OrderUploadInfo, error)
+
+	GetMyOrderUpload(ctx context.Context, userId, uploadId uuid.UUID) (OrderUploadInfo, error)
+	FetchByOrderId(ctx context.Context, orderId uuid.UUID) ([]OrderUploadInfo, error)
+
+	GetDownloadURL(ctx context.Context, userId, uploadId uuid.UUID) (string, error)
+	OpenSignedFile(ctx context.Context, key string, expires int64, signature string) (io.ReadCloser, error)
+}
@@ -0,0 +1,76 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PageOption Cursor 가 비어있으면 첫 페이지
+type PageOption struct {
+	Cursor string
+	Limit  int
+}
+
+// Size 0 이하면 기본값, 최대 MaxPageLimit
+func (p PageOption) Size() int {
+	switch {
+	case p.Limit <= 0:
+		return DefaultPageLimit
+	case p.Limit > MaxPageLimit:
+		return MaxPageLimit
+	}
+	return p.Limit
+}
+
+// Page NextCursor 가 nil 이면 마지막 페이지, Total 은 커서와 상관없이 조건에 맞는 전체 수
+type Page struct {
+	NextCursor *string
+	Total      int64
+}
+
+// EncodePageCursor 마지막으로 내려준 행의 정렬 값들, 마지막 값은 아이디
+func EncodePageCursor(values ...string) string {
+	b, _ := json.Marshal(values)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodePageCursor 빈 커서는 nil, 정렬 값 개수가 size 와 다르면 ErrInvalidCursor
+func DecodePageCursor(cursor string, size int) (values []string, err error) {
+	if cursor == "" {
+		return
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	err = json.Unmarshal(b, &values)
+	if err != nil || len(values) != size {
+		return nil, ErrInvalidCursor
+	}
+	return
+}
+
+type PageResponse struct {
+	// NextCursor 다음 페이지를 받을 때 cursor 로 넘길 값, 마지막 페이지면 null
+	NextCursor *string `json:"nextCursor" example:"WyIyMDIxLTEwLTI3VDA0OjQ0OjE4WiIsIjU1MGU4NDAwIl0"`
+
+	// Total 커서와 상관없이 조건에 맞는 전체 수
+	Total int64 `json:"total" example:"42"`
+}
+
+func ToPageResponse(page Page) PageResponse {
+	return PageResponse{
+		NextCursor: page.NextCursor,
+		Total:      page.Total,
+	}
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"encoding/base64"
+	"reflect"
+	"testing"
+)
+
+func TestPageCursor_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+	}{
+		{"id only", []string{"550e8400-e29b-41d4-a716-446655440000"}},
+		{"time and id", []string{"2021-10-27T04:44:18.123456Z", "550e8400-e29b-41d4-a716-446655440000"}},
+		{"korean and quote", []string{`김 "편집"`, "550e8400-e29b-41d4-a716-446655440000"}},
+		{"empty value", []string{"", "550e8400-e29b-41d4-a716-446655440000"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor := EncodePageCursor(tt.values...)
+			got, err := DecodePageCursor(cursor, len(tt.values))
+			if err != nil {
+				t.Fatalf("DecodePageCursor() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.values) {
+				t.Fatalf("DecodePageCursor() = %q, want %q", got, tt.values)
+			}
+		})
+	}
+}
+
+func TestDecodePageCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cursor  string
+		size    int
+		want    []string
+		wantErr error
+	}{
+		{"empty cursor", "", 2, nil, nil},
+		{"not base64", "!!!", 1, nil, ErrInvalidCursor},
+		{"padded base64", base64.URLEncoding.EncodeToString([]byte(`["a"]`)), 1, nil, ErrInvalidCursor},
+		{"not json", base64.RawURLEncoding.EncodeToString([]byte("a")), 1, nil, ErrInvalidCursor},
+		{"not string list", base64.RawURLEncoding.EncodeToString([]byte(`[1]`)), 1, nil, ErrInvalidCursor},
+		{"size mismatch", EncodePageCursor("a", "b"), 3, nil, ErrInvalidCursor},
+		{"valid", EncodePageCursor("a", "b"), 2, []string{"a", "b"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodePageCursor(tt.cursor, tt.size)
+			if err != tt.wantErr || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodePageCursor() = %q, %v, want %q, %v", got, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPageOption_Size(t *testing.T) {
+	tests := []struct {
+		limit int
+		want  int
+	}{
+		{0, DefaultPageLimit},
+		{-1, DefaultPageLimit},
+		{10, 10},
+		{MaxPageLimit, MaxPageLimit},
+		{MaxPageLimit + 1, MaxPageLimit},
+	}
+	for _, tt := range tests {
+		if got := (PageOption{Limit: tt.limit}).Size(); got != tt.want {
+			t.Errorf("Size(%d) = %d, want %d", tt.limit, got, tt.want)
+		}
+	}
+}
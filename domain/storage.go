@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// StorageAdapter 파일 저장소 추상화, 로컬 파일시스템 또는 S3 호환 저장소로 구현
+type StorageAdapter interface {
+	Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+
+	// SignedURL 인증 없이 일정 시간 동안 내려받을 수 있는 URL
+	SignedURL(ctx context.Context, key string, expire time.Duration) (string, error)
+}
+
+// StorageMultipartAdapter 한 번에 올릴 수 있는 크기에 제한이 있는 저장소(S3 는 5GiB)가 구현, 조각을 따로 올린 뒤 하나로 합침
+type StorageMultipartAdapter interface {
+	// PutMultipart part 가 i 번째 조각의 내용과 크기를 돌려줌, 조각은 순서대로 하나씩 읽고 다 읽으면 닫음
+	PutMultipart(ctx context.Context, key string, count int, contentType string, part func(i int) (io.ReadCloser, int64, error)) error
+}
+
+// StorageSignatureVerifier 서버가 직접 서명 URL 을 처리하는 저장소(로컬 등)가 구현
+type StorageSignatureVerifier interface {
+	VerifySignature(key string, expires int64, signature string) bool
+}
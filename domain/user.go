@@ -46,6 +46,19 @@ func CheckUserAlive(u *User, scope ...func(user User) bool) bool {
 	return false
 }
 
+// CheckUserRole 사용자가 없거나 탈퇴했거나 scope 에 맞지 않으면 ErrNoPermission
+func CheckUserRole(ctx context.Context, userRepo UserRepository, userId uuid.UUID, scope ...func(user User) bool) (err error) {
+	user, err := userRepo.GetById(ctx, userId)
+	if err != nil {
+		return
+	}
+
+	if !CheckUserAlive(user, scope...) {
+		err = ErrNoPermission
+	}
+	return
+}
+
 func CreateUser(option UserCreateOption) User {
 	return User{
 		Id:        uuid.New(),
@@ -69,6 +82,9 @@ type User struct {
 	Manager   *Manager   `gorm:"foreignKey:Id"`
 	MyJob     []Order    `gorm:"foreignKey:Orderer"`
 	Ticket    []Order    `gorm:"foreignKey:Assignee"`
+
+	// Version 저장할 때마다 올라감, 다른 곳에서 먼저 저장했으면 저장 실패
+	Version uint `gorm:"not null"`
 }
 
 func (User) TableName() string {
@@ -176,12 +192,42 @@ func (u *User) UpdateCustomerInfo(name, channelName, channelLink, email, mobile,
 	customer.Memo = memo
 }
 
+// UserSort 비어있으면 가입 일시 순
+type UserSort string
+
+const (
+	UserSortCreatedAt UserSort = "createdAt"
+	UserSortName      UserSort = "name"
+)
+
 type FetchAdminOption struct {
+	// Query 이름, 닉네임으로 검색
 	Query string
+
+	// CreatedFrom, CreatedTo 가입 일시 범위, 둘 다 포함
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+
+	Sort UserSort
+	Desc bool
+
+	// Page FetchAdminPage 에서만 사용, nil 이면 첫 페이지
+	Page *PageOption
 }
 
 type FetchCustomerOption struct {
+	// Query 이름, 채널명, 이메일로 검색
 	Query string
+
+	// CreatedFrom, CreatedTo 가입 일시 범위, 둘 다 포함
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+
+	Sort UserSort
+	Desc bool
+
+	// Page nil 이면 첫 페이지
+	Page *PageOption
 }
 
 type UserRepository interface {
@@ -194,7 +240,8 @@ type UserRepository interface {
 	GetById(ctx context.Context, userId uuid.UUID) (*User, error)
 
 	FetchAllAdmin(ctx context.Context, option FetchAdminOption) ([]User, error)
-	FetchAllCustomer(ctx context.Context, option FetchCustomerOption) ([]User, error)
+	FetchAdminPage(ctx context.Context, option FetchAdminOption) ([]User, Page, error)
+	FetchCustomerPage(ctx context.Context, option FetchCustomerOption) ([]User, Page, error)
 
 	GetByIdWithCustomer(ctx context.Context, id uuid.UUID) (*User, error)
 	GetByIdWithManager(ctx context.Context, id uuid.UUID) (*User, error)
@@ -343,8 +390,8 @@ type UserUseCase interface {
 
 	GetAdminInfoDetailByUserId(ctx context.Context, userId uuid.UUID) (AdminInfoDetailData, error)
 	GetCustomerInfoDetailByUserId(ctx context.Context, userId uuid.UUID) (CustomerInfoDetailData, error)
-	FetchAllAdmin(ctx context.Context, option FetchAdminOption) ([]AdminInfoData, error)
-	FetchAllCustomer(ctx context.Context, option FetchCustomerOption) ([]CustomerInfoData, error)
+	FetchAdmin(ctx context.Context, option FetchAdminOption) ([]AdminInfoData, Page, error)
+	FetchCustomer(ctx context.Context, option FetchCustomerOption) ([]CustomerInfoData, Page, error)
 
 	CustomerSubscribeInfoByUserId(ctx context.Context, userId uuid.UUID) (CustomerSubscribeInfoData, error)
 }
@@ -1,5 +1,12 @@
 package main
 
+import (
+	"context"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+)
+
 // @securityDefinitions.apikey Auth-Jwt-Bearer
 // @in header
 // @name Authorization
@@ -18,5 +25,19 @@ package main
 
 // @BasePath /
 func main() {
-	getApp().Start()
+	// go run . seed, 서버를 띄우지 않고 기준 데이터만 넣음
+	if len(os.Args) > 1 && os.Args[1] == "seed" {
+		applied, err := getSeeder().Run(context.Background())
+		if err != nil {
+			log.WithError(err).Fatal("seed failed")
+		}
+		log.WithField("versions", applied).Info("seed done")
+		return
+	}
+
+	a, err := getApp()
+	if err != nil {
+		log.WithError(err).Fatal("app init failed")
+	}
+	a.Start()
 }
\ No newline at end of file
@@ -0,0 +1,382 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+const (
+	tag = "[MANAGER] "
+)
+
+func NewManagerController(useCase domain.ManagerUseCase) *ManagerController {
+	return &ManagerController{useCase: useCase}
+}
+
+type ManagerController struct {
+	useCase domain.ManagerUseCase
+}
+
+func (c *ManagerController) Bind(e *echo.Echo) {
+	//ADMIN, 본인 또는 SUPER_ADMIN
+	e.GET("/admin/workload", c.fetchEditorWorkload,
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.GET("/admin/:userId/profile", echox.UserID(c.getEditorProfile),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.PUT("/admin/:userId/working-hour", echox.UserID(c.updateEditorWorkingHours),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.POST("/admin/:userId/day-off", echox.UserID(c.addEditorDayOff),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.DELETE("/admin/:userId/day-off/:dayOffId", echox.UserID(c.deleteEditorDayOff),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+}
+
+type ManagerWorkingHourResponse struct {
+	// Weekday 요일, 0: 일요일 ~ 6: 토요일
+	Weekday uint8 `json:"weekday" validate:"required" example:"1"`
+
+	// StartMinute 근무 시작, 0시부터 분 단위
+	StartMinute uint16 `json:"startMinute" validate:"required" example:"600"`
+
+	// EndMinute 근무 종료, 0시부터 분 단위
+	EndMinute uint16 `json:"endMinute" validate:"required" example:"1140"`
+} // @name ManagerWorkingHourResponse
+
+type ManagerDayOffResponse struct {
+	DayOffId  uuid.UUID `json:"dayOffId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	StartDate time.Time `json:"startDate" validate:"required" example:"2021-10-30T00:00:00+00:00"`
+	EndDate   time.Time `json:"endDate" validate:"required" example:"2021-11-01T00:00:00+00:00"`
+	Reason    string    `json:"reason" validate:"required" example:"여름 휴가"`
+} // @name ManagerDayOffResponse
+
+type EditorProfileResponse struct {
+	EditorId uuid.UUID `json:"editorId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name     string    `json:"name" validate:"required" example:"홍길동"`
+	Nickname string    `json:"nickname" validate:"required" example:"광대버기"`
+
+	// MaxConcurrentOrder 동시에 맡을 수 있는 최대 의뢰 수, 0 이면 기본값 사용
+	MaxConcurrentOrder uint8    `json:"maxConcurrentOrder" validate:"required" example:"5"`
+	Skills             []string `json:"skills" validate:"required" example:"자막,컷편집"`
+	Away               bool     `json:"away" validate:"required" example:"false"`
+
+	WorkingHours []ManagerWorkingHourResponse `json:"workingHours" validate:"required"`
+
+	// DayOffs 오늘 이후 휴무일
+	DayOffs []ManagerDayOffResponse `json:"dayOffs" validate:"required"`
+} // @name EditorProfileResponse
+
+// @Tags (Manager) 편집자 일정
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 편집자 프로필
+// @Description 편집자의 최대 동시 의뢰 수, 근무 시간, 휴무일을 가져오는 기능, 본인 또는 최고 관리자만 가능
+// @Accept json
+// @Produce json
+// @Param user_id path string true "편집자 식별 아이디(UUID)"
+// @Success 200 {object} EditorProfileResponse true "편집자 프로필"
+// @Router /admin/{user_id}/profile [get]
+func (c *ManagerController) getEditorProfile(ctx echo.Context, userId uuid.UUID) error {
+	var req struct {
+		EditorId uuid.UUID `json:"-" param:"userId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "get editor profile, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	res, err := c.useCase.GetEditorProfile(ctx.Request().Context(), domain.GetEditorProfile{
+		UserId:   userId,
+		EditorId: req.EditorId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusOK, useCaseToEditorProfileResponse(res))
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "getEditorProfile, unhandled error useCase.GetEditorProfile")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type ManagerWorkingHourRequest struct {
+	// Weekday 요일, 0: 일요일 ~ 6: 토요일
+	Weekday uint8 `json:"weekday" validate:"max=6" example:"1"`
+
+	// StartMinute 근무 시작, 0시부터 분 단위
+	StartMinute uint16 `json:"startMinute" validate:"max=1440" example:"600"`
+
+	// EndMinute 근무 종료, 0시부터 분 단위, 시작보다 늦어야함
+	EndMinute uint16 `json:"endMinute" validate:"required,max=1440,gtfield=StartMinute" example:"1140"`
+} // @name ManagerWorkingHourRequest
+
+type UpdateEditorWorkingHoursRequest struct {
+	EditorId uuid.UUID `json:"-" param:"userId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// WorkingHours 요일별 근무 시간, 없는 요일은 휴무
+	WorkingHours []ManagerWorkingHourRequest `json:"workingHours" validate:"max=7,dive"`
+} // @name UpdateEditorWorkingHoursRequest
+
+// @Tags (Manager) 편집자 일정
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 편집자 주간 근무 시간 수정
+// @Description 요일별 근무 시간을 통째로 바꾸는 기능, 같은 요일이 두 번 들어가면 안됨, 본인 또는 최고 관리자만 가능
+// @Accept json
+// @Param user_id path string true "편집자 식별 아이디(UUID)"
+// @Param requestBody body UpdateEditorWorkingHoursRequest true "근무 시간 데이터 구조"
+// @Success 204 "수정 완료"
+// @Router /admin/{user_id}/working-hour [put]
+func (c *ManagerController) updateEditorWorkingHours(ctx echo.Context, userId uuid.UUID) error {
+	var req UpdateEditorWorkingHoursRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "update editor working hours, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	hours := make([]domain.ManagerWorkingHourInfo, len(req.WorkingHours))
+	for i := range req.WorkingHours {
+		hours[i] = domain.ManagerWorkingHourInfo{
+			Weekday:     req.WorkingHours[i].Weekday,
+			StartMinute: req.WorkingHours[i].StartMinute,
+			EndMinute:   req.WorkingHours[i].EndMinute,
+		}
+	}
+
+	err = c.useCase.UpdateEditorWorkingHours(ctx.Request().Context(), domain.UpdateEditorWorkingHours{
+		UserId:   userId,
+		EditorId: req.EditorId,
+		Hours:    hours,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid working hours"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "updateEditorWorkingHours, unhandled error useCase.UpdateEditorWorkingHours")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type AddEditorDayOffRequest struct {
+	EditorId  uuid.UUID `json:"-" param:"userId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	StartDate time.Time `json:"startDate" validate:"required" example:"2021-10-30T00:00:00+00:00"`
+	EndDate   time.Time `json:"endDate" validate:"required" example:"2021-11-01T00:00:00+00:00"`
+	Reason    string    `json:"reason" validate:"max=200" example:"여름 휴가"`
+} // @name AddEditorDayOffRequest
+
+type AddEditorDayOffResponse struct {
+	DayOffId uuid.UUID `json:"dayOffId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name AddEditorDayOffResponse
+
+// @Tags (Manager) 편집자 일정
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 편집자 휴무일 추가
+// @Description 휴가, 휴무일을 추가하는 기능, 시작일과 종료일 모두 포함, 본인 또는 최고 관리자만 가능
+// @Accept json
+// @Produce json
+// @Param user_id path string true "편집자 식별 아이디(UUID)"
+// @Param requestBody body AddEditorDayOffRequest true "휴무일 데이터 구조"
+// @Success 201 {object} AddEditorDayOffResponse true "추가 완료"
+// @Router /admin/{user_id}/day-off [post]
+func (c *ManagerController) addEditorDayOff(ctx echo.Context, userId uuid.UUID) error {
+	var req AddEditorDayOffRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "add editor day off, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	newId, err := c.useCase.AddEditorDayOff(ctx.Request().Context(), domain.AddEditorDayOff{
+		UserId:    userId,
+		EditorId:  req.EditorId,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Reason:    req.Reason,
+	})
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusCreated, AddEditorDayOffResponse{DayOffId: newId})
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "end date before start date"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "addEditorDayOff, unhandled error useCase.AddEditorDayOff")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+// @Tags (Manager) 편집자 일정
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 편집자 휴무일 삭제
+// @Description 휴무일을 삭제하는 기능, 본인 또는 최고 관리자만 가능
+// @Accept json
+// @Param user_id path string true "편집자 식별 아이디(UUID)"
+// @Param day_off_id path string true "휴무일 식별 아이디(UUID)"
+// @Success 204 "삭제 완료"
+// @Router /admin/{user_id}/day-off/{day_off_id} [delete]
+func (c *ManagerController) deleteEditorDayOff(ctx echo.Context, userId uuid.UUID) error {
+	var req struct {
+		EditorId uuid.UUID `json:"-" param:"userId"`
+		DayOffId uuid.UUID `json:"-" param:"dayOffId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "delete editor day off, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.DeleteEditorDayOff(ctx.Request().Context(), domain.DeleteEditorDayOff{
+		UserId:   userId,
+		EditorId: req.EditorId,
+		DayOffId: req.DayOffId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "deleteEditorDayOff, unhandled error useCase.DeleteEditorDayOff")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type EditorWorkloadOrderResponse struct {
+	OrderId    uuid.UUID  `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	OrderedAt  time.Time  `json:"orderedAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+	DueDate    *time.Time `json:"dueDate" example:"2021-10-30T00:00:00+00:00"`
+	OrderState uint8      `json:"orderState" validate:"required" example:"3"`
+	Overdue    bool       `json:"overdue" validate:"required" example:"false"`
+} // @name EditorWorkloadOrderResponse
+
+type EditorWorkloadResponse struct {
+	EditorId uuid.UUID `json:"editorId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name     string    `json:"name" validate:"required" example:"홍길동"`
+	Nickname string    `json:"nickname" validate:"required" example:"광대버기"`
+
+	// ConcurrentOrderLimit 동시에 맡을 수 있는 최대 의뢰 수
+	ConcurrentOrderLimit uint8 `json:"concurrentOrderLimit" validate:"required" example:"5"`
+
+	// ActiveOrderCount 진행중인 의뢰 수
+	ActiveOrderCount int `json:"activeOrderCount" validate:"required" example:"2"`
+
+	// Away 자동 배정 제외 중이거나 오늘 휴무
+	Away bool `json:"away" validate:"required" example:"false"`
+
+	// Orders 진행중인 의뢰, 의뢰 일자 순
+	Orders []EditorWorkloadOrderResponse `json:"orders" validate:"required"`
+} // @name EditorWorkloadResponse
+
+type EditorWorkloadListResponse []EditorWorkloadResponse
+
+// @Tags (Manager) 편집자 일정
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 편집자별 업무량
+// @Description 편집자마다 진행중인 의뢰와 마감일, 최대 동시 의뢰 수를 가져오는 기능, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Success 200 {object} EditorWorkloadListResponse true "편집자별 업무량"
+// @Router /admin/workload [get]
+func (c *ManagerController) fetchEditorWorkload(ctx echo.Context) error {
+	list, err := c.useCase.FetchEditorWorkload(ctx.Request().Context())
+	if err != nil {
+		log.WithError(err).Error(tag, "fetchEditorWorkload, unhandled error useCase.FetchEditorWorkload")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	res := make(EditorWorkloadListResponse, len(list))
+	for i := range list {
+		src := list[i]
+		orders := make([]EditorWorkloadOrderResponse, len(src.Orders))
+		for j := range src.Orders {
+			orders[j] = EditorWorkloadOrderResponse{
+				OrderId:    src.Orders[j].OrderId,
+				OrderedAt:  src.Orders[j].OrderedAt,
+				DueDate:    src.Orders[j].DueDate,
+				OrderState: src.Orders[j].OrderState,
+				Overdue:    src.Orders[j].Overdue,
+			}
+		}
+
+		res[i] = EditorWorkloadResponse{
+			EditorId:             src.EditorId,
+			Name:                 src.Name,
+			Nickname:             src.Nickname,
+			ConcurrentOrderLimit: src.ConcurrentOrderLimit,
+			ActiveOrderCount:     len(src.Orders),
+			Away:                 src.Away,
+			Orders:               orders,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func useCaseToEditorProfileResponse(src domain.EditorProfileInfo) EditorProfileResponse {
+	hours := make([]ManagerWorkingHourResponse, len(src.WorkingHours))
+	for i := range src.WorkingHours {
+		hours[i] = ManagerWorkingHourResponse{
+			Weekday:     src.WorkingHours[i].Weekday,
+			StartMinute: src.WorkingHours[i].StartMinute,
+			EndMinute:   src.WorkingHours[i].EndMinute,
+		}
+	}
+
+	dayOffs := make([]ManagerDayOffResponse, len(src.DayOffs))
+	for i := range src.DayOffs {
+		dayOffs[i] = ManagerDayOffResponse{
+			DayOffId:  src.DayOffs[i].DayOffId,
+			StartDate: src.DayOffs[i].StartDate,
+			EndDate:   src.DayOffs[i].EndDate,
+			Reason:    src.DayOffs[i].Reason,
+		}
+	}
+
+	skills := src.Skills
+	if skills == nil {
+		skills = []string{}
+	}
+
+	return EditorProfileResponse{
+		EditorId:           src.EditorId,
+		Name:               src.Name,
+		Nickname:           src.Nickname,
+		MaxConcurrentOrder: src.MaxConcurrentOrder,
+		Skills:             skills,
+		Away:               src.Away,
+		WorkingHours:       hours,
+		DayOffs:            dayOffs,
+	}
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func NewManagerRepository(db *gorm.DB) domain.ManagerRepository {
-	db.AutoMigrate(&domain.Manager{})
+	db.AutoMigrate(&domain.Manager{}, &domain.ManagerWorkingHour{}, &domain.ManagerDayOff{})
 	return &repo{db: db}
 }
 
@@ -46,3 +46,60 @@ func (r *repo) Save(ctx context.Context, manager *domain.Manager) error {
 func (r *repo) With(tx gormx.Tx) domain.ManagerTxRepository {
 	return &repo{db: tx.Get()}
 }
+
+func (r *repo) SaveWorkingHours(ctx context.Context, managerId uuid.UUID, hours []domain.ManagerWorkingHour) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) (err error) {
+		err = tx.Where("`manager_id` = ?", managerId).
+			Delete(&domain.ManagerWorkingHour{}).Error
+		if err != nil || len(hours) == 0 {
+			return
+		}
+
+		return tx.Create(&hours).Error
+	})
+}
+
+func (r *repo) FetchWorkingHoursByManagerIds(ctx context.Context, ids []uuid.UUID) (list []domain.ManagerWorkingHour, err error) {
+	if len(ids) == 0 {
+		return
+	}
+
+	err = r.db.WithContext(ctx).
+		Order("`manager_id`, `weekday`").
+		Where("`manager_id` IN ?", ids).
+		Find(&list).Error
+	return
+}
+
+func (r *repo) SaveDayOff(ctx context.Context, dayOff *domain.ManagerDayOff) error {
+	return gormx.Upsert(ctx, r.db, dayOff)
+}
+
+func (r *repo) DeleteDayOff(ctx context.Context, dayOff *domain.ManagerDayOff) error {
+	return r.db.WithContext(ctx).Delete(dayOff).Error
+}
+
+func (r *repo) GetDayOffById(ctx context.Context, id uuid.UUID) (dayOff *domain.ManagerDayOff, err error) {
+	var entity domain.ManagerDayOff
+	err = r.db.WithContext(ctx).First(&entity, id).Error
+	if err == nil {
+		dayOff = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) FetchDayOff(ctx context.Context, option domain.FetchManagerDayOffOption) (list []domain.ManagerDayOff, err error) {
+	db := r.db.WithContext(ctx).
+		Order("`start_date` asc").
+		Where("`start_date` <= ? AND `end_date` >= ?",
+			domain.TruncateDate(option.To), domain.TruncateDate(option.From))
+	if len(option.ManagerIds) > 0 {
+		db = db.Where("`manager_id` IN ?", option.ManagerIds)
+	}
+
+	err = db.Find(&list).Error
+	return
+}
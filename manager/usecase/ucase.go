@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+func NewManagerUseCase(
+	managerRepo domain.ManagerRepository,
+	userRepo domain.UserRepository,
+	orderRepo domain.OrderRepository,
+	timeout time.Duration,
+) domain.ManagerUseCase {
+	return &ucase{
+		managerRepo: managerRepo,
+		userRepo:    userRepo,
+		orderRepo:   orderRepo,
+		timeout:     timeout,
+	}
+}
+
+type ucase struct {
+	managerRepo domain.ManagerRepository
+	userRepo    domain.UserRepository
+	orderRepo   domain.OrderRepository
+	timeout     time.Duration
+}
+
+func (u *ucase) GetEditorProfile(ctx context.Context, in domain.GetEditorProfile) (res domain.EditorProfileInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	manager, err := u.getEditor(c, in.UserId, in.EditorId)
+	if err != nil {
+		return
+	}
+
+	var (
+		hours   []domain.ManagerWorkingHour
+		dayOffs []domain.ManagerDayOff
+	)
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		hours, err = u.managerRepo.FetchWorkingHoursByManagerIds(gc, []uuid.UUID{manager.Id})
+		return
+	})
+	g.Go(func() (err error) {
+		// 지난 휴무는 보여주지 않음
+		dayOffs, err = u.managerRepo.FetchDayOff(gc, domain.FetchManagerDayOffOption{
+			ManagerIds: []uuid.UUID{manager.Id},
+			From:       time.Now(),
+			To:         time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC),
+		})
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	res = domain.EditorProfileInfo{
+		EditorId:           manager.Id,
+		Name:               manager.Name,
+		Nickname:           manager.Nickname,
+		MaxConcurrentOrder: manager.MaxConcurrentOrder,
+		Skills:             manager.SkillList(),
+		Away:               manager.Away,
+		WorkingHours:       make([]domain.ManagerWorkingHourInfo, len(hours)),
+		DayOffs:            make([]domain.ManagerDayOffInfo, len(dayOffs)),
+	}
+	for i := range hours {
+		res.WorkingHours[i] = domain.ManagerWorkingHourInfo{
+			Weekday:     hours[i].Weekday,
+			StartMinute: hours[i].StartMinute,
+			EndMinute:   hours[i].EndMinute,
+		}
+	}
+	for i := range dayOffs {
+		res.DayOffs[i] = domain.ManagerDayOffInfo{
+			DayOffId:  dayOffs[i].Id,
+			StartDate: dayOffs[i].StartDate,
+			EndDate:   dayOffs[i].EndDate,
+			Reason:    dayOffs[i].Reason,
+		}
+	}
+	return
+}
+
+func (u *ucase) UpdateEditorWorkingHours(ctx context.Context, in domain.UpdateEditorWorkingHours) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	manager, err := u.getEditor(c, in.UserId, in.EditorId)
+	if err != nil {
+		return
+	}
+
+	hours := make([]domain.ManagerWorkingHour, len(in.Hours))
+	for i := range in.Hours {
+		hours[i] = domain.ManagerWorkingHour{
+			ManagerId:   manager.Id,
+			Weekday:     in.Hours[i].Weekday,
+			StartMinute: in.Hours[i].StartMinute,
+			EndMinute:   in.Hours[i].EndMinute,
+		}
+	}
+
+	if !domain.IsValidManagerWorkingHours(hours) {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	return u.managerRepo.SaveWorkingHours(c, manager.Id, hours)
+}
+
+func (u *ucase) AddEditorDayOff(ctx context.Context, in domain.AddEditorDayOff) (newId uuid.UUID, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	manager, err := u.getEditor(c, in.UserId, in.EditorId)
+	if err != nil {
+		return
+	}
+
+	dayOff := domain.CreateManagerDayOff(domain.CreateManagerDayOffOption{
+		ManagerId: manager.Id,
+		StartDate: in.StartDate,
+		EndDate:   in.EndDate,
+		Reason:    in.Reason,
+	})
+	if !dayOff.IsValidRange() {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	err = u.managerRepo.SaveDayOff(c, &dayOff)
+	if err != nil {
+		return
+	}
+
+	newId = dayOff.Id
+	return
+}
+
+func (u *ucase) DeleteEditorDayOff(ctx context.Context, in domain.DeleteEditorDayOff) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var dayOff *domain.ManagerDayOff
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		_, err = u.getEditor(gc, in.UserId, in.EditorId)
+		return
+	})
+	g.Go(func() (err error) {
+		dayOff, err = u.managerRepo.GetDayOffById(gc, in.DayOffId)
+		if err == nil && (dayOff == nil || dayOff.ManagerId != in.EditorId) {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	return u.managerRepo.DeleteDayOff(c, dayOff)
+}
+
+func (u *ucase) FetchEditorWorkload(ctx context.Context) (res []domain.EditorWorkloadInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	now := time.Now()
+	var (
+		admins  []domain.User
+		orders  []domain.Order
+		dayOffs []domain.ManagerDayOff
+	)
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		admins, err = u.userRepo.FetchAllAdmin(gc, domain.FetchAdminOption{})
+		return
+	})
+	g.Go(func() (err error) {
+		orders, err = u.orderRepo.Fetch(gc, domain.FetchOrderOption{
+			OrderState: domain.OrderGeneralStateProcessing,
+		})
+		return
+	})
+	g.Go(func() (err error) {
+		dayOffs, err = u.managerRepo.FetchDayOff(gc, domain.FetchManagerDayOffOption{
+			From: now,
+			To:   now,
+		})
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	offToday := make(map[uuid.UUID]bool, len(dayOffs))
+	for i := range dayOffs {
+		offToday[dayOffs[i].ManagerId] = true
+	}
+
+	ordersByAssignee := make(map[uuid.UUID][]domain.EditorWorkloadOrderInfo)
+	for i := range orders {
+		order := orders[i]
+		if order.Assignee == nil {
+			continue
+		}
+
+		ordersByAssignee[*order.Assignee] = append(ordersByAssignee[*order.Assignee], domain.EditorWorkloadOrderInfo{
+			OrderId:    order.Id,
+			OrderedAt:  order.OrderedAt,
+			DueDate:    order.DueDate,
+			OrderState: order.State,
+			Overdue:    order.IsOverdue(now),
+		})
+	}
+
+	res = make([]domain.EditorWorkloadInfo, 0, len(admins))
+	for i := range admins {
+		manager := admins[i].Manager
+		if manager == nil {
+			continue
+		}
+
+		assigned := ordersByAssignee[manager.Id]
+		if assigned == nil {
+			assigned = []domain.EditorWorkloadOrderInfo{}
+		}
+
+		res = append(res, domain.EditorWorkloadInfo{
+			EditorId:             manager.Id,
+			Name:                 manager.Name,
+			Nickname:             manager.Nickname,
+			ConcurrentOrderLimit: manager.ConcurrentOrderLimit(config.Assignment.DefaultMaxConcurrentOrder),
+			Away:                 manager.Away || offToday[manager.Id],
+			Orders:               assigned,
+		})
+	}
+	return
+}
+
+// getEditor 본인 또는 최고 관리자만 편집자 일정을 다룰 수 있음
+func (u *ucase) getEditor(ctx context.Context, userId, editorId uuid.UUID) (manager *domain.Manager, err error) {
+	g, gc := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		if userId == editorId {
+			return
+		}
+
+		user, err := u.userRepo.GetById(gc, userId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(user, domain.User.IsSuperAdmin) {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		editor, err := u.userRepo.GetById(gc, editorId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(editor, domain.User.IsAdmin, domain.User.IsSuperAdmin) {
+			return domain.ErrItemNotFound
+		}
+
+		manager, err = u.managerRepo.GetById(gc, editorId)
+		if err == nil && manager == nil {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	err = g.Wait()
+	return
+}
@@ -0,0 +1,31 @@
+package adapter
+
+import (
+	"context"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+const (
+	tag = "[NOTIFICATION] "
+)
+
+// NewLogNotificationAdapter 실제 발송 채널(메일, 메신저 등)이 붙기 전까지 로그로 알림을 남김
+func NewLogNotificationAdapter() domain.NotificationAdapter {
+	logger := logrus.New()
+	// 전역 로그 레벨과 관계없이 알림은 항상 남김
+	logger.SetLevel(logrus.InfoLevel)
+	return &logNotification{logger: logger}
+}
+
+type logNotification struct {
+	logger *logrus.Logger
+}
+
+func (l *logNotification) Notify(ctx context.Context, notification domain.Notification) error {
+	l.logger.WithField("userIds", notification.UserIds).
+		WithField("title", notification.Title).
+		Info(tag, notification.Message)
+	return nil
+}
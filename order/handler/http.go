@@ -30,6 +30,14 @@ func (c *OrderController) Bind(e *echo.Echo) {
 	e.POST("/order/recent-processing/edit", echox.UserID(c.myOrderEdit), debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
 	// 주문 접수
 	e.POST("/order", echox.UserID(c.createOrder), debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	// 내 주문 목록, 완료된 주문 포함
+	e.GET("/customer/me/orders", echox.UserID(c.fetchMyOrder), debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	// 내 주문 가져오기
+	e.GET("/customer/me/orders/:orderId", echox.UserID(c.getMyOrder), debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	// 주문 완료
+	e.POST("/order/:orderId/done", echox.UserID(c.orderDone), debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	// 수정 접수
+	e.POST("/order/:orderId/edit", echox.UserID(c.orderEdit), debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
 
 	//ADMIN
 	e.GET("/order/:orderId", c.getOrderDetailInfo,
@@ -38,14 +46,32 @@ func (c *OrderController) Bind(e *echo.Echo) {
 		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
 	e.PUT("/order/:orderId", c.updateOrderInfo,
 		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.PUT("/order/:orderId/priority", echox.UserID(c.updateOrderPriority),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.POST("/order/:orderId/feedback/:feedbackId/resolve", echox.UserID(c.resolveOrderFeedback),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.GET("/order/board", echox.UserID(c.fetchOrderBoard),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.POST("/order/:orderId/move", echox.UserID(c.moveOrderCard),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.GET("/order/export", echox.UserID(c.exportOrder),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.POST("/order/bulk/assign", echox.UserID(c.bulkAssignOrder),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.POST("/order/bulk/due-date", echox.UserID(c.bulkDueDateOrder),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.POST("/order/bulk/state", echox.UserID(c.bulkStateOrder),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.POST("/order/bulk/cancel", echox.UserID(c.bulkCancelOrder),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
 	e.POST("/order/:orderId/edit-done", nil,
 		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole)) // 대기
 
 	// v1 - fetch, todo refactor
-	e.GET("/order/ready", c.fetchOrderToReady,
+	e.GET("/order/ready", echox.UserID(c.fetchOrderToReady),
 		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
 	e.GET("/order/processing", echox.UserID(c.fetchOrderToProcessing),
 		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
-	e.GET("/order/done", c.fetchOrderToDone,
+	e.GET("/order/done", echox.UserID(c.fetchOrderToDone),
 		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
 }
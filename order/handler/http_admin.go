@@ -7,35 +7,117 @@ import (
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
 	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
 )
 
 type OrderFetchRequest struct {
-	Query        string `json:"-" query:"q"`
+	// Query 고객 이름, 채널명, 이메일, 요청사항, 담당 편집자 이름/닉네임, 의뢰 아이디 앞자리로 검색, 두 글자 이상
+	Query        string `json:"-" query:"q" validate:"omitempty,min=2,max=100"`
 	ShowMyTicket bool   `json:"-" query:"smt" example:"false"`
+
+	// SLA overdue: 마감 초과만, atRisk: 마감 임박만
+	SLA string `json:"-" query:"sla" validate:"omitempty,oneof=overdue atRisk" example:"overdue"`
+
+	// Cursor 이전 응답의 nextCursor, 비어있으면 첫 페이지
+	Cursor string `json:"-" query:"cursor"`
+	Limit  int    `json:"-" query:"limit" validate:"omitempty,min=1,max=100" example:"20"`
+
+	// Sort 비어있으면 기본 정렬, 정렬 기준을 바꾸면 커서는 처음부터 다시 받아야함
+	Sort string `json:"-" query:"sort" validate:"omitempty,oneof=orderedAt name assignee state" example:"orderedAt"`
+	Desc bool   `json:"-" query:"desc" example:"false"`
+
+	OrderedFrom *time.Time `json:"-" query:"orderedFrom" example:"2021-10-01T00:00:00+09:00"`
+	OrderedTo   *time.Time `json:"-" query:"orderedTo" example:"2021-10-31T23:59:59+09:00"`
+	DueFrom     *time.Time `json:"-" query:"dueFrom" example:"2021-10-01T00:00:00+09:00"`
+	DueTo       *time.Time `json:"-" query:"dueTo" example:"2021-10-31T00:00:00+09:00"`
+	StateId     *uint8     `json:"-" query:"stateId" example:"3"`
+
+	// Assignee 담당 편집자로 거르기, smt 가 있으면 무시됨
+	Assignee *uuid.UUID `json:"-" query:"assignee" example:"550e8400-e29b-41d4-a716-446655440000"`
 } // @name OrderFetchRequest
 
+type OrderSearchHighlightResponse struct {
+	// Field 검색어와 일치한 항목
+	// * ORDERER_NAME - 고객 이름
+	// * CHANNEL_NAME - 채널명
+	// * EMAIL - 이메일
+	// * REQUIREMENT - 요청사항
+	// * ASSIGNEE_NAME - 담당 편집자 이름
+	// * ASSIGNEE_NICKNAME - 담당 편집자 닉네임
+	// * ORDER_ID - 의뢰 아이디
+	Field domain.OrderSearchField `json:"field" validate:"required" example:"REQUIREMENT" enums:"ORDERER_NAME,CHANNEL_NAME,EMAIL,REQUIREMENT,ASSIGNEE_NAME,ASSIGNEE_NICKNAME,ORDER_ID"`
+
+	// Fragment 일치한 부분을 <em> 으로 감싼 일부분
+	Fragment string `json:"fragment" validate:"required" example:"…자막은 <em>노란색</em>으로 부탁…"`
+} // @name OrderSearchHighlightResponse
+
+func useCaseToOrderSearchHighlightResponse(list []domain.OrderSearchHighlight) []OrderSearchHighlightResponse {
+	if list == nil {
+		return nil
+	}
+
+	res := make([]OrderSearchHighlightResponse, len(list))
+	for i := range list {
+		res[i] = OrderSearchHighlightResponse{
+			Field:    list[i].Field,
+			Fragment: list[i].Fragment,
+		}
+	}
+	return res
+}
+
 type OrderReadyInfoResponse struct {
 	OrderId            uuid.UUID `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
 	OrderedAt          time.Time `json:"orderedAt" validate:"required"`
 	OrdererName        string    `json:"ordererName" validate:"required"`
 	OrdererChannelName string    `json:"ordererChannelName" validate:"required"`
 	OrdererChannelLink string    `json:"ordererChannelLink" validate:"required"`
+
+	// UnreadMessageCount 내가 읽지 않은 메시지 수
+	UnreadMessageCount int64 `json:"unreadMessageCount" example:"0"`
+
+	// Priority 우선순위, 목록은 RUSH, HIGH, NORMAL 순 다음 의뢰 일자 순으로 정렬됨
+	Priority domain.OrderPriority `json:"priority" validate:"required" example:"NORMAL" enums:"NORMAL,HIGH,RUSH"`
+
+	// TypeId 의뢰 종류 아이디
+	TypeId uint8 `json:"typeId" example:"1"`
+
+	// Highlights 검색어(q)가 있을 때만, 검색어와 일치한 항목
+	Highlights []OrderSearchHighlightResponse `json:"highlights,omitempty"`
 } // @name OrderReadyInfoResponse
 
 type OrderReadyInfoListResponse []OrderReadyInfoResponse
 
+type OrderReadyInfoPageResponse struct {
+	Items OrderReadyInfoListResponse `json:"items" validate:"required"`
+	domain.PageResponse
+} // @name OrderReadyInfoPageResponse
+
 // @Tags (Order) 어드민 기능
 // @Security Auth-Jwt-Bearer
 // @Summary [어드민] 제작 의뢰 요청 목록
 // @Description 제작 의뢰 요청 목록 기능, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
 // @Accept json
 // @Produce json
-// @Param q query string false "검색어"
-// @Success 200 {object} OrderReadyInfoListResponse true "의뢰 요청 목록"
+// @Param q query string false "검색어, 고객 이름, 채널명, 이메일, 요청사항, 담당 편집자 이름/닉네임, 의뢰 아이디 앞자리"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param limit query int false "페이지 크기, 기본 20, 최대 100"
+// @Param sort query string false "정렬 기준 (orderedAt, name, assignee, state)"
+// @Param desc query boolean false "내림차순"
+// @Param orderedFrom query string false "의뢰 일시 시작 (RFC3339)"
+// @Param orderedTo query string false "의뢰 일시 끝 (RFC3339)"
+// @Param dueFrom query string false "마감일 시작 (RFC3339)"
+// @Param dueTo query string false "마감일 끝 (RFC3339)"
+// @Param stateId query int false "의뢰 상태 아이디"
+// @Param assignee query string false "담당 편집자 아이디"
+// @Success 200 {object} OrderReadyInfoPageResponse true "의뢰 요청 목록"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 커서"
 // @Router /order/ready [get]
-func (c *OrderController) fetchOrderToReady(ctx echo.Context) error {
-	res, alreadyResp, err := c.internalFetchOrder(ctx, domain.OrderGeneralStateReady, nil)
+func (c *OrderController) fetchOrderToReady(ctx echo.Context, userId uuid.UUID) error {
+	res, page, alreadyResp, err := c.internalFetchOrder(ctx, domain.OrderGeneralStateReady, userId, nil)
 	if alreadyResp {
 		return err
 	}
@@ -50,10 +132,17 @@ func (c *OrderController) fetchOrderToReady(ctx echo.Context) error {
 			OrdererName:        src.OrdererName,
 			OrdererChannelName: src.OrdererChannelName,
 			OrdererChannelLink: src.OrdererChannelLink,
+			UnreadMessageCount: src.UnreadMessageCount,
+			Priority:           src.Priority,
+			TypeId:             src.TypeId,
+			Highlights:         useCaseToOrderSearchHighlightResponse(src.Highlights),
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, resp)
+	return ctx.JSON(http.StatusOK, OrderReadyInfoPageResponse{
+		Items:        resp,
+		PageResponse: domain.ToPageResponse(page),
+	})
 }
 
 type OrderProcessingInfoResponse struct {
@@ -66,22 +155,62 @@ type OrderProcessingInfoResponse struct {
 	OrderStateContent  string    `json:"orderStateContent" validate:"required"`
 	AssigneeName       string    `json:"assigneeName" validate:"required"`
 	AssigneeNickname   string    `json:"assigneeNickname" validate:"required"`
+
+	// UnreadMessageCount 내가 읽지 않은 메시지 수
+	UnreadMessageCount int64 `json:"unreadMessageCount" example:"2"`
+
+	DueDate *time.Time `json:"dueDate" example:"2021-10-30T00:00:00+00:00"`
+
+	// RemainingSeconds 마감까지 남은 시간(초), 마감이 지나면 음수, 마감일이 없으면 null
+	RemainingSeconds *int64 `json:"remainingSeconds" example:"86400"`
+
+	// Overdue 마감 초과 여부
+	Overdue bool `json:"overdue" example:"false"`
+
+	// AtRisk 마감 임박 여부
+	AtRisk bool `json:"atRisk" example:"true"`
+
+	// Priority 우선순위, 목록은 RUSH, HIGH, NORMAL 순 다음 의뢰 일자 순으로 정렬됨
+	Priority domain.OrderPriority `json:"priority" validate:"required" example:"NORMAL" enums:"NORMAL,HIGH,RUSH"`
+
+	// TypeId 의뢰 종류 아이디
+	TypeId uint8 `json:"typeId" example:"1"`
+
+	// Highlights 검색어(q)가 있을 때만, 검색어와 일치한 항목
+	Highlights []OrderSearchHighlightResponse `json:"highlights,omitempty"`
 } // @name OrderProcessingInfoResponse
 
 type OrderProcessingInfoListResponse []OrderProcessingInfoResponse
 
+type OrderProcessingInfoPageResponse struct {
+	Items OrderProcessingInfoListResponse `json:"items" validate:"required"`
+	domain.PageResponse
+} // @name OrderProcessingInfoPageResponse
+
 // @Tags (Order) 어드민 기능
 // @Security Auth-Jwt-Bearer
 // @Summary [어드민] 제작 의뢰 진행중 목록
 // @Description 제작 의뢰 진행중 목록 기능, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
 // @Accept json
 // @Produce json
-// @Param q query string false "검색어"
+// @Param q query string false "검색어, 고객 이름, 채널명, 이메일, 요청사항, 담당 편집자 이름/닉네임, 의뢰 아이디 앞자리"
 // @Param smt query boolean false "자기 업무만 보기"
-// @Success 200 {object} OrderProcessingInfoListResponse true "진행중인 의뢰 목록"
+// @Param sla query string false "SLA 필터 (overdue, atRisk)"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param limit query int false "페이지 크기, 기본 20, 최대 100"
+// @Param sort query string false "정렬 기준 (orderedAt, name, assignee, state)"
+// @Param desc query boolean false "내림차순"
+// @Param orderedFrom query string false "의뢰 일시 시작 (RFC3339)"
+// @Param orderedTo query string false "의뢰 일시 끝 (RFC3339)"
+// @Param dueFrom query string false "마감일 시작 (RFC3339)"
+// @Param dueTo query string false "마감일 끝 (RFC3339)"
+// @Param stateId query int false "의뢰 상태 아이디"
+// @Param assignee query string false "담당 편집자 아이디"
+// @Success 200 {object} OrderProcessingInfoPageResponse true "진행중인 의뢰 목록"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 커서"
 // @Router /order/processing [get]
 func (c *OrderController) fetchOrderToProcessing(ctx echo.Context, userId uuid.UUID) error {
-	res, alreadyResp, err := c.internalFetchOrder(ctx, domain.OrderGeneralStateProcessing, &userId)
+	res, page, alreadyResp, err := c.internalFetchOrder(ctx, domain.OrderGeneralStateProcessing, userId, &userId)
 	if alreadyResp {
 		return err
 	}
@@ -98,22 +227,37 @@ func (c *OrderController) fetchOrderToProcessing(ctx echo.Context, userId uuid.U
 			OrdererChannelLink: src.OrdererChannelLink,
 			OrderState:         src.OrderState,
 			OrderStateContent:  src.OrderStateContent,
+			UnreadMessageCount: src.UnreadMessageCount,
+			Priority:           src.Priority,
+			TypeId:             src.TypeId,
+			DueDate:            src.DueDate,
+			Overdue:            src.Overdue,
+			AtRisk:             src.AtRisk,
+			Highlights:         useCaseToOrderSearchHighlightResponse(src.Highlights),
+		}
+
+		if src.RemainingTime != nil {
+			seconds := int64(src.RemainingTime.Seconds())
+			dst.RemainingSeconds = &seconds
 		}
 
 		if src.AssigneeName == nil {
-			dst.AssigneeName = "알 수 없음"
+			dst.AssigneeName = i18n.T(ctx.Request().Context(), i18n.Unknown)
 		} else {
 			dst.AssigneeName = *src.AssigneeName
 		}
 
 		if src.AssigneeNickname == nil {
-			dst.AssigneeNickname = "알 수 없음"
+			dst.AssigneeNickname = i18n.T(ctx.Request().Context(), i18n.Unknown)
 		} else {
 			dst.AssigneeNickname = *src.AssigneeNickname
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, resp)
+	return ctx.JSON(http.StatusOK, OrderProcessingInfoPageResponse{
+		Items:        resp,
+		PageResponse: domain.ToPageResponse(page),
+	})
 }
 
 type OrderDoneInfoResponse struct {
@@ -124,21 +268,43 @@ type OrderDoneInfoResponse struct {
 	OrdererChannelLink string    `json:"ordererChannelLink" validate:"required"`
 	OrderState         uint8     `json:"orderState" validate:"required"`
 	OrderStateContent  string    `json:"orderStateContent" validate:"required"`
+
+	// UnreadMessageCount 내가 읽지 않은 메시지 수
+	UnreadMessageCount int64 `json:"unreadMessageCount" example:"0"`
+
+	// Highlights 검색어(q)가 있을 때만, 검색어와 일치한 항목
+	Highlights []OrderSearchHighlightResponse `json:"highlights,omitempty"`
 } // @name OrderDoneInfoResponse
 
 type OrderDoneInfoListResponse []OrderDoneInfoResponse
 
+type OrderDoneInfoPageResponse struct {
+	Items OrderDoneInfoListResponse `json:"items" validate:"required"`
+	domain.PageResponse
+} // @name OrderDoneInfoPageResponse
+
 // @Tags (Order) 어드민 기능
 // @Security Auth-Jwt-Bearer
 // @Summary [어드민] 제작 의뢰 완료된 목록
 // @Description 제작 의뢰 완료된 목록 기능, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
 // @Accept json
 // @Produce json
-// @Param q query string false "검색어"
-// @Success 200 {object} OrderDoneInfoListResponse true "완료 의뢰 목록"
+// @Param q query string false "검색어, 고객 이름, 채널명, 이메일, 요청사항, 담당 편집자 이름/닉네임, 의뢰 아이디 앞자리"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param limit query int false "페이지 크기, 기본 20, 최대 100"
+// @Param sort query string false "정렬 기준 (orderedAt, name, assignee, state)"
+// @Param desc query boolean false "내림차순"
+// @Param orderedFrom query string false "의뢰 일시 시작 (RFC3339)"
+// @Param orderedTo query string false "의뢰 일시 끝 (RFC3339)"
+// @Param dueFrom query string false "마감일 시작 (RFC3339)"
+// @Param dueTo query string false "마감일 끝 (RFC3339)"
+// @Param stateId query int false "의뢰 상태 아이디"
+// @Param assignee query string false "담당 편집자 아이디"
+// @Success 200 {object} OrderDoneInfoPageResponse true "완료 의뢰 목록"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 커서"
 // @Router /order/done [get]
-func (c *OrderController) fetchOrderToDone(ctx echo.Context) error {
-	res, alreadyResp, err := c.internalFetchOrder(ctx, domain.OrderGeneralStateDone, nil)
+func (c *OrderController) fetchOrderToDone(ctx echo.Context, userId uuid.UUID) error {
+	res, page, alreadyResp, err := c.internalFetchOrder(ctx, domain.OrderGeneralStateDone, userId, nil)
 	if alreadyResp {
 		return err
 	}
@@ -156,13 +322,18 @@ func (c *OrderController) fetchOrderToDone(ctx echo.Context) error {
 			OrdererChannelLink: src.OrdererChannelLink,
 			OrderState:         src.OrderState,
 			OrderStateContent:  src.OrderStateContent,
+			UnreadMessageCount: src.UnreadMessageCount,
+			Highlights:         useCaseToOrderSearchHighlightResponse(src.Highlights),
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, resp)
+	return ctx.JSON(http.StatusOK, OrderDoneInfoPageResponse{
+		Items:        resp,
+		PageResponse: domain.ToPageResponse(page),
+	})
 }
 
-func (c *OrderController) internalFetchOrder(ctx echo.Context, state domain.OrderGeneralState, userId *uuid.UUID) (res []domain.OrderInfo, alreadyResp bool, err error) {
+func (c *OrderController) internalFetchOrder(ctx echo.Context, state domain.OrderGeneralState, viewerId uuid.UUID, userId *uuid.UUID) (res []domain.OrderInfo, page domain.Page, alreadyResp bool, err error) {
 	var req OrderFetchRequest
 	err = ctx.Bind(&req)
 	if err != nil {
@@ -174,16 +345,37 @@ func (c *OrderController) internalFetchOrder(ctx echo.Context, state domain.Orde
 		return
 	}
 
-	if !req.ShowMyTicket {
-		userId = nil
+	assignee := req.Assignee
+	if req.ShowMyTicket && userId != nil {
+		assignee = userId
 	}
-	res, err = c.useCase.Fetch(ctx.Request().Context(), domain.FetchOrderOption{
-		OrderState: state,
-		Query:      req.Query,
-		Assignee:   userId,
+	res, page, err = c.useCase.Fetch(ctx.Request().Context(), domain.FetchOrderOption{
+		OrderState:  state,
+		Query:       req.Query,
+		Assignee:    assignee,
+		Viewer:      &viewerId,
+		SLA:         domain.OrderSLAFilter(req.SLA),
+		OrderedFrom: req.OrderedFrom,
+		OrderedTo:   req.OrderedTo,
+		DueFrom:     req.DueFrom,
+		DueTo:       req.DueTo,
+		StateId:     req.StateId,
+		Sort:        domain.OrderSort(req.Sort),
+		Desc:        req.Desc,
+		Page: &domain.PageOption{
+			Cursor: req.Cursor,
+			Limit:  req.Limit,
+		},
 	})
 
-	if err != nil {
+	switch err {
+	case nil:
+	case domain.ErrInvalidCursor:
+		alreadyResp = true
+		log.WithError(err).Trace(tag, "fetch order, invalid cursor")
+		err = ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+		return
+	default:
 		alreadyResp = true
 		log.WithError(err).Error(tag, "fetch order, unhandled error useCase.Fetch")
 		err = ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
@@ -214,8 +406,70 @@ type OrderDetailInfoResponse struct {
 	OrderStateContent  string                           `json:"orderStateContent" validate:"required" example:"이펙트 추가 중"`
 	RemainingEditCount uint8                            `json:"remainingEditCount" validate:"required" example:"2"`
 	Requirement        string                           `json:"requirement"`
+	Priority           domain.OrderPriority             `json:"priority" validate:"required" example:"NORMAL" enums:"NORMAL,HIGH,RUSH"`
+	TypeId             uint8                            `json:"typeId" example:"1"`
+
+	// Feedbacks 수정 요청 항목, 수정 회차(revision) 순
+	Feedbacks []OrderFeedbackResponse `json:"feedbacks" validate:"required"`
+
+	// References 참고 링크, 고객이 입력한 순
+	References []OrderReferenceResponse `json:"references" validate:"required"`
 } // @name OrderDetailInfoResponse
 
+type OrderReferenceResponse struct {
+	// Kind 링크 종류
+	// * FOOTAGE_FOLDER - 원본 영상 폴더
+	// * REFERENCE_VIDEO - 참고 영상
+	// * THUMBNAIL_REFERENCE - 참고 썸네일
+	// * MUSIC - 배경 음악
+	Kind domain.OrderReferenceKind `json:"kind" validate:"required" example:"REFERENCE_VIDEO" enums:"FOOTAGE_FOLDER,REFERENCE_VIDEO,THUMBNAIL_REFERENCE,MUSIC"`
+	Url  string                    `json:"url" validate:"required" example:"https://youtu.be/dQw4w9WgXcQ"`
+
+	// YoutubeId 유튜브 링크면 영상 아이디
+	YoutubeId *string `json:"youtubeId" example:"dQw4w9WgXcQ"`
+	Label     *string `json:"label" example:"이 영상 자막 스타일로"`
+} // @name OrderReferenceResponse
+
+type OrderReferenceRequest struct {
+	// Kind 링크 종류
+	// * FOOTAGE_FOLDER - 원본 영상 폴더
+	// * REFERENCE_VIDEO - 참고 영상
+	// * THUMBNAIL_REFERENCE - 참고 썸네일
+	// * MUSIC - 배경 음악
+	Kind domain.OrderReferenceKind `json:"kind" validate:"required,oneof=FOOTAGE_FOLDER REFERENCE_VIDEO THUMBNAIL_REFERENCE MUSIC" example:"REFERENCE_VIDEO" enums:"FOOTAGE_FOLDER,REFERENCE_VIDEO,THUMBNAIL_REFERENCE,MUSIC"`
+
+	// Url http, https 링크만 가능
+	Url   string  `json:"url" validate:"required,url,max=2048" example:"https://youtu.be/dQw4w9WgXcQ"`
+	Label *string `json:"label" validate:"omitempty,max=200" example:"이 영상 자막 스타일로"`
+} // @name OrderReferenceRequest
+
+func requestToOrderReferenceInputList(list []OrderReferenceRequest) []domain.OrderReferenceInput {
+	res := make([]domain.OrderReferenceInput, len(list))
+	for i := range list {
+		res[i] = domain.OrderReferenceInput{
+			Kind:  list[i].Kind,
+			Url:   list[i].Url,
+			Label: list[i].Label,
+		}
+	}
+	return res
+}
+
+type OrderFeedbackResponse struct {
+	FeedbackId uuid.UUID `json:"feedbackId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Revision 몇 번째 수정 요청인지 (1부터 시작)
+	Revision uint8 `json:"revision" validate:"required" example:"1"`
+
+	StartMs     *uint32                      `json:"startMs" example:"83000"`
+	EndMs       *uint32                      `json:"endMs" example:"90500"`
+	Category    domain.OrderFeedbackCategory `json:"category" validate:"required" example:"SUBTITLE" enums:"CUT,SUBTITLE,EFFECT,AUDIO"`
+	Description string                       `json:"description" validate:"required" example:"자막 오타 수정 부탁드려요"`
+	CreatedAt   time.Time                    `json:"createdAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+	ResolvedAt  *time.Time                   `json:"resolvedAt" example:"2021-10-28T04:44:18+00:00"`
+	ResolvedBy  *uuid.UUID                   `json:"resolvedBy" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name OrderFeedbackResponse
+
 // @Tags (Order) 어드민 기능
 // @Security Auth-Jwt-Bearer
 // @Summary [어드민] 의뢰 상세 정보
@@ -223,7 +477,9 @@ type OrderDetailInfoResponse struct {
 // @Accept json
 // @Produce json
 // @Param order_id path string true "의뢰 식별 아이디(UUID)"
-// @Success 200 {object} OrderDetailInfoResponse true "정보 가져오기 완료"
+// @Success 200 {object} OrderDetailInfoResponse true "정보 가져오기 완료, ETag 헤더에 의뢰 버전"
+// @Header 200 {string} ETag "의뢰 버전, 수정 요청 시 If-Match 헤더로 전달"
+// @Failure 404 {object} domain.ErrorResponse "없는 의뢰"
 // @Router /order/{order_id} [get]
 func (c *OrderController) getOrderDetailInfo(ctx echo.Context) error {
 
@@ -240,6 +496,31 @@ func (c *OrderController) getOrderDetailInfo(ctx echo.Context) error {
 
 	res, err := c.useCase.GetOrderDetailInfo(ctx.Request().Context(), req.OrderId)
 
+	switch err {
+	case nil:
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	default:
+		log.WithError(err).Error(tag, "getOrderDetailInfo, unhandled error useCase.GetOrderDetailInfo")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	echox.SetVersionETag(ctx, res.Version)
+	return ctx.JSON(http.StatusOK, useCaseToOrderDetailInfoResponse(res))
+}
+
+// orderVersionConflict 다른 곳에서 먼저 수정했으면 현재 의뢰 정보를 새 ETag 와 함께 내려줌
+func (c *OrderController) orderVersionConflict(ctx echo.Context, orderId uuid.UUID) error {
+	res, err := c.useCase.GetOrderDetailInfo(ctx.Request().Context(), orderId)
+	if err != nil {
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: domain.ErrVersionConflict.Error()})
+	}
+
+	echox.SetVersionETag(ctx, res.Version)
+	return ctx.JSON(http.StatusConflict, useCaseToOrderDetailInfoResponse(res))
+}
+
+func useCaseToOrderDetailInfoResponse(res domain.OrderDetailInfo) OrderDetailInfoResponse {
 	var assignee *orderDetailAssigneeInfoResponse
 	if res.AssigneeInfo != nil {
 		assignee = &orderDetailAssigneeInfoResponse{
@@ -249,7 +530,7 @@ func (c *OrderController) getOrderDetailInfo(ctx echo.Context) error {
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, OrderDetailInfoResponse{
+	return OrderDetailInfoResponse{
 		OrderId:            res.OrderId,
 		OrderedAt:          res.OrderedAt,
 		Orderer:            res.Orderer,
@@ -259,7 +540,93 @@ func (c *OrderController) getOrderDetailInfo(ctx echo.Context) error {
 		OrderStateContent:  res.OrderStateContent,
 		RemainingEditCount: res.RemainingEditCount,
 		Requirement:        res.Requirement,
-	})
+		Priority:           res.Priority,
+		TypeId:             res.TypeId,
+		Feedbacks:          useCaseToOrderFeedbackListResponse(res.Feedbacks),
+		References:         useCaseToOrderReferenceListResponse(res.References),
+	}
+}
+
+func useCaseToOrderReferenceListResponse(list []domain.OrderReferenceInfo) (res []OrderReferenceResponse) {
+	res = make([]OrderReferenceResponse, len(list))
+	for i := range list {
+		res[i] = OrderReferenceResponse{
+			Kind:      list[i].Kind,
+			Url:       list[i].Url,
+			YoutubeId: list[i].YoutubeId,
+			Label:     list[i].Label,
+		}
+	}
+
+	return
+}
+
+func useCaseToOrderFeedbackListResponse(list []domain.OrderFeedbackInfo) (res []OrderFeedbackResponse) {
+	res = make([]OrderFeedbackResponse, len(list))
+	for i := range list {
+		src := list[i]
+		res[i] = OrderFeedbackResponse{
+			FeedbackId:  src.FeedbackId,
+			Revision:    src.Revision,
+			StartMs:     src.StartMs,
+			EndMs:       src.EndMs,
+			Category:    src.Category,
+			Description: src.Description,
+			CreatedAt:   src.CreatedAt,
+			ResolvedAt:  src.ResolvedAt,
+			ResolvedBy:  src.ResolvedBy,
+		}
+	}
+
+	return
+}
+
+type ResolveOrderFeedbackRequest struct {
+	OrderId    uuid.UUID `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	FeedbackId uuid.UUID `json:"-" param:"feedbackId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name ResolveOrderFeedbackRequest
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 수정 요청 항목 해결
+// @Description 수정 요청 항목을 해결 처리하는 기능, 담당 편집자 또는 'SUPER_ADMIN' 만 가능
+// @Accept json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param feedback_id path string true "수정 요청 항목 식별 아이디(UUID)"
+// @Success 204 "해결 처리 완료"
+// @Router /order/{order_id}/feedback/{feedback_id}/resolve [post]
+func (c *OrderController) resolveOrderFeedback(ctx echo.Context, userId uuid.UUID) error {
+	var req ResolveOrderFeedbackRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "resolve order feedback, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	var in = domain.ResolveOrderFeedback{
+		UserId:     userId,
+		OrderId:    req.OrderId,
+		FeedbackId: req.FeedbackId,
+	}
+	err = c.useCase.ResolveOrderFeedback(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "already resolved feedback"})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", in).
+			Error(tag, "resolveOrderFeedback, unhandled error useCase.ResolveOrderFeedback")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
 }
 
 type UpdateOrderInfoRequest struct {
@@ -267,6 +634,9 @@ type UpdateOrderInfoRequest struct {
 	DueDate    time.Time `json:"dueDate" validate:"required" example:"2021-10-30T00:00:00+00:00"`
 	Assignee   uuid.UUID `json:"assignee" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
 	OrderState uint8     `json:"orderState" validate:"required" example:"3"`
+
+	// References 참고 링크, 없으면 바꾸지 않고 빈 배열이면 모두 지움
+	References *[]OrderReferenceRequest `json:"references" validate:"omitempty,max=20,dive"`
 } // @name UpdateOrderInfoRequest
 
 // @Tags (Order) 어드민 기능
@@ -276,8 +646,11 @@ type UpdateOrderInfoRequest struct {
 // @Accept json
 // @Produce json
 // @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param If-Match header string false "의뢰 상세 정보의 ETag, 그 사이 다른 곳에서 수정했으면 409"
 // @Param requestBody body UpdateOrderInfoRequest true "편집 의뢰 요청 데이터 구조"
 // @Success 204 "정보 수정 완료"
+// @Failure 400 {object} domain.ErrorResponse "없는 편집자나 상태, 완료, 취소 상태로 변경, 결과물 없이 수정 완료로 변경, 끝난 의뢰의 상태 변경"
+// @Failure 409 {object} OrderDetailInfoResponse "다른 곳에서 먼저 수정함, 현재 의뢰 정보"
 // @Router /order/{order_id} [put]
 func (c *OrderController) updateOrderInfo(ctx echo.Context) error {
 	var req UpdateOrderInfoRequest
@@ -289,41 +662,128 @@ func (c *OrderController) updateOrderInfo(ctx echo.Context) error {
 		})
 	}
 
-	err = c.useCase.UpdateOrderInfo(ctx.Request().Context(), domain.UpdateOrderInfo{
+	version, err := echox.IfMatchVersion(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	}
+
+	in := domain.UpdateOrderInfo{
 		OrderId:    req.OrderId,
 		DueDate:    req.DueDate,
 		Assignee:   req.Assignee,
 		OrderState: req.OrderState,
+		Version:    version,
+	}
+	if req.References != nil {
+		references := requestToOrderReferenceInputList(*req.References)
+		in.References = &references
+	}
+	err = c.useCase.UpdateOrderInfo(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrVersionConflict:
+		return c.orderVersionConflict(ctx, req.OrderId)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrWeirdData, domain.ErrInvalidReference, domain.ErrOrderClosed:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	default:
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type UpdateOrderPriorityRequest struct {
+	OrderId  uuid.UUID            `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Priority domain.OrderPriority `json:"priority" validate:"required,oneof=NORMAL HIGH RUSH" example:"RUSH" enums:"NORMAL,HIGH,RUSH"`
+} // @name UpdateOrderPriorityRequest
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 의뢰 우선순위 변경
+// @Description 의뢰 우선순위를 바꾸는 기능, 새 우선순위의 기본 마감일이 지금 마감일보다 빠르면 마감일도 당겨짐, 의뢰 횟수는 차감하지 않음, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param If-Match header string false "의뢰 상세 정보의 ETag, 그 사이 다른 곳에서 수정했으면 409"
+// @Param requestBody body UpdateOrderPriorityRequest true "우선순위 데이터 구조"
+// @Success 204 "변경 완료"
+// @Failure 409 {object} OrderDetailInfoResponse "다른 곳에서 먼저 수정함, 현재 의뢰 정보"
+// @Router /order/{order_id}/priority [put]
+func (c *OrderController) updateOrderPriority(ctx echo.Context, userId uuid.UUID) error {
+	var req UpdateOrderPriorityRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "update order priority, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	version, err := echox.IfMatchVersion(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	}
+
+	err = c.useCase.UpdateOrderPriority(ctx.Request().Context(), domain.UpdateOrderPriority{
+		UserId:   userId,
+		OrderId:  req.OrderId,
+		Priority: req.Priority,
+		Version:  version,
 	})
 
 	switch err {
 	case nil:
 		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrVersionConflict:
+		return c.orderVersionConflict(ctx, req.OrderId)
 	case domain.ErrItemNotFound:
 		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
 	case domain.ErrWeirdData:
 		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
 	default:
+		log.WithError(err).
+			WithField("req", req).
+			Error(tag, "updateOrderPriority, unhandled error useCase.UpdateOrderPriority")
 		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
 	}
 }
 
 type OrderAssignSelfResponse struct {
 	OrderId uuid.UUID `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Warnings 강제로 배정했을 때의 경고
+	// * OVER_CAPACITY - 최대 동시 의뢰 수를 넘음
+	// * AWAY - 자동 배정 제외 중이거나 마감일 전에 휴무일이 있음
+	Warnings []domain.OrderAssignWarning `json:"warnings" validate:"required" example:"OVER_CAPACITY" enums:"OVER_CAPACITY,AWAY"`
 }
 
+type OrderAssignSelfWarningResponse struct {
+	Message string `json:"message" validate:"required" example:"editor over capacity or away"`
+
+	// Warnings 배정하지 못한 이유, force=true 로 다시 요청하면 배정됨
+	// * OVER_CAPACITY - 최대 동시 의뢰 수를 넘음
+	// * AWAY - 자동 배정 제외 중이거나 마감일 전에 휴무일이 있음
+	Warnings []domain.OrderAssignWarning `json:"warnings" validate:"required" example:"OVER_CAPACITY" enums:"OVER_CAPACITY,AWAY"`
+} // @name OrderAssignSelfWarningResponse
+
 // @Tags (Order) 어드민 기능
 // @Security Auth-Jwt-Bearer
 // @Summary [어드민] 의뢰 나에게 업무 할당
-// @Description 업무 나에게 할당 하는 기능, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Description 업무 나에게 할당 하는 기능, 최대 동시 의뢰 수를 넘었거나 마감일 전에 휴무일이 있으면 force=true 일 때만 할당됨, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
 // @Accept json
 // @Produce json
 // @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param force query boolean false "경고를 무시하고 할당"
 // @Success 200 {object} OrderAssignSelfResponse true "수주 완료"
+// @Failure 409 {object} OrderAssignSelfWarningResponse "업무량, 휴무로 할당하지 않음"
 // @Router /order/{order_id}/assign-self [post]
 func (c *OrderController) orderAssignSelf(ctx echo.Context, userId uuid.UUID) error {
 	var req struct {
 		OrderId uuid.UUID `json:"-" param:"orderId"`
+		Force   bool      `json:"-" query:"force"`
 	}
 	err := ctx.Bind(&req)
 	if err != nil {
@@ -336,16 +796,28 @@ func (c *OrderController) orderAssignSelf(ctx echo.Context, userId uuid.UUID) er
 	var in = domain.OrderAssignSelf{
 		OrderId:  req.OrderId,
 		Assignee: userId,
+		Force:    req.Force,
+	}
+	warnings, err := c.useCase.OrderAssignSelf(ctx.Request().Context(), in)
+	if warnings == nil {
+		warnings = []domain.OrderAssignWarning{}
 	}
-	err = c.useCase.OrderAssignSelf(ctx.Request().Context(), in)
 
 	switch err {
 	case nil:
 		return ctx.JSON(http.StatusOK, OrderAssignSelfResponse{
-			OrderId: req.OrderId,
+			OrderId:  req.OrderId,
+			Warnings: warnings,
 		})
-	case domain.ErrItemAlreadyExist:
+	case domain.ErrEditorUnavailable:
+		return ctx.JSON(http.StatusConflict, OrderAssignSelfWarningResponse{
+			Message:  err.Error(),
+			Warnings: warnings,
+		})
+	case domain.ErrItemAlreadyExist, domain.ErrVersionConflict:
 		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "assign conflict"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
 	case domain.ErrNoPermission:
 		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
 	default:
@@ -354,4 +826,223 @@ func (c *OrderController) orderAssignSelf(ctx echo.Context, userId uuid.UUID) er
 			Error(tag, "orderAssignSelf / unhandled error useCase.OrderAssignSelf")
 		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
 	}
-}
\ No newline at end of file
+}
+
+type BulkOrderIdsRequest struct {
+	// OrderIds 바꿀 의뢰들, 중복은 한 번만 처리
+	OrderIds []uuid.UUID `json:"orderIds" validate:"required,min=1,max=100" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name BulkOrderIdsRequest
+
+type BulkAssignOrderRequest struct {
+	BulkOrderIdsRequest
+
+	// Assignee 배정할 편집자
+	Assignee uuid.UUID `json:"assignee" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Force 편집자가 바쁘거나 쉬는 중이어도 배정
+	Force bool `json:"force" example:"false"`
+} // @name BulkAssignOrderRequest
+
+type BulkDueDateOrderRequest struct {
+	BulkOrderIdsRequest
+	DueDate time.Time `json:"dueDate" validate:"required" example:"2021-10-30T00:00:00+00:00"`
+} // @name BulkDueDateOrderRequest
+
+type BulkStateOrderRequest struct {
+	BulkOrderIdsRequest
+
+	// OrderState 바꿀 상태, 완료(DONE), 취소(CANCELED) 상태는 불가
+	OrderState uint8 `json:"orderState" validate:"required" example:"3"`
+} // @name BulkStateOrderRequest
+
+type BulkOrderItemResultResponse struct {
+	OrderId uuid.UUID `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Success bool      `json:"success" validate:"required" example:"false"`
+
+	// Error 실패 이유
+	// * NOT_FOUND - 없는 의뢰
+	// * CLOSED - 이미 완료되거나 취소된 의뢰
+	// * VERSION_CONFLICT - 동시에 다른 곳에서 수정함
+	// * EDITOR_UNAVAILABLE - 편집자가 바쁘거나 쉬는 중, force 로 다시 요청
+	// * INTERNAL - 서버 오류
+	Error *string `json:"error" enums:"NOT_FOUND,CLOSED,VERSION_CONFLICT,EDITOR_UNAVAILABLE,INTERNAL" example:"CLOSED"`
+
+	// Warnings 배정할 때 편집자 상태 경고
+	Warnings []domain.OrderAssignWarning `json:"warnings" enums:"OVER_CAPACITY,AWAY" example:"OVER_CAPACITY"`
+} // @name BulkOrderItemResultResponse
+
+type BulkOrderResultResponse struct {
+	SuccessCount int                           `json:"successCount" validate:"required" example:"9"`
+	FailureCount int                           `json:"failureCount" validate:"required" example:"1"`
+	Results      []BulkOrderItemResultResponse `json:"results" validate:"required"`
+} // @name BulkOrderResultResponse
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 여러 의뢰 편집자 배정
+// @Description 여러 의뢰를 한 편집자에게 배정하는 기능, 의뢰마다 따로 저장되어 일부만 실패할 수 있음, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param requestBody body BulkAssignOrderRequest true "배정 데이터 구조"
+// @Success 200 {object} BulkOrderResultResponse true "의뢰별 결과"
+// @Failure 400 {object} domain.ErrorResponse "없는 편집자"
+// @Router /order/bulk/assign [post]
+func (c *OrderController) bulkAssignOrder(ctx echo.Context, userId uuid.UUID) error {
+	var req BulkAssignOrderRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "bulk assign order, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	return c.internalBulkUpdateOrder(ctx, domain.BulkUpdateOrder{
+		UserId:   userId,
+		OrderIds: req.OrderIds,
+		Action:   domain.BulkOrderActionAssign,
+		Assignee: req.Assignee,
+		Force:    req.Force,
+	})
+}
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 여러 의뢰 마감일 변경
+// @Description 여러 의뢰의 마감일을 바꾸는 기능, 의뢰마다 따로 저장되어 일부만 실패할 수 있음, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param requestBody body BulkDueDateOrderRequest true "마감일 데이터 구조"
+// @Success 200 {object} BulkOrderResultResponse true "의뢰별 결과"
+// @Router /order/bulk/due-date [post]
+func (c *OrderController) bulkDueDateOrder(ctx echo.Context, userId uuid.UUID) error {
+	var req BulkDueDateOrderRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "bulk due date order, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	return c.internalBulkUpdateOrder(ctx, domain.BulkUpdateOrder{
+		UserId:   userId,
+		OrderIds: req.OrderIds,
+		Action:   domain.BulkOrderActionDueDate,
+		DueDate:  req.DueDate,
+	})
+}
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 여러 의뢰 상태 변경
+// @Description 여러 의뢰의 상태를 바꾸는 기능, 완료와 취소 상태로는 바꿀 수 없고 편집자가 없는 의뢰는 의뢰 종류의 작업 시작 상태로 바뀜, 의뢰마다 따로 저장되어 일부만 실패할 수 있음, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param requestBody body BulkStateOrderRequest true "상태 데이터 구조"
+// @Success 200 {object} BulkOrderResultResponse true "의뢰별 결과"
+// @Failure 400 {object} domain.ErrorResponse "없거나 바꿀 수 없는 상태"
+// @Router /order/bulk/state [post]
+func (c *OrderController) bulkStateOrder(ctx echo.Context, userId uuid.UUID) error {
+	var req BulkStateOrderRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "bulk state order, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	return c.internalBulkUpdateOrder(ctx, domain.BulkUpdateOrder{
+		UserId:     userId,
+		OrderIds:   req.OrderIds,
+		Action:     domain.BulkOrderActionState,
+		OrderState: req.OrderState,
+	})
+}
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 여러 의뢰 취소
+// @Description 여러 의뢰를 취소하는 기능, 취소한 의뢰는 끝난 의뢰로 취급되고 의뢰 요청 때 차감된 의뢰 횟수는 티켓에 돌려줌, 의뢰마다 따로 저장되어 일부만 실패할 수 있음, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param requestBody body BulkOrderIdsRequest true "취소할 의뢰"
+// @Success 200 {object} BulkOrderResultResponse true "의뢰별 결과"
+// @Router /order/bulk/cancel [post]
+func (c *OrderController) bulkCancelOrder(ctx echo.Context, userId uuid.UUID) error {
+	var req BulkOrderIdsRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "bulk cancel order, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	return c.internalBulkUpdateOrder(ctx, domain.BulkUpdateOrder{
+		UserId:   userId,
+		OrderIds: req.OrderIds,
+		Action:   domain.BulkOrderActionCancel,
+	})
+}
+
+func (c *OrderController) internalBulkUpdateOrder(ctx echo.Context, in domain.BulkUpdateOrder) error {
+	list, err := c.useCase.BulkUpdateOrder(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", in).
+			Error(tag, "bulkUpdateOrder, unhandled error useCase.BulkUpdateOrder")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	res := BulkOrderResultResponse{
+		Results: make([]BulkOrderItemResultResponse, len(list)),
+	}
+	for i := range list {
+		src := list[i]
+		res.Results[i] = BulkOrderItemResultResponse{
+			OrderId:  src.OrderId,
+			Success:  src.Err == nil,
+			Warnings: src.Warnings,
+		}
+
+		if src.Err == nil {
+			res.SuccessCount++
+			continue
+		}
+
+		res.FailureCount++
+		res.Results[i].Error = pointer.String(bulkOrderErrorCode(src.Err))
+		if src.Err != domain.ErrItemNotFound && src.Err != domain.ErrOrderClosed &&
+			src.Err != domain.ErrVersionConflict && src.Err != domain.ErrEditorUnavailable {
+			log.WithError(src.Err).
+				WithField("orderId", src.OrderId).
+				WithField("action", in.Action).
+				Error(tag, "bulkUpdateOrder, unhandled item error useCase.BulkUpdateOrder")
+		}
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func bulkOrderErrorCode(err error) string {
+	switch err {
+	case domain.ErrItemNotFound:
+		return "NOT_FOUND"
+	case domain.ErrOrderClosed:
+		return "CLOSED"
+	case domain.ErrVersionConflict:
+		return "VERSION_CONFLICT"
+	case domain.ErrEditorUnavailable:
+		return "EDITOR_UNAVAILABLE"
+	default:
+		return "INTERNAL"
+	}
+}
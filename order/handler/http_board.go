@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+type OrderBoardRequest struct {
+	// Query 고객 이름, 채널명, 이메일, 요청사항, 담당 편집자 이름/닉네임, 의뢰 아이디 앞자리로 검색, 두 글자 이상
+	Query        string `json:"-" query:"q" validate:"omitempty,min=2,max=100"`
+	ShowMyTicket bool   `json:"-" query:"smt" example:"false"`
+
+	// SLA overdue: 마감 초과만, atRisk: 마감 임박만
+	SLA string `json:"-" query:"sla" validate:"omitempty,oneof=overdue atRisk" example:"overdue"`
+
+	OrderedFrom *time.Time `json:"-" query:"orderedFrom" example:"2021-10-01T00:00:00+09:00"`
+	OrderedTo   *time.Time `json:"-" query:"orderedTo" example:"2021-10-31T23:59:59+09:00"`
+	DueFrom     *time.Time `json:"-" query:"dueFrom" example:"2021-10-01T00:00:00+09:00"`
+	DueTo       *time.Time `json:"-" query:"dueTo" example:"2021-10-31T00:00:00+09:00"`
+
+	// Assignee 담당 편집자로 거르기, smt 가 있으면 무시됨
+	Assignee *uuid.UUID `json:"-" query:"assignee" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// StateId 이 칸만 가져옴, 칸의 다음 페이지를 받을 때 사용
+	StateId *uint8 `json:"-" query:"stateId" validate:"required_with=Cursor" example:"3"`
+
+	// Cursor 칸의 nextCursor, stateId 와 같이 보내야함
+	Cursor string `json:"-" query:"cursor"`
+
+	// Limit 칸마다 카드 수
+	Limit int `json:"-" query:"limit" validate:"omitempty,min=1,max=100" example:"20"`
+} // @name OrderBoardRequest
+
+type OrderBoardCardResponse struct {
+	OrderId            uuid.UUID  `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	OrderedAt          time.Time  `json:"orderedAt" validate:"required"`
+	OrdererName        string     `json:"ordererName" validate:"required"`
+	OrdererChannelName string     `json:"ordererChannelName" validate:"required"`
+	AssigneeNickname   *string    `json:"assigneeNickname" example:"편집자 닉네임"`
+	DueDate            *time.Time `json:"dueDate" example:"2021-10-30T00:00:00+00:00"`
+	DoneAt             *time.Time `json:"doneAt"`
+
+	// RemainingSeconds 마감까지 남은 시간(초), 마감이 지나면 음수, 마감일이 없거나 끝난 의뢰는 null
+	RemainingSeconds *int64 `json:"remainingSeconds" example:"86400"`
+	Overdue          bool   `json:"overdue" example:"false"`
+	AtRisk           bool   `json:"atRisk" example:"true"`
+
+	// UnreadMessageCount 내가 읽지 않은 메시지 수
+	UnreadMessageCount int64 `json:"unreadMessageCount" example:"2"`
+
+	Priority domain.OrderPriority `json:"priority" validate:"required" example:"NORMAL" enums:"NORMAL,HIGH,RUSH"`
+	TypeId   uint8                `json:"typeId" example:"1"`
+
+	// Highlights 검색어(q)가 있을 때만, 검색어와 일치한 항목
+	Highlights []OrderSearchHighlightResponse `json:"highlights,omitempty"`
+} // @name OrderBoardCardResponse
+
+type OrderBoardColumnResponse struct {
+	StateId  uint8                 `json:"stateId" validate:"required" example:"3"`
+	Code     domain.OrderStateCode `json:"code" validate:"required" example:"NONE" enums:"NONE,DEFAULT,TAKE,THUMBNAIL_TAKE,REQUEST_EDIT,EDIT_DONE,DONE,CANCELED"`
+	Content  string                `json:"content" validate:"required" example:"편집 중"`
+	Emoji    string                `json:"emoji" example:"😍"`
+	ParentId *uint8                `json:"parentId" example:"2"`
+	GroupId  *uint8                `json:"groupId" example:"1"`
+
+	// Depth 상태 트리에서의 깊이, 최상위 상태는 0
+	Depth int `json:"depth" example:"1"`
+
+	// Active false 면 꺼진 상태, 남은 의뢰가 있을 때만 보이고 이 칸으로는 옮길 수 없음
+	Active bool `json:"active" example:"true"`
+
+	// Count 칸의 전체 의뢰 수
+	Count int64 `json:"count" validate:"required" example:"42"`
+
+	// NextCursor 칸의 다음 페이지, 없으면 null
+	NextCursor *string                  `json:"nextCursor"`
+	Cards      []OrderBoardCardResponse `json:"cards" validate:"required"`
+} // @name OrderBoardColumnResponse
+
+type OrderBoardResponse struct {
+	// Columns 그룹이 없는 시작 상태, 그룹별 상태 트리, 완료, 취소 순
+	Columns []OrderBoardColumnResponse `json:"columns" validate:"required"`
+} // @name OrderBoardResponse
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 의뢰 보드
+// @Description 의뢰 상태 트리를 따라 상태마다 칸 하나, 칸마다 의뢰 수와 카드 첫 페이지를 주는 기능, 칸의 다음 페이지는 stateId 와 cursor 로 받음, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Produce json
+// @Param q query string false "검색어, 고객 이름, 채널명, 이메일, 요청사항, 담당 편집자 이름/닉네임, 의뢰 아이디 앞자리"
+// @Param smt query boolean false "내가 맡은 의뢰만"
+// @Param sla query string false "SLA (overdue, atRisk)"
+// @Param orderedFrom query string false "의뢰 일시 시작 (RFC3339)"
+// @Param orderedTo query string false "의뢰 일시 끝 (RFC3339)"
+// @Param dueFrom query string false "마감일 시작 (RFC3339)"
+// @Param dueTo query string false "마감일 끝 (RFC3339)"
+// @Param assignee query string false "담당 편집자 아이디"
+// @Param stateId query int false "이 칸만"
+// @Param cursor query string false "칸의 nextCursor, stateId 필요"
+// @Param limit query int false "칸마다 카드 수, 기본 20, 최대 100"
+// @Success 200 {object} OrderBoardResponse true "의뢰 보드"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 커서"
+// @Failure 404 {object} domain.ErrorResponse "없는 상태"
+// @Router /order/board [get]
+func (c *OrderController) fetchOrderBoard(ctx echo.Context, userId uuid.UUID) error {
+	var req OrderBoardRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch order board, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	assignee := req.Assignee
+	if req.ShowMyTicket {
+		assignee = &userId
+	}
+	option := domain.FetchOrderOption{
+		Query:       req.Query,
+		Assignee:    assignee,
+		Viewer:      &userId,
+		SLA:         domain.OrderSLAFilter(req.SLA),
+		OrderedFrom: req.OrderedFrom,
+		OrderedTo:   req.OrderedTo,
+		DueFrom:     req.DueFrom,
+		DueTo:       req.DueTo,
+		StateId:     req.StateId,
+		Page: &domain.PageOption{
+			Cursor: req.Cursor,
+			Limit:  req.Limit,
+		},
+	}
+	columns, err := c.useCase.FetchBoard(ctx.Request().Context(), option)
+
+	switch err {
+	case nil:
+	case domain.ErrInvalidCursor:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	default:
+		log.WithError(err).
+			WithField("option", option).
+			Error(tag, "fetch order board, unhandled error useCase.FetchBoard")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	res := OrderBoardResponse{
+		Columns: make([]OrderBoardColumnResponse, len(columns)),
+	}
+	for i := range columns {
+		src := columns[i]
+		res.Columns[i] = OrderBoardColumnResponse{
+			StateId:    src.StateId,
+			Code:       src.Code,
+			Content:    src.Content,
+			Emoji:      src.Emoji,
+			ParentId:   src.ParentId,
+			GroupId:    src.GroupId,
+			Depth:      src.Depth,
+			Active:     src.Active,
+			Count:      src.Page.Total,
+			NextCursor: src.Page.NextCursor,
+			Cards:      useCaseToOrderBoardCardListResponse(src.Cards),
+		}
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+func useCaseToOrderBoardCardListResponse(list []domain.OrderInfo) []OrderBoardCardResponse {
+	res := make([]OrderBoardCardResponse, len(list))
+	for i := range list {
+		src := list[i]
+		res[i] = OrderBoardCardResponse{
+			OrderId:            src.OrderId,
+			OrderedAt:          src.OrderedAt,
+			OrdererName:        src.OrdererName,
+			OrdererChannelName: src.OrdererChannelName,
+			AssigneeNickname:   src.AssigneeNickname,
+			DueDate:            src.DueDate,
+			DoneAt:             src.DoneAt,
+			Overdue:            src.Overdue,
+			AtRisk:             src.AtRisk,
+			UnreadMessageCount: src.UnreadMessageCount,
+			Priority:           src.Priority,
+			TypeId:             src.TypeId,
+			Highlights:         useCaseToOrderSearchHighlightResponse(src.Highlights),
+		}
+
+		if src.RemainingTime != nil {
+			seconds := int64(src.RemainingTime.Seconds())
+			res[i].RemainingSeconds = &seconds
+		}
+	}
+	return res
+}
+
+type MoveOrderCardRequest struct {
+	OrderId uuid.UUID `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// OrderState 옮길 칸의 상태, 완료(DONE), 취소(CANCELED) 칸으로는 옮길 수 없음
+	OrderState uint8 `json:"orderState" validate:"required" example:"4"`
+} // @name MoveOrderCardRequest
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 의뢰 보드 카드 이동
+// @Description 보드에서 카드를 다른 칸으로 옮겨 의뢰 상태를 바꾸는 기능, 의뢰 정보 수정과 같은 상태 변경 검사를 거침, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param If-Match header string false "의뢰 상세 정보의 ETag, 그 사이 다른 곳에서 수정했으면 409"
+// @Param requestBody body MoveOrderCardRequest true "카드 이동 데이터 구조"
+// @Success 204 "이동 완료"
+// @Failure 400 {object} domain.ErrorResponse "없는 상태, 완료, 취소 칸으로 이동, 결과물 없이 수정 완료 칸으로 이동, 끝난 의뢰, 편집자가 없는 의뢰"
+// @Failure 404 {object} domain.ErrorResponse "없는 의뢰"
+// @Failure 409 {object} OrderDetailInfoResponse "다른 곳에서 먼저 수정함, 현재 의뢰 정보"
+// @Router /order/{order_id}/move [post]
+func (c *OrderController) moveOrderCard(ctx echo.Context, userId uuid.UUID) error {
+	var req MoveOrderCardRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "move order card, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	version, err := echox.IfMatchVersion(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	}
+
+	in := domain.MoveOrderCard{
+		UserId:     userId,
+		OrderId:    req.OrderId,
+		OrderState: req.OrderState,
+		Version:    version,
+	}
+	err = c.useCase.MoveOrderCard(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrVersionConflict:
+		return c.orderVersionConflict(ctx, req.OrderId)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrWeirdData, domain.ErrOrderClosed, domain.ErrOrderNotAssigned:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", in).
+			Error(tag, "move order card, unhandled error useCase.MoveOrderCard")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
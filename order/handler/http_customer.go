@@ -8,11 +8,21 @@ import (
 	"github.com/labstack/echo/v4"
 	log "github.com/sirupsen/logrus"
 	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
 )
 
 type CreateOrderRequest struct {
+	// TypeId 의뢰 종류 아이디, 종류에 따라 차감되는 의뢰 횟수, 수정 횟수, 작업 기간이 달라짐, 비어있으면 롱폼(LONG_FORM)
+	TypeId uint8 `json:"typeId" example:"1"`
+
 	// Requirement, 요청사항
 	Requirement string `json:"requirement" validate:"required,max=2000" example:"알잘딱깔센"`
+
+	// Priority, 우선순위, 구독 플랜에서 허용된 만큼만 고를 수 있고 급할수록 의뢰 횟수가 더 차감될 수 있음, 비어있으면 NORMAL
+	Priority domain.OrderPriority `json:"priority" validate:"omitempty,oneof=NORMAL HIGH RUSH" example:"NORMAL" enums:"NORMAL,HIGH,RUSH"`
+
+	// References 원본 영상 폴더, 참고 영상 등 참고 링크, 유튜브 링크는 영상 아이디도 저장됨
+	References []OrderReferenceRequest `json:"references" validate:"max=20,dive"`
 } // @name CreateOrderRequest
 
 type CreateOrderResponse struct {
@@ -27,6 +37,9 @@ type CreateOrderResponse struct {
 // @Produce json
 // @Param requestBody body CreateOrderRequest true "편집 의뢰 요청 데이터 구조"
 // @Success 201 {object} CreateOrderResponse true "의뢰 요청 성공"
+// @Failure 403 {object} domain.ErrorResponse "사용 중인 구독 티켓 없음, 플랜에서 허용하지 않는 우선순위"
+// @Failure 404 {object} domain.ErrorResponse "없거나 사용하지 않는 의뢰 종류"
+// @Failure 409 {object} domain.ErrorResponse "동시 진행 한도 초과, 남은 의뢰 횟수 부족, 동시에 들어온 다른 의뢰와 충돌"
 // @Router /order [post]
 func (c *OrderController) createOrder(ctx echo.Context, userId uuid.UUID) error {
 	var req CreateOrderRequest
@@ -41,16 +54,25 @@ func (c *OrderController) createOrder(ctx echo.Context, userId uuid.UUID) error
 
 	orderId, err := c.useCase.RequestOrder(ctx.Request().Context(), domain.RequestOrder{
 		UserId:      userId,
+		TypeId:      req.TypeId,
 		Requirement: req.Requirement,
+		Priority:    req.Priority,
+		References:  requestToOrderReferenceInputList(req.References),
 	})
 
 	switch err {
 	case nil:
 		return ctx.JSON(http.StatusCreated, CreateOrderResponse{OrderId: orderId})
+	case domain.ErrInvalidReference:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
 	case domain.ErrNoPermission:
 		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
-	case domain.ErrItemAlreadyExist:
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: "order type not found"})
+	case domain.ErrItemAlreadyExist, domain.ErrNotEnoughOrderCount, domain.ErrVersionConflict:
 		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoActiveTicket, domain.ErrPriorityNotAllowed:
+		return ctx.JSON(http.StatusForbidden, domain.ErrorResponse{Message: err.Error()})
 	default:
 		log.WithError(err).Error(tag, "video requirement failed")
 		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
@@ -81,6 +103,18 @@ type RecentOrderInfoResponse struct {
 
 	// RemainingEditCount 남은 수정 횟수
 	RemainingEditCount uint8      `json:"remainingEditCount" validate:"required" example:"2"`
+
+	// UnreadMessageCount 읽지 않은 메시지 수
+	UnreadMessageCount int64      `json:"unreadMessageCount" example:"1"`
+
+	// Priority 우선순위
+	Priority domain.OrderPriority `json:"priority" validate:"required" example:"NORMAL" enums:"NORMAL,HIGH,RUSH"`
+
+	// TypeId 의뢰 종류 아이디
+	TypeId uint8 `json:"typeId" example:"1"`
+
+	// DoneAt 완료 일시, 진행 중이면 null
+	DoneAt *time.Time `json:"doneAt" example:"2021-10-30T04:44:18+00:00"`
 } //@name RecentOrderInfoResponse
 
 // @Tags (Order) 고객 기능
@@ -89,22 +123,15 @@ type RecentOrderInfoResponse struct {
 // @Description 고객이 진행중인 최근 편집 의뢰 정보를 가져오는 기능, 역할(role)이 'CUSTOMER' 이여야함
 // @Accept json
 // @Success 200 {object} RecentOrderInfoResponse true "의뢰 정보 가져오기 완료"
+// @Header 200 {string} ETag "의뢰 버전, 수정 요청 시 If-Match 헤더로 전달"
 // @Router /order/recent-processing [get]
 func (c *OrderController) getRecentProcessingOrder(ctx echo.Context, userId uuid.UUID) error {
 	res, err := c.useCase.GetRecentProcessingOrder(ctx.Request().Context(), userId)
 
 	switch err {
 	case nil:
-		return ctx.JSON(http.StatusOK, RecentOrderInfoResponse{
-			OrderId:            res.OrderId,
-			OrderedAt:          res.OrderedAt,
-			DueDate:            res.DueDate,
-			AssigneeNickname:   res.AssigneeNickname,
-			OrderState:         res.OrderState,
-			OrderStateContent:  res.OrderStateContent,
-			OrderStateEmoji:    res.OrderStateEmoji,
-			RemainingEditCount: res.RemainingEditCount,
-		})
+		echox.SetVersionETag(ctx, res.Version)
+		return ctx.JSON(http.StatusOK, useCaseToRecentOrderInfoResponse(res))
 	case domain.ErrItemNotFound:
 		return ctx.NoContent(http.StatusNoContent)
 	default:
@@ -113,37 +140,353 @@ func (c *OrderController) getRecentProcessingOrder(ctx echo.Context, userId uuid
 	}
 }
 
+type RecentOrderInfoListResponse []RecentOrderInfoResponse
+
+func useCaseToRecentOrderInfoResponse(src domain.RecentOrderInfo) RecentOrderInfoResponse {
+	return RecentOrderInfoResponse{
+		OrderId:            src.OrderId,
+		OrderedAt:          src.OrderedAt,
+		DueDate:            src.DueDate,
+		AssigneeNickname:   src.AssigneeNickname,
+		OrderState:         src.OrderState,
+		OrderStateContent:  src.OrderStateContent,
+		OrderStateEmoji:    src.OrderStateEmoji,
+		RemainingEditCount: src.RemainingEditCount,
+		UnreadMessageCount: src.UnreadMessageCount,
+		Priority:           src.Priority,
+		TypeId:             src.TypeId,
+		DoneAt:             src.DoneAt,
+	}
+}
+
+type RecentOrderInfoPageResponse struct {
+	Items RecentOrderInfoListResponse `json:"items" validate:"required"`
+	domain.PageResponse
+} // @name RecentOrderInfoPageResponse
+
+type MyOrderFetchRequest struct {
+	// Status processing: 진행 중만, done: 완료만, 비어있으면 모두
+	Status string `json:"-" query:"status" validate:"omitempty,oneof=processing done" example:"done"`
+
+	StateId     *uint8     `json:"-" query:"stateId" example:"3"`
+	OrderedFrom *time.Time `json:"-" query:"orderedFrom" example:"2021-10-01T00:00:00+09:00"`
+	OrderedTo   *time.Time `json:"-" query:"orderedTo" example:"2021-10-31T23:59:59+09:00"`
+
+	// Cursor 이전 응답의 nextCursor, 비어있으면 첫 페이지
+	Cursor string `json:"-" query:"cursor"`
+	Limit  int    `json:"-" query:"limit" validate:"omitempty,min=1,max=100" example:"20"`
+} // @name MyOrderFetchRequest
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 편집 의뢰 목록
+// @Description 고객의 편집 의뢰 목록(완료된 의뢰 포함)을 최근 의뢰 순으로 가져오는 기능, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Produce json
+// @Param status query string false "processing: 진행 중만, done: 완료만"
+// @Param stateId query int false "의뢰 상태 아이디"
+// @Param orderedFrom query string false "의뢰 일시 시작 (RFC3339)"
+// @Param orderedTo query string false "의뢰 일시 끝 (RFC3339)"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param limit query int false "페이지 크기, 기본 20, 최대 100"
+// @Success 200 {object} RecentOrderInfoPageResponse true "의뢰 목록 가져오기 완료"
+// @Success 204 "의뢰 없음"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 커서"
+// @Router /customer/me/orders [get]
+func (c *OrderController) fetchMyOrder(ctx echo.Context, userId uuid.UUID) error {
+	var req MyOrderFetchRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch my order, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	state := domain.OrderGeneralStateAll
+	switch req.Status {
+	case "processing":
+		state = domain.OrderGeneralStateNotDone
+	case "done":
+		state = domain.OrderGeneralStateDone
+	}
+
+	list, page, err := c.useCase.FetchMyOrder(ctx.Request().Context(), domain.FetchMyOrderOption{
+		UserId:      userId,
+		OrderState:  state,
+		StateId:     req.StateId,
+		OrderedFrom: req.OrderedFrom,
+		OrderedTo:   req.OrderedTo,
+		Page: &domain.PageOption{
+			Cursor: req.Cursor,
+			Limit:  req.Limit,
+		},
+	})
+
+	switch err {
+	case nil:
+	case domain.ErrInvalidCursor:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	default:
+		log.WithError(err).
+			WithField("in", userId).
+			Error(tag, "fetchMyOrder, unhandled error useCase.FetchMyOrder")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	res := make(RecentOrderInfoListResponse, len(list))
+	for i := range list {
+		res[i] = useCaseToRecentOrderInfoResponse(list[i])
+	}
+
+	return ctx.JSON(http.StatusOK, RecentOrderInfoPageResponse{
+		Items:        res,
+		PageResponse: domain.ToPageResponse(page),
+	})
+}
+
+type MyOrderRequest struct {
+	OrderId uuid.UUID `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name MyOrderRequest
+
+type MyOrderDeliveryFileResponse struct {
+	Name string `json:"name" validate:"required" example:"최종본.mp4"`
+	Link string `json:"link" validate:"required" example:"https://onedrive.live.com/..."`
+} // @name MyOrderDeliveryFileResponse
+
+type MyOrderDeliveryResponse struct {
+	// Version 결과물 버전, 수정 요청 후 전달될 때마다 1씩 증가
+	Version uint8 `json:"version" validate:"required" example:"1"`
+
+	// State 결과물 상태
+	// * PENDING - 검토 대기
+	// * APPROVED - 승인
+	// * REJECTED - 반려
+	State domain.OrderDeliveryState `json:"state" validate:"required" example:"APPROVED" enums:"PENDING,APPROVED,REJECTED"`
+
+	Files      []MyOrderDeliveryFileResponse `json:"files" validate:"required"`
+	Note       *string                       `json:"note" example:"자막 폰트 변경했습니다"`
+	CreatedAt  time.Time                     `json:"createdAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+	ReviewedAt *time.Time                    `json:"reviewedAt" example:"2021-10-28T04:44:18+00:00"`
+} // @name MyOrderDeliveryResponse
+
+type MyOrderStateTimelineResponse struct {
+	StateId uint8 `json:"stateId" validate:"required" example:"3"`
+
+	// Content 고객에게 보여주는 상태 설명
+	Content string `json:"content" validate:"required" example:"아주 환상적인 이펙트를 입히는 중입니다."`
+	Emoji   string `json:"emoji" example:"🎇"`
+
+	ChangedAt time.Time `json:"changedAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+
+	// Actor 상태를 바꾼 주체, SYSTEM 이면 자동 완료처럼 예약 작업이 바꿈
+	Actor domain.OrderStateActor `json:"actor" validate:"required" enums:"USER,SYSTEM" example:"USER"`
+} // @name MyOrderStateTimelineResponse
+
+type MyOrderDetailInfoResponse struct {
+	RecentOrderInfoResponse
+
+	// UsedEditCount 사용한 수정 횟수
+	UsedEditCount uint8 `json:"usedEditCount" example:"1"`
+
+	// TotalEditCount 전체 수정 횟수
+	TotalEditCount uint8 `json:"totalEditCount" example:"3"`
+
+	// Deliveries 전달받은 결과물, 오래된 버전 순
+	Deliveries []MyOrderDeliveryResponse `json:"deliveries" validate:"required"`
+
+	// StateTimeline 상태가 바뀐 기록, 오래된 순
+	StateTimeline []MyOrderStateTimelineResponse `json:"stateTimeline" validate:"required"`
+} // @name MyOrderDetailInfoResponse
+
+func useCaseToMyOrderDetailInfoResponse(src domain.MyOrderDetailInfo) MyOrderDetailInfoResponse {
+	res := MyOrderDetailInfoResponse{
+		RecentOrderInfoResponse: useCaseToRecentOrderInfoResponse(src.RecentOrderInfo),
+		UsedEditCount:           src.UsedEditCount,
+		TotalEditCount:          src.TotalEditCount,
+		Deliveries:              make([]MyOrderDeliveryResponse, len(src.Deliveries)),
+		StateTimeline:           make([]MyOrderStateTimelineResponse, len(src.StateTimeline)),
+	}
+
+	for i := range src.Deliveries {
+		delivery := src.Deliveries[i]
+		files := make([]MyOrderDeliveryFileResponse, len(delivery.Files))
+		for j := range delivery.Files {
+			files[j] = MyOrderDeliveryFileResponse{
+				Name: delivery.Files[j].Name,
+				Link: delivery.Files[j].Link,
+			}
+		}
+
+		res.Deliveries[i] = MyOrderDeliveryResponse{
+			Version:    delivery.Version,
+			State:      delivery.State,
+			Files:      files,
+			Note:       delivery.Note,
+			CreatedAt:  delivery.CreatedAt,
+			ReviewedAt: delivery.ReviewedAt,
+		}
+	}
+
+	for i := range src.StateTimeline {
+		state := src.StateTimeline[i]
+		res.StateTimeline[i] = MyOrderStateTimelineResponse{
+			StateId:   state.StateId,
+			Content:   state.Content,
+			Emoji:     state.Emoji,
+			ChangedAt: state.ChangedAt,
+			Actor:     state.Actor,
+		}
+	}
+
+	return res
+}
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 편집 의뢰 상세 정보
+// @Description 고객이 자신의 편집 의뢰 상세 정보(결과물, 수정 횟수, 상태 타임라인)를 가져오는 기능, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Success 200 {object} MyOrderDetailInfoResponse true "의뢰 정보 가져오기 완료"
+// @Header 200 {string} ETag "의뢰 버전, 수정 요청 시 If-Match 헤더로 전달"
+// @Router /customer/me/orders/{order_id} [get]
+func (c *OrderController) getMyOrder(ctx echo.Context, userId uuid.UUID) error {
+	var req MyOrderRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "get my order, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	res, err := c.useCase.GetMyOrder(ctx.Request().Context(), userId, req.OrderId)
+
+	switch err {
+	case nil:
+		echox.SetVersionETag(ctx, res.Version)
+		return ctx.JSON(http.StatusOK, useCaseToMyOrderDetailInfoResponse(res))
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", req).
+			Error(tag, "getMyOrder, unhandled error useCase.GetMyOrder")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type OrderEditRequest struct {
+	OrderId uuid.UUID `json:"-" param:"orderId" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Feedbacks 수정 요청 항목 목록
+	Feedbacks []domain.OrderFeedbackItemRequest `json:"feedbacks" validate:"max=50,dive"`
+} // @name OrderEditRequest
+
 // @Tags (Order) 고객 기능
 // @Security Auth-Jwt-Bearer
 // @Summary [고객] 진행중인 편집 수정 의뢰
 // @Description 고객이 진행중인 편집 수정 의뢰 기능, 역할(role)이 'CUSTOMER' 이여야함
 // @Accept json
+// @Param If-Match header string false "의뢰 정보의 ETag, 그 사이 다른 곳에서 수정했으면 409"
+// @Param requestBody body OrderEditRequest false "수정 요청 항목"
 // @Success 202 "수정 요청 성공"
+// @Failure 409 {object} RecentOrderInfoResponse "다른 곳에서 먼저 수정함, 현재 의뢰 정보"
 // @Router /order/recent-processing/edit [post]
 func (c *OrderController) myOrderEdit(ctx echo.Context, userId uuid.UUID) error {
-	err := c.useCase.RequestEditOrder(ctx.Request().Context(), domain.RequestEditOrder{
-		UserId: userId,
-	})
+	return c.internalOrderEdit(ctx, userId)
+}
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 편집 수정 의뢰
+// @Description 고객이 자신의 편집 의뢰에 수정을 요청하는 기능, 타임코드 구간과 분류가 있는 수정 요청 항목을 함께 보낼 수 있음, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param If-Match header string false "의뢰 정보의 ETag, 그 사이 다른 곳에서 수정했으면 409"
+// @Param requestBody body OrderEditRequest false "수정 요청 항목"
+// @Success 202 "수정 요청 성공"
+// @Failure 409 {object} RecentOrderInfoResponse "다른 곳에서 먼저 수정함, 현재 의뢰 정보"
+// @Router /order/{order_id}/edit [post]
+func (c *OrderController) orderEdit(ctx echo.Context, userId uuid.UUID) error {
+	return c.internalOrderEdit(ctx, userId)
+}
+
+// internalOrderEdit orderId path 가 없으면 가장 최근 진행중인 의뢰가 대상
+func (c *OrderController) internalOrderEdit(ctx echo.Context, userId uuid.UUID) error {
+	var req OrderEditRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "order edit, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	version, err := echox.IfMatchVersion(ctx)
+	if err != nil {
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	}
+
+	var in = domain.RequestEditOrder{
+		UserId:    userId,
+		OrderId:   req.OrderId,
+		Feedbacks: domain.ToOrderFeedbackItems(req.Feedbacks),
+		Version:   version,
+	}
+	err = c.useCase.RequestEditOrder(ctx.Request().Context(), in)
 
 	switch err {
 	case nil:
 		return ctx.NoContent(http.StatusAccepted)
+	case domain.ErrVersionConflict:
+		return c.myOrderVersionConflict(ctx, userId, req.OrderId)
 	case domain.ErrItemNotFound:
 		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
 	case domain.ErrWeirdData:
 		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "empty remaining edit count"})
+	case domain.ErrInvalidFeedback:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid feedback timecode range"})
 	case domain.ErrItemAlreadyExist:
 		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "already requested edit"})
 	case domain.ErrNoPermission:
 		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
 	default:
 		log.WithError(err).
-			WithField("in", userId).
-			Error(tag, "myOrderEdit, unhandled error useCase.RequestEditOrder")
+			WithField("in", in).
+			Error(tag, "orderEdit, unhandled error useCase.RequestEditOrder")
 		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
 	}
 }
 
+// myOrderVersionConflict 다른 곳에서 먼저 수정했으면 현재 의뢰 정보를 새 ETag 와 함께 내려줌
+func (c *OrderController) myOrderVersionConflict(ctx echo.Context, userId, orderId uuid.UUID) error {
+	var (
+		res domain.RecentOrderInfo
+		err error
+	)
+	if orderId == uuid.Nil {
+		res, err = c.useCase.GetRecentProcessingOrder(ctx.Request().Context(), userId)
+	} else {
+		var detail domain.MyOrderDetailInfo
+		detail, err = c.useCase.GetMyOrder(ctx.Request().Context(), userId, orderId)
+		res = detail.RecentOrderInfo
+	}
+	if err != nil {
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: domain.ErrVersionConflict.Error()})
+	}
+
+	echox.SetVersionETag(ctx, res.Version)
+	return ctx.JSON(http.StatusConflict, useCaseToRecentOrderInfoResponse(res))
+}
 
 type DoneOrderResponse struct {
 	// OrderId 주문 식별아이디 (UUID)
@@ -158,10 +501,37 @@ type DoneOrderResponse struct {
 // @Success 200 {object} DoneOrderResponse true "의뢰 완료 요청 성공"
 // @Router /order/recent-processing/done [post]
 func (c *OrderController) myOrderDone(ctx echo.Context, userId uuid.UUID) error {
-
-	orderId, err := c.useCase.OrderDone(ctx.Request().Context(), domain.OrderDone{
+	return c.internalOrderDone(ctx, domain.OrderDone{
 		UserId: userId,
 	})
+}
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 편집 의뢰 완료
+// @Description 고객이 자신의 편집 의뢰를 완료하는 기능, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Success 200 {object} DoneOrderResponse true "의뢰 완료 요청 성공"
+// @Router /order/{order_id}/done [post]
+func (c *OrderController) orderDone(ctx echo.Context, userId uuid.UUID) error {
+	var req MyOrderRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "order done, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	return c.internalOrderDone(ctx, domain.OrderDone{
+		UserId:  userId,
+		OrderId: req.OrderId,
+	})
+}
+
+func (c *OrderController) internalOrderDone(ctx echo.Context, in domain.OrderDone) error {
+	orderId, err := c.useCase.OrderDone(ctx.Request().Context(), in)
 
 	switch err {
 	case nil:
@@ -170,10 +540,14 @@ func (c *OrderController) myOrderDone(ctx echo.Context, userId uuid.UUID) error
 		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
 	case domain.ErrItemNotFound:
 		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "not exists order"})
+	case domain.ErrNotApprovedDelivery:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrVersionConflict:
+		return c.myOrderVersionConflict(ctx, in.UserId, in.OrderId)
 	default:
 		log.WithError(err).
-			WithField("in", userId).
-			Error(tag, "myOrderDone, unhandled error useCase.OrderDone")
+			WithField("in", in).
+			Error(tag, "orderDone, unhandled error useCase.OrderDone")
 		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
 	}
 }
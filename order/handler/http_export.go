@@ -0,0 +1,272 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/xlsx"
+)
+
+// exportFlushRows 이 줄 수마다 응답으로 내보냄
+const exportFlushRows = 500
+
+type OrderExportRequest struct {
+	// Format 파일 형식
+	Format string `json:"-" query:"format" validate:"required,oneof=csv xlsx" example:"csv"`
+
+	// Status ready: 요청, processing: 진행 중, done: 완료(취소 포함), all: 전체
+	Status string `json:"-" query:"status" validate:"required,oneof=ready processing done all" example:"done"`
+
+	// Query 고객 이름, 채널명, 이메일, 요청사항, 담당 편집자 이름/닉네임, 의뢰 아이디 앞자리로 검색, 두 글자 이상
+	Query        string `json:"-" query:"q" validate:"omitempty,min=2,max=100"`
+	ShowMyTicket bool   `json:"-" query:"smt" example:"false"`
+
+	// SLA overdue: 마감 초과만, atRisk: 마감 임박만
+	SLA string `json:"-" query:"sla" validate:"omitempty,oneof=overdue atRisk" example:"overdue"`
+
+	OrderedFrom *time.Time `json:"-" query:"orderedFrom" example:"2021-10-01T00:00:00+09:00"`
+	OrderedTo   *time.Time `json:"-" query:"orderedTo" example:"2021-10-31T23:59:59+09:00"`
+	DueFrom     *time.Time `json:"-" query:"dueFrom" example:"2021-10-01T00:00:00+09:00"`
+	DueTo       *time.Time `json:"-" query:"dueTo" example:"2021-10-31T00:00:00+09:00"`
+
+	// DoneFrom, DoneTo 월별 정산은 완료 일시로 거름
+	DoneFrom *time.Time `json:"-" query:"doneFrom" example:"2021-10-01T00:00:00+09:00"`
+	DoneTo   *time.Time `json:"-" query:"doneTo" example:"2021-10-31T23:59:59+09:00"`
+	StateId  *uint8     `json:"-" query:"stateId" example:"3"`
+
+	// Assignee 담당 편집자로 거르기, smt 가 있으면 무시됨
+	Assignee *uuid.UUID `json:"-" query:"assignee" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name OrderExportRequest
+
+var exportOrderGeneralState = map[string]domain.OrderGeneralState{
+	"ready":      domain.OrderGeneralStateReady,
+	"processing": domain.OrderGeneralStateProcessing,
+	"done":       domain.OrderGeneralStateDone,
+	"all":        domain.OrderGeneralStateAll,
+}
+
+var exportHeaderKeys = []string{
+	i18n.ExportOrderId, i18n.ExportOrderedAt, i18n.ExportCustomerName, i18n.ExportCustomerEmail, i18n.ExportChannelName,
+	i18n.ExportAssigneeName, i18n.ExportAssigneeNickname, i18n.ExportState, i18n.ExportPriority, i18n.ExportType,
+	i18n.ExportDueDate, i18n.ExportDoneAt, i18n.ExportCanceledAt, i18n.ExportUsedEditCount, i18n.ExportTotalEditCount, i18n.ExportTicketExOrderId,
+}
+
+// exportHeader 요청 언어로 된 첫 줄
+func exportHeader(ctx context.Context) []interface{} {
+	header := make([]interface{}, len(exportHeaderKeys))
+	for i, key := range exportHeaderKeys {
+		header[i] = i18n.T(ctx, key)
+	}
+	return header
+}
+
+// orderExportWriter csv, xlsx 공통
+type orderExportWriter interface {
+	WriteRow(cells ...interface{}) error
+	Flush() error
+	Close() error
+}
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 의뢰 내보내기
+// @Description 의뢰 목록과 같은 조건으로 거른 의뢰를 CSV, XLSX 파일로 내려받는 기능, 의뢰 일자 순, 날짜는 설정된 시간대 기준, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Description 파일을 받는 중에 오류가 나면 파일이 중간에 끊김
+// @Produce text/csv
+// @Produce application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Param format query string true "파일 형식 (csv, xlsx)"
+// @Param status query string true "의뢰 종류 (ready, processing, done, all)"
+// @Param q query string false "검색어, 고객 이름, 채널명, 이메일, 요청사항, 담당 편집자 이름/닉네임, 의뢰 아이디 앞자리"
+// @Param smt query boolean false "내가 맡은 의뢰만"
+// @Param sla query string false "SLA (overdue, atRisk)"
+// @Param orderedFrom query string false "의뢰 일시 시작 (RFC3339)"
+// @Param orderedTo query string false "의뢰 일시 끝 (RFC3339)"
+// @Param dueFrom query string false "마감일 시작 (RFC3339)"
+// @Param dueTo query string false "마감일 끝 (RFC3339)"
+// @Param doneFrom query string false "완료 일시 시작 (RFC3339)"
+// @Param doneTo query string false "완료 일시 끝 (RFC3339)"
+// @Param stateId query int false "의뢰 상태 아이디"
+// @Param assignee query string false "담당 편집자 아이디"
+// @Success 200 {file} file "의뢰 목록 파일"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 조건"
+// @Router /order/export [get]
+func (c *OrderController) exportOrder(ctx echo.Context, userId uuid.UUID) error {
+	var req OrderExportRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "export order, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	assignee := req.Assignee
+	if req.ShowMyTicket {
+		assignee = &userId
+	}
+	option := domain.FetchOrderOption{
+		OrderState:  exportOrderGeneralState[req.Status],
+		Query:       req.Query,
+		Assignee:    assignee,
+		SLA:         domain.OrderSLAFilter(req.SLA),
+		OrderedFrom: req.OrderedFrom,
+		OrderedTo:   req.OrderedTo,
+		DueFrom:     req.DueFrom,
+		DueTo:       req.DueTo,
+		DoneFrom:    req.DoneFrom,
+		DoneTo:      req.DoneTo,
+		StateId:     req.StateId,
+	}
+
+	loc := config.Export.Location()
+	res := ctx.Response()
+	filename := fmt.Sprintf("orders-%s.%s", time.Now().In(loc).Format("20060102-150405"), req.Format)
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+
+	// 첫 줄들을 모아서 보내므로 조회가 바로 실패하면 오류 응답을 보낼 수 있음
+	out := bufio.NewWriter(res)
+	var w orderExportWriter
+	switch req.Format {
+	case "xlsx":
+		res.Header().Set(echo.HeaderContentType, xlsx.ContentType)
+		w, err = xlsx.NewWriter(out, "orders")
+	default:
+		res.Header().Set(echo.HeaderContentType, "text/csv; charset=utf-8")
+		// 엑셀에서 열어도 한글이 깨지지 않도록 BOM 을 붙임
+		_, err = out.WriteString("\ufeff")
+		w = &csvExportWriter{w: csv.NewWriter(out)}
+	}
+	if err == nil {
+		err = w.WriteRow(exportHeader(ctx.Request().Context())...)
+	}
+
+	rows := 0
+	if err == nil {
+		err = c.useCase.Export(ctx.Request().Context(), option, func(row domain.OrderExportRow) (err error) {
+			err = w.WriteRow(orderExportCells(row, loc)...)
+			if err != nil {
+				return
+			}
+
+			rows++
+			if rows%exportFlushRows == 0 {
+				err = w.Flush()
+				if err == nil {
+					err = out.Flush()
+				}
+				if err == nil {
+					res.Flush()
+				}
+			}
+			return
+		})
+	}
+	if err == nil {
+		err = w.Close()
+	}
+	if err == nil {
+		err = out.Flush()
+	}
+
+	if err != nil {
+		log.WithError(err).
+			WithField("option", option).
+			WithField("rows", rows).
+			Error(tag, "export order, unhandled error useCase.Export")
+
+		// 이미 보내기 시작했으면 상태 코드를 바꿀 수 없어 끊음
+		if res.Committed {
+			return nil
+		}
+
+		res.Header().Del(echo.HeaderContentDisposition)
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+	return nil
+}
+
+// orderExportCells exportHeaderKeys 순서
+func orderExportCells(row domain.OrderExportRow, loc *time.Location) []interface{} {
+	return []interface{}{
+		row.OrderId.String(),
+		row.OrderedAt.In(loc).Format("2006-01-02 15:04:05"),
+		exportString(row.OrdererName),
+		exportString(row.OrdererEmail),
+		exportString(row.OrdererChannelName),
+		exportString(row.AssigneeName),
+		exportString(row.AssigneeNickname),
+		exportString(row.OrderStateContent),
+		string(row.Priority),
+		row.TypeId,
+		exportDate(row.DueDate),
+		exportTime(row.DoneAt, loc),
+		exportTime(row.CanceledAt, loc),
+		row.EditCount,
+		row.TotalEditCount,
+		exportString(row.TicketExOrderId),
+	}
+}
+
+func exportString(s *string) interface{} {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
+
+// exportDate 마감일은 날짜만 저장되므로 시간대를 바꾸지 않음
+func exportDate(t *time.Time) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Format("2006-01-02")
+}
+
+func exportTime(t *time.Time, loc *time.Location) interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.In(loc).Format("2006-01-02 15:04:05")
+}
+
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvExportWriter) WriteRow(cells ...interface{}) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		if cell != nil {
+			record[i] = csvSafeCell(fmt.Sprint(cell))
+		}
+	}
+	return c.w.Write(record)
+}
+
+// csvSafeCell 고객이 입력한 이름 등이 스프레드시트에서 수식으로 실행되지 않도록 앞에 ' 를 붙임
+func csvSafeCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func (c *csvExportWriter) Flush() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+func (c *csvExportWriter) Close() error {
+	return c.Flush()
+}
@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+
+func TestCsvExportWriter_WriteRow(t *testing.T) {
+	tests := []struct {
+		name string
+		cell interface{}
+		want string
+	}{
+		{"plain text", "홍길동", "홍길동"},
+		{"formula", "=HYPERLINK(\"http://evil\")", "'=HYPERLINK(\"http://evil\")"},
+		{"plus", "+1234", "'+1234"},
+		{"minus", "-2+3", "'-2+3"},
+		{"at", "@SUM(A1)", "'@SUM(A1)"},
+		{"tab", "\t=1", "'\t=1"},
+		{"carriage return", "\r=1", "'\r=1"},
+		{"formula in the middle", "a=1", "a=1"},
+		{"number", uint8(3), "3"},
+		{"nil", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := &csvExportWriter{w: csv.NewWriter(&buf)}
+			if err := w.WriteRow(tt.cell, "end"); err != nil {
+				t.Fatal(err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			records, err := csv.NewReader(&buf).ReadAll()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(records) != 1 || len(records[0]) != 2 || records[0][0] != tt.want {
+				t.Fatalf("records = %q, want %q", records, tt.want)
+			}
+		})
+	}
+}
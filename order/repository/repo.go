@@ -3,6 +3,11 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stockfolioofficial/back-editfolio/domain"
@@ -10,8 +15,17 @@ import (
 	"gorm.io/gorm"
 )
 
+// priorityRank NORMAL 0, HIGH 1, RUSH 2
+var priorityRank = fmt.Sprintf("FIELD(`order`.`priority`, '%s', '%s')",
+	domain.OrderPriorityHigh, domain.OrderPriorityRush)
+
+// priorityOrder RUSH, HIGH, NORMAL 순으로 정렬
+var priorityOrder = priorityRank + " desc"
+
+var orderIdPrefix = regexp.MustCompile(`^[0-9a-fA-F-]+$`)
+
 func NewOrderRepository(db *gorm.DB) domain.OrderRepository {
-	db.AutoMigrate(&domain.Order{})
+	db.AutoMigrate(&domain.Order{}, &domain.OrderStateHistory{}, &domain.OrderReference{})
 	return &repo{
 		db: db,
 	}
@@ -36,37 +50,431 @@ func (r *repo) GetRecentByOrdererId(ctx context.Context, ordererId uuid.UUID) (o
 	return
 }
 
+func (r *repo) GetRecentProcessingByOrdererId(ctx context.Context, ordererId uuid.UUID) (order *domain.Order, err error) {
+	var entity domain.Order
+	err = r.db.WithContext(ctx).
+		Order("ordered_at desc").
+		Where("`orderer` = ? AND `done_at` IS NULL", ordererId).
+		First(&entity).Error
+	if err == nil {
+		order = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) CountProcessingByOrdererId(ctx context.Context, ordererId uuid.UUID) (cnt int64, err error) {
+	err = r.db.WithContext(ctx).
+		Model(&domain.Order{}).
+		Where("`orderer` = ? AND `done_at` IS NULL", ordererId).
+		Count(&cnt).Error
+	return
+}
+
+func (r *repo) FetchActiveSlotByOrdererId(ctx context.Context, ordererId uuid.UUID) (list []uint8, err error) {
+	err = r.db.WithContext(ctx).
+		Model(&domain.Order{}).
+		Where("`orderer` = ? AND `done_at` IS NULL AND `active_slot` IS NOT NULL", ordererId).
+		Pluck("active_slot", &list).Error
+	return
+}
+
+func (r *repo) CountProcessingByAssignee(ctx context.Context, assignee uuid.UUID) (cnt int64, err error) {
+	err = r.db.WithContext(ctx).
+		Model(&domain.Order{}).
+		Where("`assignee` = ? AND `done_at` IS NULL", assignee).
+		Count(&cnt).Error
+	return
+}
+
+func (r *repo) FetchSLABreached(ctx context.Context, option domain.FetchSLABreachedOption) (list []domain.Order, err error) {
+	cond := r.filter(r.db, domain.FetchOrderOption{SLA: domain.OrderSLAFilterOverdue})
+	for stateId, before := range option.StuckBefore {
+		cond = cond.Or("`state` = ? AND COALESCE(`state_changed_at`, `ordered_at`) < ?", stateId, before)
+	}
+
+	err = r.db.WithContext(ctx).
+		Order("`ordered_at` asc").
+		Where("`done_at` IS NULL").
+		Where(cond).
+		Find(&list).Error
+	return
+}
+
+func (r *repo) CountProcessingGroupByAssignee(ctx context.Context) (res map[uuid.UUID]int64, err error) {
+	var rows []struct {
+		Assignee uuid.UUID
+		Cnt      int64
+	}
+	err = r.db.WithContext(ctx).
+		Model(&domain.Order{}).
+		Select("`assignee`, COUNT(*) AS `cnt`").
+		Where("`assignee` IS NOT NULL AND `done_at` IS NULL").
+		Group("`assignee`").
+		Scan(&rows).Error
+	if err != nil {
+		return
+	}
+
+	res = make(map[uuid.UUID]int64, len(rows))
+	for i := range rows {
+		res[rows[i].Assignee] = rows[i].Cnt
+	}
+	return
+}
+
 func (r *repo) Fetch(ctx context.Context, option domain.FetchOrderOption) (list []domain.Order, err error) {
+	db := r.filter(r.db.WithContext(ctx), option)
+
+	switch option.OrderState {
+	case domain.OrderGeneralStateReady, domain.OrderGeneralStateProcessing:
+		db = db.Order(priorityOrder).
+			Order("`ordered_at` asc")
+	case domain.OrderGeneralStateDone:
+		db = db.Order("`ordered_at` desc")
+	}
+
+	err = db.Find(&list).Error
+	return
+}
+
+func (r *repo) FetchPage(ctx context.Context, option domain.FetchOrderOption) (list []domain.Order, page domain.Page, err error) {
+	var pageOption domain.PageOption
+	if option.Page != nil {
+		pageOption = *option.Page
+	}
+
+	columns := r.sortColumns(option)
+	values, err := domain.DecodePageCursor(pageOption.Cursor, len(columns))
+	if err != nil {
+		return
+	}
+
+	after, err := parseCursorValues(option.Sort, values)
+	if err != nil {
+		return
+	}
+
+	err = r.filter(r.db.WithContext(ctx).Model(&domain.Order{}), option).
+		Count(&page.Total).Error
+	if err != nil {
+		return
+	}
+
+	db := r.filter(r.db.WithContext(ctx).Select("`order`.*"), option)
+	switch option.Sort {
+	case domain.OrderSortName:
+		db = db.Joins("LEFT JOIN `customer` ON `customer`.`id` = `order`.`orderer`")
+	case domain.OrderSortAssignee:
+		db = db.Joins("LEFT JOIN `manager` ON `manager`.`id` = `order`.`assignee`")
+	}
+
+	size := pageOption.Size()
+	err = gormx.Keyset(db, columns, after, size+1).
+		Find(&list).Error
+	if err != nil || len(list) <= size {
+		return
+	}
+
+	list = list[:size]
+	values, err = r.cursorValues(ctx, option, &list[size-1])
+	if err != nil {
+		return
+	}
+
+	cursor := domain.EncodePageCursor(values...)
+	page.NextCursor = &cursor
+	return
+}
+
+func (r *repo) Export(ctx context.Context, option domain.FetchOrderOption, fn func(row domain.OrderExportRow) error) (err error) {
 	db := r.db.WithContext(ctx)
+	rows, err := r.filter(db.Model(&domain.Order{}), option).
+		Select("`order`.`id` AS `order_id`, `order`.`ordered_at`, "+
+			"`customer`.`name` AS `orderer_name`, `customer`.`email` AS `orderer_email`, "+
+			"`customer`.`channel_name` AS `orderer_channel_name`, "+
+			"`manager`.`name` AS `assignee_name`, `manager`.`nickname` AS `assignee_nickname`, "+
+			"COALESCE(`order_state_translation`.`content`, `order_state`.`content`) AS `order_state_content`, "+
+			"`order`.`priority`, `order`.`type_id`, `order`.`due_date`, `order`.`done_at`, `order`.`canceled_at`, "+
+			"`order`.`edit_count`, `order`.`total_edit_count`, `ticket`.`ex_order_id` AS `ticket_ex_order_id`").
+		Joins("LEFT JOIN `customer` ON `customer`.`id` = `order`.`orderer`").
+		Joins("LEFT JOIN `manager` ON `manager`.`id` = `order`.`assignee`").
+		Joins("LEFT JOIN `order_state` ON `order_state`.`id` = `order`.`state`").
+		// 기본 언어는 번역이 없으므로 그대로 상태 내용을 씀
+		Joins("LEFT JOIN `order_state_translation` ON `order_state_translation`.`state_id` = `order`.`state` AND `order_state_translation`.`lang` = ?",
+			option.Lang).
+		Joins("LEFT JOIN (?) AS `ticket` ON `ticket`.`id` = `order`.`ticket_id`",
+			db.Model(&domain.OrderTicket{}).Select("`id`, `ex_order_id`")).
+		Order("`order`.`ordered_at` asc").
+		Order("`order`.`id` asc").
+		Rows()
+	if err != nil {
+		return
+	}
+	defer rows.Close()
 
+	for rows.Next() {
+		var row domain.OrderExportRow
+		err = db.ScanRows(rows, &row)
+		if err != nil {
+			return
+		}
+
+		err = fn(row)
+		if err != nil {
+			return
+		}
+	}
+	return rows.Err()
+}
+
+// filter 목록 종류, 검색어, 기간, 상태, 담당자, SLA 조건
+func (r *repo) filter(db *gorm.DB, option domain.FetchOrderOption) *gorm.DB {
 	switch option.OrderState {
 	case domain.OrderGeneralStateReady:
-		db = db.Order("`ordered_at` asc").
-			Where("`assignee` IS NULL AND `done_at` IS NULL")
+		db = db.Where("`assignee` IS NULL AND `done_at` IS NULL")
 	case domain.OrderGeneralStateProcessing:
-		db = db.Order("`ordered_at` asc")
 		if option.Assignee == nil {
 			db = db.Where("`assignee` IS NOT NULL AND `done_at` IS NULL")
 		} else {
 			db = db.Where("`assignee` = ? AND `done_at` IS NULL", option.Assignee)
 		}
 	case domain.OrderGeneralStateDone:
+		db = db.Where("`done_at` IS NOT NULL")
+		if option.Assignee != nil {
+			db = db.Where("`assignee` = ?", option.Assignee)
+		}
+	case domain.OrderGeneralStateNotDone:
+		db = db.Where("`done_at` IS NULL")
+	}
 
-		db = db.Order("`ordered_at` desc").
-			Where("`done_at` IS NOT NULL")
+	if option.Orderer != nil {
+		db = db.Where("`orderer` = ?", option.Orderer)
 	}
 
-	//TODO
-	//if len(option.Query) > 0 {
-	//	db = db.Where()
-	//}
+	if query := strings.TrimSpace(option.Query); len(query) > 0 {
+		db = db.Where(r.searchCondition(query))
+	}
 
-	err = db.Find(&list).Error
+	if option.OrderedFrom != nil {
+		db = db.Where("`ordered_at` >= ?", option.OrderedFrom)
+	}
+	if option.OrderedTo != nil {
+		db = db.Where("`ordered_at` <= ?", option.OrderedTo)
+	}
+	if option.DueFrom != nil {
+		db = db.Where("`due_date` >= ?", domain.TruncateDate(*option.DueFrom))
+	}
+	if option.DueTo != nil {
+		db = db.Where("`due_date` <= ?", domain.TruncateDate(*option.DueTo))
+	}
+	if option.DoneFrom != nil {
+		db = db.Where("`done_at` >= ?", option.DoneFrom)
+	}
+	if option.DoneTo != nil {
+		db = db.Where("`done_at` <= ?", option.DoneTo)
+	}
+	if option.StateId != nil {
+		db = db.Where("`state` = ?", option.StateId)
+	}
+
+	// 마감 시각은 마감일 다음 날 0시라 하루 전 시각과 비교
+	since := time.Now().AddDate(0, 0, -1)
+	switch option.SLA {
+	case domain.OrderSLAFilterOverdue:
+		db = db.Where("`done_at` IS NULL AND `due_date` < ?", since)
+	case domain.OrderSLAFilterAtRisk:
+		db = db.Where("`done_at` IS NULL AND `due_date` >= ? AND `due_date` <= ?",
+			since, since.Add(option.AtRiskWindow))
+	}
+	return db
+}
+
+// sortColumns 마지막은 항상 의뢰 아이디라 같은 값끼리도 순서가 정해짐
+func (r *repo) sortColumns(option domain.FetchOrderOption) []gormx.KeysetColumn {
+	desc := option.Desc
+	var columns []gormx.KeysetColumn
+	switch option.Sort {
+	case domain.OrderSortOrderedAt:
+		columns = []gormx.KeysetColumn{{Expr: "`order`.`ordered_at`", Desc: desc}}
+	case domain.OrderSortName:
+		columns = []gormx.KeysetColumn{{Expr: "COALESCE(`customer`.`name`, '')", Desc: desc}}
+	case domain.OrderSortAssignee:
+		columns = []gormx.KeysetColumn{{Expr: "COALESCE(`manager`.`nickname`, '')", Desc: desc}}
+	case domain.OrderSortState:
+		columns = []gormx.KeysetColumn{{Expr: "`order`.`state`", Desc: desc}}
+	default:
+		if option.OrderState == domain.OrderGeneralStateDone {
+			columns = []gormx.KeysetColumn{{Expr: "`order`.`ordered_at`", Desc: !desc}}
+		} else {
+			columns = []gormx.KeysetColumn{
+				{Expr: priorityRank, Desc: !desc},
+				{Expr: "`order`.`ordered_at`", Desc: desc},
+			}
+		}
+	}
+
+	return append(columns, gormx.KeysetColumn{Expr: "`order`.`id`", Desc: columns[0].Desc})
+}
+
+// cursorValues sortColumns 순서대로 마지막 행의 정렬 값
+func (r *repo) cursorValues(ctx context.Context, option domain.FetchOrderOption, last *domain.Order) (values []string, err error) {
+	switch option.Sort {
+	case domain.OrderSortOrderedAt:
+		values = []string{last.OrderedAt.Format(time.RFC3339Nano)}
+	case domain.OrderSortName:
+		var names []string
+		err = r.db.WithContext(ctx).
+			Model(&domain.Customer{}).
+			Where("`id` = ?", last.Orderer).
+			Pluck("name", &names).Error
+		values = []string{firstOrZero(names)}
+	case domain.OrderSortAssignee:
+		var nicknames []string
+		if last.Assignee != nil {
+			err = r.db.WithContext(ctx).
+				Model(&domain.Manager{}).
+				Where("`id` = ?", last.Assignee).
+				Pluck("nickname", &nicknames).Error
+		}
+		values = []string{firstOrZero(nicknames)}
+	case domain.OrderSortState:
+		values = []string{strconv.Itoa(int(last.State))}
+	default:
+		if option.OrderState == domain.OrderGeneralStateDone {
+			values = []string{last.OrderedAt.Format(time.RFC3339Nano)}
+		} else {
+			values = []string{
+				strconv.Itoa(last.Priority.Rank()),
+				last.OrderedAt.Format(time.RFC3339Nano),
+			}
+		}
+	}
+
+	return append(values, last.Id.String()), err
+}
+
+// parseCursorValues 시각은 time.Time 으로 바꿔야 DB 에서 비교됨
+func parseCursorValues(sort domain.OrderSort, values []string) (after []interface{}, err error) {
+	after = make([]interface{}, len(values))
+	for i := range values {
+		after[i] = values[i]
+	}
+
+	timeAt := -1
+	switch sort {
+	case domain.OrderSortOrderedAt:
+		timeAt = 0
+	case domain.OrderSortDefault:
+		timeAt = len(values) - 2
+	}
+
+	if timeAt >= 0 && timeAt < len(values) {
+		var t time.Time
+		t, err = time.Parse(time.RFC3339Nano, values[timeAt])
+		if err != nil {
+			return nil, domain.ErrInvalidCursor
+		}
+		after[timeAt] = t
+	}
 	return
 }
 
+func firstOrZero(list []string) string {
+	if len(list) == 0 {
+		return ""
+	}
+	return list[0]
+}
+
+// searchCondition 요청사항, 고객, 편집자는 ngram FULLTEXT 인덱스로, 의뢰 아이디는 앞자리로 검색,
+// ngram 토큰보다 짧은 검색어는 FULLTEXT 로 찾을 수 없어서 LIKE 로 검색
+func (r *repo) searchCondition(query string) *gorm.DB {
+	var cond *gorm.DB
+	if gormx.IsShorterThanNgram(query) {
+		pattern := gormx.LikeContains(query)
+		cond = r.db.Where("`requirement` LIKE ?", pattern).
+			Or("`orderer` IN (?)", r.db.Model(&domain.Customer{}).
+				Select("`id`").
+				Where("`name` LIKE ? OR `channel_name` LIKE ? OR `email` LIKE ?", pattern, pattern, pattern)).
+			Or("`assignee` IN (?)", r.db.Model(&domain.Manager{}).
+				Select("`id`").
+				Where("`name` LIKE ? OR `nickname` LIKE ?", pattern, pattern))
+	} else {
+		phrase := gormx.FulltextPhrase(query)
+		cond = r.db.Where("MATCH(`requirement`) AGAINST(? IN BOOLEAN MODE)", phrase).
+			Or("`orderer` IN (?)", r.db.Model(&domain.Customer{}).
+				Select("`id`").
+				Where("MATCH(`name`, `channel_name`, `email`) AGAINST(? IN BOOLEAN MODE)", phrase)).
+			Or("`assignee` IN (?)", r.db.Model(&domain.Manager{}).
+				Select("`id`").
+				Where("MATCH(`name`, `nickname`) AGAINST(? IN BOOLEAN MODE)", phrase))
+	}
+
+	if orderIdPrefix.MatchString(query) {
+		cond = cond.Or("`order`.`id` LIKE ?", strings.ToLower(query)+"%")
+	}
+	return cond
+}
+
+// Save 상태 변경 기록도 같은 트랜잭션에서 저장
 func (r *repo) Save(ctx context.Context, order *domain.Order) error {
-	return gormx.Upsert(ctx, r.db, order)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		saved, err := gormx.SaveWithVersion(ctx, tx, order, &order.Version)
+		if gormx.IsDuplicateEntry(err) {
+			// 같은 고객의 다른 의뢰가 먼저 같은 동시 진행 자리를 차지함
+			return domain.ErrItemAlreadyExist
+		}
+		if err != nil {
+			return err
+		}
+		if !saved {
+			return domain.ErrVersionConflict
+		}
+
+		if len(order.NewStateHistories) > 0 {
+			err = tx.Create(&order.NewStateHistories).Error
+			if err != nil {
+				return err
+			}
+			order.NewStateHistories = nil
+		}
+
+		if order.NewReferences != nil {
+			err = tx.Where("`order_id` = ?", order.Id).Delete(&domain.OrderReference{}).Error
+			if err != nil {
+				return err
+			}
+
+			if len(order.NewReferences) > 0 {
+				err = tx.Create(&order.NewReferences).Error
+				if err != nil {
+					return err
+				}
+			}
+			order.NewReferences = nil
+		}
+		return nil
+	})
+}
+
+func (r *repo) FetchReferenceByOrderId(ctx context.Context, orderId uuid.UUID) (list []domain.OrderReference, err error) {
+	err = r.db.WithContext(ctx).
+		Where("`order_id` = ?", orderId).
+		Order("`seq` asc").
+		Find(&list).Error
+	return
+}
+
+func (r *repo) FetchStateHistoryByOrderId(ctx context.Context, orderId uuid.UUID) (list []domain.OrderStateHistory, err error) {
+	err = r.db.WithContext(ctx).
+		Where("`order_id` = ?", orderId).
+		Order("`changed_at` asc").
+		Order("`id` asc").
+		Find(&list).Error
+	return
 }
 
 func (r *repo) Get() *gorm.DB {
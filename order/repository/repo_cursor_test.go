@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+func TestParseCursorValues(t *testing.T) {
+	const id = "550e8400-e29b-41d4-a716-446655440000"
+	orderedAt := time.Date(2021, 10, 27, 4, 44, 18, 123456000, time.UTC)
+	at := orderedAt.Format(time.RFC3339Nano)
+
+	tests := []struct {
+		name    string
+		sort    domain.OrderSort
+		values  []string
+		want    []interface{}
+		wantErr error
+	}{
+		{"default with priority", domain.OrderSortDefault, []string{"2", at, id}, []interface{}{"2", orderedAt, id}, nil},
+		{"default done list", domain.OrderSortDefault, []string{at, id}, []interface{}{orderedAt, id}, nil},
+		{"ordered at", domain.OrderSortOrderedAt, []string{at, id}, []interface{}{orderedAt, id}, nil},
+		{"name", domain.OrderSortName, []string{"김편집", id}, []interface{}{"김편집", id}, nil},
+		{"state", domain.OrderSortState, []string{"3", id}, []interface{}{"3", id}, nil},
+		{"broken time", domain.OrderSortOrderedAt, []string{"yesterday", id}, nil, domain.ErrInvalidCursor},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseCursorValues(tt.sort, tt.values)
+			if err != tt.wantErr {
+				t.Fatalf("parseCursorValues() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("parseCursorValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,8 +8,15 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 
+	"github.com/stockfolioofficial/back-editfolio/core/config"
 	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+)
+
+const (
+	tag = "[ORDER] "
 )
 
 func NewOrderUseCase(
@@ -19,34 +26,52 @@ func NewOrderUseCase(
 	customerRepo domain.CustomerRepository,
 	orderStateRepo domain.OrderStateRepository,
 	orderTicketRepo domain.OrderTicketRepository,
+	orderDeliveryRepo domain.OrderDeliveryRepository,
+	orderMessageRepo domain.OrderMessageRepository,
+	orderFeedbackRepo domain.OrderFeedbackRepository,
+	orderTypeRepo domain.OrderTypeRepository,
+	orderAssignmentUseCase domain.OrderAssignmentUseCase,
 	timeout time.Duration,
 ) domain.OrderUseCase {
 	return &ucase{
-		orderRepo:       orderRepo,
-		userRepo:        userRepo,
-		managerRepo:     managerRepo,
-		customerRepo:    customerRepo,
-		orderStateRepo:  orderStateRepo,
-		orderTicketRepo: orderTicketRepo,
-		timeout:         timeout,
+		orderRepo:              orderRepo,
+		userRepo:               userRepo,
+		managerRepo:            managerRepo,
+		customerRepo:           customerRepo,
+		orderStateRepo:         orderStateRepo,
+		orderTicketRepo:        orderTicketRepo,
+		orderDeliveryRepo:      orderDeliveryRepo,
+		orderMessageRepo:       orderMessageRepo,
+		orderFeedbackRepo:      orderFeedbackRepo,
+		orderTypeRepo:          orderTypeRepo,
+		orderAssignmentUseCase: orderAssignmentUseCase,
+		timeout:                timeout,
 	}
 }
 
 type ucase struct {
-	orderRepo       domain.OrderRepository
-	userRepo        domain.UserRepository
-	managerRepo     domain.ManagerRepository
-	customerRepo    domain.CustomerRepository
-	orderStateRepo  domain.OrderStateRepository
-	orderTicketRepo domain.OrderTicketRepository
-	timeout         time.Duration
+	orderRepo              domain.OrderRepository
+	userRepo               domain.UserRepository
+	managerRepo            domain.ManagerRepository
+	customerRepo           domain.CustomerRepository
+	orderStateRepo         domain.OrderStateRepository
+	orderTicketRepo        domain.OrderTicketRepository
+	orderDeliveryRepo      domain.OrderDeliveryRepository
+	orderMessageRepo       domain.OrderMessageRepository
+	orderFeedbackRepo      domain.OrderFeedbackRepository
+	orderTypeRepo          domain.OrderTypeRepository
+	orderAssignmentUseCase domain.OrderAssignmentUseCase
+	timeout                time.Duration
 }
 
 func (u *ucase) RequestOrder(ctx context.Context, in domain.RequestOrder) (newId uuid.UUID, err error) {
 	c, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
-	var defaultState uint8 = 1
+	var (
+		defaultState uint8 = 1
+		orderType    *domain.OrderType
+	)
 	g, gc := errgroup.WithContext(ctx)
 	g.Go(func() (err error) {
 		exists, err := u.userRepo.GetById(gc, in.UserId)
@@ -60,11 +85,15 @@ func (u *ucase) RequestOrder(ctx context.Context, in domain.RequestOrder) (newId
 		return
 	})
 	g.Go(func() (err error) {
-		exists, _ := u.orderRepo.GetRecentByOrdererId(gc, in.UserId)
-		if exists != nil && !exists.IsDone() {
-			err = domain.ErrItemAlreadyExist
+		// 종류가 생기기 전의 클라이언트는 종류를 보내지 않으므로 롱폼으로 받음
+		if in.TypeId == 0 {
+			orderType, err = u.orderTypeRepo.GetByCode(gc, domain.OrderTypeCodeLongForm)
+		} else {
+			orderType, err = u.orderTypeRepo.GetById(gc, in.TypeId)
+		}
+		if err == nil && (orderType == nil || !orderType.Active) {
+			err = domain.ErrItemNotFound
 		}
-
 		return
 	})
 	g.Go(func() error {
@@ -80,10 +109,25 @@ func (u *ucase) RequestOrder(ctx context.Context, in domain.RequestOrder) (newId
 		return
 	}
 
+	priority := in.Priority
+	if priority == "" {
+		priority = domain.OrderPriorityNormal
+	}
+
+	if !priority.IsValid() {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	// 종류별 차감 횟수 중 한 건은 기본 차감이므로 나머지를 우선순위 추가 차감과 합산
+	extra := orderType.Cost() - 1 + config.Priority.ExtraOrderCount[string(priority)]
 	err = u.orderTicketRepo.Transaction(c, func(otr domain.OrderTicketTxRepository) (err error) {
 		orderOption := domain.CreateOrderOption{
-			Orderer:     in.UserId,
-			State:       defaultState,
+			Orderer:  in.UserId,
+			State:    defaultState,
+			Priority: priority,
+			TypeId:   orderType.Id,
+			DueDays:  orderType.DueDays(config.Priority.DueDays[string(priority)]),
 		}
 		if len(in.Requirement) > 0 {
 			orderOption.Requirement = &in.Requirement
@@ -91,27 +135,66 @@ func (u *ucase) RequestOrder(ctx context.Context, in domain.RequestOrder) (newId
 
 		or := u.orderRepo.With(otr)
 
-		ticket, err := otr.GetByOwnerIdBetweenStartAndEnd(c, in.UserId, time.Now())
+		// 같은 고객의 의뢰 요청은 티켓 잠금이 풀릴 때까지 기다렸다가 차례로 검사함
+		ticket, err := otr.LockByOwnerIdBetweenStartAndEnd(c, in.UserId, time.Now())
+		if err != nil {
+			return
+		}
+
+		if ticket == nil {
+			return domain.ErrNoActiveTicket
+		}
+
+		// 동시에 들어온 요청이 남은 횟수를 먼저 다 쓰면 여기서 걸림
+		if ticket.IsEmptyOrderCount() {
+			return domain.ErrNotEnoughOrderCount
+		}
+
+		processing, err := or.CountProcessingByOrdererId(c, in.UserId)
+		if err != nil {
+			return
+		}
+
+		if ticket.IsFullConcurrentOrder(processing) {
+			return domain.ErrItemAlreadyExist
+		}
+
+		usedSlots, err := or.FetchActiveSlotByOrdererId(c, in.UserId)
 		if err != nil {
 			return
 		}
 
-		if ticket == nil || ticket.IsEmptyOrderCount() {
-			return errors.New("no ticket") // todo error handling
+		slot, ok := ticket.FreeActiveSlot(usedSlots)
+		if !ok {
+			return domain.ErrItemAlreadyExist
 		}
 
-		ticket.UseOrder()
-		orderOption.EditCount = ticket.EditCount
+		if !ticket.AllowsPriority(priority) {
+			return domain.ErrPriorityNotAllowed
+		}
+
+		if !ticket.HasOrderCount(extra) {
+			return domain.ErrNotEnoughOrderCount
+		}
+
+		ticket.UseOrderWithExtra(extra)
+		orderOption.EditCount = orderType.EditCount(ticket.EditCount)
+		orderOption.ActiveSlot = slot
+		orderOption.TicketId = ticket.Id
+		orderOption.UsedOrderCount = 1 + extra
 		order := domain.CreateOrder(orderOption)
+		err = order.ReplaceReferences(in.References)
+		if err != nil {
+			return
+		}
 
-		g, gc = errgroup.WithContext(c)
-		g.Go(func() error {
-			return otr.Save(gc, ticket)
-		})
-		g.Go(func() error {
-			return or.Save(gc, &order)
-		})
-		err = g.Wait()
+		// 하나의 트랜잭션 연결은 동시에 쓸 수 없으므로 차례로 저장
+		err = otr.Save(c, ticket)
+		if err != nil {
+			return
+		}
+
+		err = or.Save(c, &order)
 		if err != nil {
 			return
 		}
@@ -119,17 +202,37 @@ func (u *ucase) RequestOrder(ctx context.Context, in domain.RequestOrder) (newId
 		newId = order.Id
 		return
 	})
+	if err != nil {
+		return
+	}
 
+	go u.assignRequestedOrder(newId)
 	return
 }
 
+// assignRequestedOrder 의뢰 요청 응답이 늦어지지 않도록 커밋 후 따로 실행
+func (u *ucase) assignRequestedOrder(orderId uuid.UUID) {
+	err := u.orderAssignmentUseCase.AssignRequestedOrder(context.Background(), orderId)
+	if err != nil {
+		log.WithError(err).
+			WithField("orderId", orderId).
+			Error(tag, "assignRequestedOrder, orderAssignmentUseCase.AssignRequestedOrder")
+	}
+}
+
 func (u *ucase) RequestEditOrder(ctx context.Context, in domain.RequestEditOrder) (err error) {
 	c, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
+	if !domain.IsValidOrderFeedbackItems(in.Feedbacks) {
+		err = domain.ErrInvalidFeedback
+		return
+	}
+
 	var (
-		order *domain.Order
-		state *domain.OrderState
+		order  *domain.Order
+		state  *domain.OrderState
+		latest *domain.OrderDelivery
 	)
 	g, gc := errgroup.WithContext(ctx)
 	g.Go(func() (err error) {
@@ -145,21 +248,27 @@ func (u *ucase) RequestEditOrder(ctx context.Context, in domain.RequestEditOrder
 		return
 	})
 	g.Go(func() (err error) {
-		order, err = u.orderRepo.GetRecentByOrdererId(c, in.UserId)
+		order, err = domain.GetCustomerOrder(gc, u.orderRepo, in.UserId, in.OrderId)
 		if err != nil {
 			return
 		}
 
-		if order == nil {
+		if order.IsDone() {
 			err = domain.ErrItemNotFound
 			return
 		}
 
+		if !order.IsVersion(in.Version) {
+			err = domain.ErrVersionConflict
+			return
+		}
+
 		if order.IsEmptyEditCount() {
 			err = domain.ErrWeirdData
 			return
 		}
 
+		latest, err = u.orderDeliveryRepo.GetLatestByOrderId(gc, order.Id)
 		return
 	})
 	g.Go(func() (err error) {
@@ -179,8 +288,31 @@ func (u *ucase) RequestEditOrder(ctx context.Context, in domain.RequestEditOrder
 		return
 	}
 	order.UseEdit()
-	order.State = state.Id
-	err = u.orderRepo.Save(c, order)
+	order.ChangeState(state.Id)
+
+	// 검토 대기 중인 결과물이 있으면 반려한 것으로 봄, 그대로 두면 다음 결과물을 전달할 수 없음
+	rejectLatest := latest != nil && latest.IsPending()
+	if rejectLatest {
+		latest.Reject()
+	}
+
+	// 수정 요청 항목은 이번 수정 회차(Revision)로 묶어 저장
+	feedbacks := domain.CreateOrderFeedbackList(order.Id, order.EditCount, in.UserId, in.Feedbacks)
+	err = u.orderRepo.Transaction(c, func(or domain.OrderTxRepository) (err error) {
+		err = or.Save(c, order)
+		if err != nil {
+			return
+		}
+
+		if rejectLatest {
+			err = u.orderDeliveryRepo.With(or).Save(c, latest)
+			if err != nil {
+				return
+			}
+		}
+
+		return u.orderFeedbackRepo.With(or).SaveAll(c, feedbacks)
+	})
 	return
 }
 
@@ -206,13 +338,24 @@ func (u *ucase) OrderDone(ctx context.Context, in domain.OrderDone) (orderId uui
 		return
 	})
 	g.Go(func() (err error) {
-		order, err = u.orderRepo.GetRecentByOrdererId(c, in.UserId)
+		order, err = domain.GetCustomerOrder(gc, u.orderRepo, in.UserId, in.OrderId)
 		if err != nil {
 			return
 		}
 
-		if order == nil || order.IsDone() {
+		if order.IsDone() {
 			err = domain.ErrItemNotFound
+			return
+		}
+
+		approved, err := u.orderDeliveryRepo.ExistsApprovedByOrderId(gc, order.Id)
+		if err != nil {
+			return
+		}
+
+		if !approved {
+			err = domain.ErrNotApprovedDelivery
+			return
 		}
 
 		order.Done()
@@ -230,7 +373,7 @@ func (u *ucase) OrderDone(ctx context.Context, in domain.OrderDone) (orderId uui
 		return
 	}
 
-	order.State = state.Id
+	order.ChangeState(state.Id)
 	err = u.orderRepo.Save(c, order)
 	if err != nil {
 		return
@@ -254,6 +397,10 @@ func (u *ucase) UpdateOrderInfo(ctx context.Context, in domain.UpdateOrderInfo)
 		return
 	}
 
+	if !order.IsVersion(in.Version) {
+		err = domain.ErrVersionConflict
+		return
+	}
 
 	var (
 		aExists *domain.Manager
@@ -274,39 +421,126 @@ func (u *ucase) UpdateOrderInfo(ctx context.Context, in domain.UpdateOrderInfo)
 	})
 
 	g.Go(func() (err error) {
-		if order.Assignee == nil {
-			sExists, err = u.orderStateRepo.GetByCode(gc, domain.OrderStateCodeTake)
-		} else {
-			sExists, err = u.orderStateRepo.GetById(gc, in.OrderState)
+		sExists, err = u.getNextState(gc, order, in.OrderState)
+		return
+	})
+
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	if in.References != nil {
+		err = order.ReplaceReferences(*in.References)
+		if err != nil {
+			return
 		}
+	}
+
+	order.DueDate = &in.DueDate
+	order.Assignee = &in.Assignee
+	order.ChangeState(sExists.Id)
+
+	return u.orderRepo.Save(c, order)
+}
+
+// getNextState 의뢰 정보 수정, 일괄 상태 변경, 보드 카드 이동에서 같이 쓰는 상태 변경 검사,
+// 편집자가 없던 의뢰는 작업 시작 상태가 되고 끝난 의뢰의 상태나 완료, 취소, 꺼진 상태로는 바꿀 수 없음,
+// 수정 완료는 고객이 검토할 결과물이 있어야 옮길 수 있음
+func (u *ucase) getNextState(ctx context.Context, order *domain.Order, stateId uint8) (state *domain.OrderState, err error) {
+	if order.IsDone() && (order.Assignee == nil || order.State != stateId) {
+		err = domain.ErrOrderClosed
+		return
+	}
+
+	if order.Assignee == nil {
+		return u.getTakeState(ctx, order)
+	}
+
+	state, err = u.orderStateRepo.GetById(ctx, stateId)
+	if err != nil {
+		return
+	}
+
+	// 완료는 결과물 승인으로, 취소는 취소 기능으로만 바뀌고 꺼진 상태로는 옮길 수 없음
+	if state == nil || (state.Id != order.State && (!state.Active ||
+		state.Code == domain.OrderStateCodeDone || state.Code == domain.OrderStateCodeCanceled)) {
+		state, err = nil, domain.ErrWeirdData
+		return
+	}
+
+	if state.Id == order.State || state.Code != domain.OrderStateCodeEditDone {
+		return
+	}
+
+	// 결과물 없이 수정 완료로 옮기면 자동 완료 대상이 되지 않아 의뢰가 끝나지 않음
+	latest, err := u.orderDeliveryRepo.GetLatestByOrderId(ctx, order.Id)
+	if err != nil {
+		state = nil
+		return
+	}
+
+	if latest == nil || latest.State == domain.OrderDeliveryStateRejected {
+		state, err = nil, domain.ErrWeirdData
+	}
+	return
+}
+
+// UpdateOrderPriority 어드민이 바꾸는 우선순위는 의뢰 횟수를 차감하지 않음
+func (u *ucase) UpdateOrderPriority(ctx context.Context, in domain.UpdateOrderPriority) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if !in.Priority.IsValid() {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	var order *domain.Order
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		user, err := u.userRepo.GetById(gc, in.UserId)
 		if err != nil {
-			return err
+			return
 		}
 
-		if sExists == nil {
-			err = domain.ErrWeirdData
+		if !domain.CheckUserAlive(user, domain.User.IsAdmin, domain.User.IsSuperAdmin) {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		order, err = u.orderRepo.GetById(gc, in.OrderId)
+		if err == nil && (order == nil || order.IsDone()) {
+			err = domain.ErrItemNotFound
 		}
 		return
 	})
-
 	err = g.Wait()
 	if err != nil {
 		return
 	}
 
-	order.DueDate = &in.DueDate
-	order.Assignee = &in.Assignee
-	if sExists == nil {
-		order.State = in.OrderState
-	} else {
-		order.State = sExists.Id
+	if !order.IsVersion(in.Version) {
+		err = domain.ErrVersionConflict
+		return
 	}
 
+	dueDays := config.Priority.DueDays[string(in.Priority)]
+	orderType, err := u.orderTypeRepo.GetById(c, order.TypeId)
+	if err != nil {
+		return
+	}
+
+	if orderType != nil {
+		dueDays = orderType.DueDays(dueDays)
+	}
+
+	order.ChangePriority(in.Priority, dueDays)
 	return u.orderRepo.Save(c, order)
 }
 
-
-func (u *ucase) OrderAssignSelf(ctx context.Context, in domain.OrderAssignSelf) (err error) {
+func (u *ucase) OrderAssignSelf(ctx context.Context, in domain.OrderAssignSelf) (warnings []domain.OrderAssignWarning, err error) {
 	c, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
@@ -321,6 +555,11 @@ func (u *ucase) OrderAssignSelf(ctx context.Context, in domain.OrderAssignSelf)
 			return
 		}
 
+		if order == nil {
+			err = domain.ErrItemNotFound
+			return
+		}
+
 		if order.Assignee != nil {
 			err = domain.ErrItemAlreadyExist
 			return
@@ -343,19 +582,224 @@ func (u *ucase) OrderAssignSelf(ctx context.Context, in domain.OrderAssignSelf)
 
 		return
 	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	state, err = u.getTakeState(c, order)
+	if err != nil {
+		return
+	}
+
+	warnings, err = u.checkEditorAvailable(c, in.Assignee, order)
+	if err != nil {
+		return
+	}
+
+	if len(warnings) > 0 && !in.Force {
+		err = domain.ErrEditorUnavailable
+		return
+	}
+
+	order.ChangeState(state.Id)
+	err = u.orderRepo.Save(c, order)
+	return
+}
+
+// getTakeState 의뢰 종류별 작업 흐름의 시작 상태
+func (u *ucase) getTakeState(ctx context.Context, order *domain.Order) (state *domain.OrderState, err error) {
+	var (
+		orderType *domain.OrderType
+		take      *domain.OrderState
+	)
+	g, gc := errgroup.WithContext(ctx)
 	g.Go(func() (err error) {
-		state, _ = u.orderStateRepo.GetByCode(gc, domain.OrderStateCodeTake)
-		if state == nil {
-			err = errors.New("orderStateRepo.GetByCode domain.OrderStateCodeTake not exists state")
-		}
+		orderType, err = u.orderTypeRepo.GetById(gc, order.TypeId)
 		return
 	})
+	g.Go(func() error {
+		take, _ = u.orderStateRepo.GetByCode(gc, domain.OrderStateCodeTake)
+		if take == nil {
+			return errors.New("orderStateRepo.GetByCode domain.OrderStateCodeTake not exists state")
+		}
+		return nil
+	})
 	err = g.Wait()
 	if err != nil {
 		return
 	}
 
-	order.State = state.Id
-	err = u.orderRepo.Save(c, order)
+	stateId := order.TakeStateId(orderType, take.Id)
+	if stateId == take.Id {
+		return take, nil
+	}
+
+	state, err = u.orderStateRepo.GetById(ctx, stateId)
+	if err == nil && state == nil {
+		err = errors.New("orderStateRepo.GetById order type root state not exists state")
+	}
 	return
-}
\ No newline at end of file
+}
+
+// checkEditorAvailable 최대 동시 의뢰 수를 넘었거나, 오늘부터 마감일 사이에 쉬는 날이 있거나 근무 시간이 없으면 경고,
+// 마감일이 없으면 오늘 남은 근무 시간만 봄
+func (u *ucase) checkEditorAvailable(ctx context.Context, editorId uuid.UUID, order *domain.Order) (warnings []domain.OrderAssignWarning, err error) {
+	var (
+		manager    *domain.Manager
+		processing int64
+		dayOffs    []domain.ManagerDayOff
+		hours      []domain.ManagerWorkingHour
+	)
+	now := time.Now()
+	until := now
+	if order.DueDate != nil && order.DueDate.After(now) {
+		until = *order.DueDate
+	}
+
+	g, gc := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		manager, err = u.managerRepo.GetById(gc, editorId)
+		return
+	})
+	g.Go(func() (err error) {
+		processing, err = u.orderRepo.CountProcessingByAssignee(gc, editorId)
+		return
+	})
+	g.Go(func() (err error) {
+		dayOffs, err = u.managerRepo.FetchDayOff(gc, domain.FetchManagerDayOffOption{
+			ManagerIds: []uuid.UUID{editorId},
+			From:       now,
+			To:         until,
+		})
+		return
+	})
+	g.Go(func() (err error) {
+		hours, err = u.managerRepo.FetchWorkingHoursByManagerIds(gc, []uuid.UUID{editorId})
+		return
+	})
+	err = g.Wait()
+	if err != nil || manager == nil {
+		return
+	}
+
+	loc := config.Assignment.Location()
+	workUntil := until
+	y, m, d := now.In(loc).Date()
+	if endOfToday := time.Date(y, m, d+1, 0, 0, 0, 0, loc); workUntil.Before(endOfToday) {
+		workUntil = endOfToday
+	}
+
+	if processing >= int64(manager.ConcurrentOrderLimit(config.Assignment.DefaultMaxConcurrentOrder)) {
+		warnings = append(warnings, domain.OrderAssignWarningOverCapacity)
+	}
+
+	if manager.Away || len(dayOffs) > 0 || !domain.HasWorkingTime(hours, now, workUntil, loc) {
+		warnings = append(warnings, domain.OrderAssignWarningAway)
+	}
+	return
+}
+
+// OrderUploadCompleted 원본 영상 업로드 완료 시 호출,
+// 편집자가 영상 검토 중(TAKE, THUMBNAIL_TAKE)이면 다음 작업 상태로 넘김
+func (u *ucase) OrderUploadCompleted(ctx context.Context, in domain.OrderUploadCompleted) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	order, err := u.orderRepo.GetById(c, in.OrderId)
+	if err != nil {
+		return
+	}
+
+	if order == nil || order.IsDone() {
+		err = domain.ErrItemNotFound
+		return
+	}
+
+	order.FootageUploadedAt = pointer.Time(time.Now())
+
+	state, err := u.orderStateRepo.GetById(c, order.State)
+	if err != nil {
+		return
+	}
+
+	if state != nil && state.IsTake() {
+		var children []domain.OrderState
+		children, err = u.orderStateRepo.FetchByParentId(c, state.Id)
+		if err != nil {
+			return
+		}
+
+		if len(children) > 0 {
+			order.ChangeState(children[0].Id)
+		}
+	}
+
+	return u.orderRepo.Save(c, order)
+}
+
+// ResolveOrderFeedback 담당 편집자(또는 최고 관리자)가 수정 요청 항목을 해결 처리
+func (u *ucase) ResolveOrderFeedback(ctx context.Context, in domain.ResolveOrderFeedback) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var (
+		user     *domain.User
+		order    *domain.Order
+		feedback *domain.OrderFeedback
+	)
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		user, err = u.userRepo.GetById(gc, in.UserId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(user,
+			domain.User.IsAdmin,
+			domain.User.IsSuperAdmin) {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		order, err = u.orderRepo.GetById(gc, in.OrderId)
+		if err != nil {
+			return
+		}
+
+		if order == nil {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		feedback, err = u.orderFeedbackRepo.GetById(gc, in.FeedbackId)
+		if err != nil {
+			return
+		}
+
+		if feedback == nil || feedback.OrderId != in.OrderId {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	if !user.IsSuperAdmin() && (order.Assignee == nil || *order.Assignee != in.UserId) {
+		err = domain.ErrNoPermission
+		return
+	}
+
+	if feedback.IsResolved() {
+		err = domain.ErrItemAlreadyExist
+		return
+	}
+
+	feedback.Resolve(in.UserId)
+	return u.orderFeedbackRepo.Save(c, feedback)
+}
+
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+// boardFetchLimit 칸마다 개수, 목록 두 번씩 조회하므로 한 번에 조회하는 칸 수를 제한
+const boardFetchLimit = 4
+
+func (u *ucase) FetchBoard(ctx context.Context, option domain.FetchOrderOption) (res []domain.OrderBoardColumn, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	states, err := u.orderStateRepo.FetchFull(c)
+	if err != nil {
+		return
+	}
+
+	columns := domain.SortOrderBoardColumns(states)
+	if option.StateId != nil {
+		res = make([]domain.OrderBoardColumn, 0, 1)
+		for i := range columns {
+			if columns[i].StateId == *option.StateId {
+				res = append(res, columns[i])
+			}
+		}
+
+		if len(res) == 0 {
+			err = domain.ErrItemNotFound
+			return
+		}
+	} else {
+		res = columns
+	}
+
+	// 칸마다 상태로만 거르므로 끝난 의뢰도 완료, 취소 칸에 보임
+	option.OrderState = domain.OrderGeneralStateAll
+	g, gc := errgroup.WithContext(c)
+	g.SetLimit(boardFetchLimit)
+	for i := range res {
+		column := &res[i]
+		columnOption := option
+		columnOption.StateId = &column.StateId
+		g.Go(func() (err error) {
+			column.Cards, column.Page, err = u.Fetch(gc, columnOption)
+			return
+		})
+	}
+	err = g.Wait()
+	if err != nil {
+		res = nil
+		return
+	}
+
+	// 꺼진 상태는 남은 의뢰가 있을 때만 보여줌
+	visible := res[:0]
+	for i := range res {
+		if res[i].Active || res[i].Page.Total > 0 {
+			visible = append(visible, res[i])
+		}
+	}
+	res = visible
+	return
+}
+
+func (u *ucase) MoveOrderCard(ctx context.Context, in domain.MoveOrderCard) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var order *domain.Order
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		user, err := u.userRepo.GetById(gc, in.UserId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(user, domain.User.IsAdmin, domain.User.IsSuperAdmin) {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		order, err = u.orderRepo.GetById(gc, in.OrderId)
+		if err == nil && order == nil {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	if !order.IsVersion(in.Version) {
+		err = domain.ErrVersionConflict
+		return
+	}
+
+	// 요청 칸의 카드는 편집자를 배정해야 옮겨짐
+	if order.Assignee == nil && !order.IsDone() {
+		err = domain.ErrOrderNotAssigned
+		return
+	}
+
+	state, err := u.getNextState(c, order, in.OrderState)
+	if err != nil {
+		return
+	}
+
+	if state.Id == order.State {
+		return
+	}
+
+	order.ChangeState(state.Id)
+	return u.orderRepo.Save(c, order)
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+func (u *ucase) BulkUpdateOrder(ctx context.Context, in domain.BulkUpdateOrder) (res []domain.BulkOrderResult, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var state *domain.OrderState
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		user, err := u.userRepo.GetById(gc, in.UserId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(user, domain.User.IsAdmin, domain.User.IsSuperAdmin) {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		state, err = u.getBulkTarget(gc, in)
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	// 같은 의뢰가 여러 번 들어오면 한 번만 처리, 편집자 동시 의뢰 수가 앞의 배정 결과를 반영하도록 차례로 처리
+	seen := make(map[uuid.UUID]bool, len(in.OrderIds))
+	res = make([]domain.BulkOrderResult, 0, len(in.OrderIds))
+	for _, orderId := range in.OrderIds {
+		if seen[orderId] {
+			continue
+		}
+		seen[orderId] = true
+
+		result := domain.BulkOrderResult{OrderId: orderId}
+		result.Warnings, result.Err = u.bulkUpdateOrder(c, in, orderId, state)
+		res = append(res, result)
+	}
+	return
+}
+
+// getBulkTarget 모든 의뢰에 같이 쓰이는 배정 편집자, 상태를 미리 확인, 취소 상태만 그대로 모든 의뢰에 씀
+func (u *ucase) getBulkTarget(ctx context.Context, in domain.BulkUpdateOrder) (state *domain.OrderState, err error) {
+	switch in.Action {
+	case domain.BulkOrderActionAssign:
+		var assignee *domain.User
+		assignee, err = u.userRepo.GetById(ctx, in.Assignee)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(assignee, domain.User.IsAdmin, domain.User.IsSuperAdmin) {
+			err = domain.ErrWeirdData
+		}
+	case domain.BulkOrderActionDueDate:
+		if in.DueDate.IsZero() {
+			err = domain.ErrWeirdData
+		}
+	case domain.BulkOrderActionState:
+		// 실제로 옮길 상태는 의뢰마다 getNextState 로 정하고, 여기서는 어느 의뢰에도 쓸 수 없는 상태만 미리 거름
+		var target *domain.OrderState
+		target, err = u.orderStateRepo.GetById(ctx, in.OrderState)
+		if err != nil {
+			return
+		}
+
+		// 완료는 결과물 승인이 필요하고 취소는 취소 기능으로만 가능, 꺼진 상태로는 옮길 수 없음
+		if target == nil || !target.Active || target.Code == domain.OrderStateCodeDone || target.Code == domain.OrderStateCodeCanceled {
+			err = domain.ErrWeirdData
+		}
+	case domain.BulkOrderActionCancel:
+		state, err = u.orderStateRepo.GetByCode(ctx, domain.OrderStateCodeCanceled)
+		if err == nil && state == nil {
+			err = errors.New("orderStateRepo.GetByCode domain.OrderStateCodeCanceled not exists state")
+		}
+	default:
+		err = domain.ErrWeirdData
+	}
+	return
+}
+
+// bulkUpdateOrder 의뢰 하나를 바꾸고 저장, 저장은 의뢰마다 하나의 트랜잭션
+func (u *ucase) bulkUpdateOrder(ctx context.Context, in domain.BulkUpdateOrder, orderId uuid.UUID, state *domain.OrderState) (warnings []domain.OrderAssignWarning, err error) {
+	order, err := u.orderRepo.GetById(ctx, orderId)
+	if err != nil {
+		return
+	}
+
+	if order == nil {
+		err = domain.ErrItemNotFound
+		return
+	}
+
+	if order.IsDone() {
+		err = domain.ErrOrderClosed
+		return
+	}
+
+	switch in.Action {
+	case domain.BulkOrderActionAssign:
+		if order.Assignee != nil && *order.Assignee == in.Assignee {
+			return
+		}
+
+		warnings, err = u.checkEditorAvailable(ctx, in.Assignee, order)
+		if err != nil {
+			return
+		}
+
+		if len(warnings) > 0 && !in.Force {
+			err = domain.ErrEditorUnavailable
+			return
+		}
+
+		if order.Assignee == nil {
+			var take *domain.OrderState
+			take, err = u.getTakeState(ctx, order)
+			if err != nil {
+				return
+			}
+			order.ChangeState(take.Id)
+		}
+
+		assignee := in.Assignee
+		order.Assignee = &assignee
+	case domain.BulkOrderActionDueDate:
+		dueDate := domain.TruncateDate(in.DueDate)
+		order.DueDate = &dueDate
+	case domain.BulkOrderActionState:
+		// 배정되지 않은 의뢰는 종류별 시작 상태로 가는 등 의뢰 정보 수정과 같은 규칙을 따름
+		var next *domain.OrderState
+		next, err = u.getNextState(ctx, order, in.OrderState)
+		if err != nil {
+			return
+		}
+		order.ChangeState(next.Id)
+	case domain.BulkOrderActionCancel:
+		order.Cancel()
+		order.ChangeState(state.Id)
+		err = u.cancelOrder(ctx, order)
+		return
+	}
+
+	err = u.orderRepo.Save(ctx, order)
+	return
+}
+
+// cancelOrder 의뢰 요청 때 차감한 의뢰 횟수를 취소와 같은 트랜잭션에서 티켓에 돌려줌
+func (u *ucase) cancelOrder(ctx context.Context, order *domain.Order) error {
+	return u.orderTicketRepo.Transaction(ctx, func(otr domain.OrderTicketTxRepository) (err error) {
+		if order.TicketId != nil && order.UsedOrderCount > 0 {
+			var ticket *domain.OrderTicket
+			ticket, err = otr.LockById(ctx, *order.TicketId)
+			if err != nil {
+				return
+			}
+
+			// 티켓이 지워졌으면 돌려줄 곳이 없음
+			if ticket != nil {
+				ticket.RefundOrder(order.UsedOrderCount)
+				err = otr.Save(ctx, ticket)
+				if err != nil {
+					return
+				}
+			}
+		}
+
+		return u.orderRepo.With(otr).Save(ctx, order)
+	})
+}
@@ -0,0 +1,170 @@
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	customerRepository "github.com/stockfolioofficial/back-editfolio/customer/repository"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	managerRepository "github.com/stockfolioofficial/back-editfolio/manager/repository"
+	notificationAdapter "github.com/stockfolioofficial/back-editfolio/notification/adapter"
+	orderRepository "github.com/stockfolioofficial/back-editfolio/order/repository"
+	"github.com/stockfolioofficial/back-editfolio/order/usecase"
+	orderDeliveryRepository "github.com/stockfolioofficial/back-editfolio/orderDelivery/repository"
+	orderDeliveryUseCase "github.com/stockfolioofficial/back-editfolio/orderDelivery/usecase"
+	orderFeedbackRepository "github.com/stockfolioofficial/back-editfolio/orderFeedback/repository"
+	orderMessageRepository "github.com/stockfolioofficial/back-editfolio/orderMessage/repository"
+	orderStateRepository "github.com/stockfolioofficial/back-editfolio/orderState/repository"
+	orderTicketRepository "github.com/stockfolioofficial/back-editfolio/orderTicket/repository"
+	orderTypeRepository "github.com/stockfolioofficial/back-editfolio/orderType/repository"
+	userRepository "github.com/stockfolioofficial/back-editfolio/user/repository"
+)
+
+// TestRequestEditOrder_PendingDelivery 결과물 검토 중에 수정 요청을 해도 다음 결과물을 전달할 수 있어야 함
+func TestRequestEditOrder_PendingDelivery(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+
+	userRepo := userRepository.NewUserRepository(db)
+	orderRepo := orderRepository.NewOrderRepository(db)
+	orderStateRepo := orderStateRepository.NewOrderStateRepository(db)
+	orderDeliveryRepo := orderDeliveryRepository.NewOrderDeliveryRepository(db)
+	orderFeedbackRepo := orderFeedbackRepository.NewOrderFeedbackRepository(db)
+	u := usecase.NewOrderUseCase(
+		orderRepo,
+		userRepo,
+		managerRepository.NewManagerRepository(db),
+		customerRepository.NewCustomerRepository(db),
+		orderStateRepo,
+		orderTicketRepository.NewOrderTicketRepository(db),
+		orderDeliveryRepo,
+		orderMessageRepository.NewOrderMessageRepository(db),
+		orderFeedbackRepo,
+		orderTypeRepository.NewOrderTypeRepository(db),
+		noAssignment{},
+		30*time.Second,
+	)
+	du := orderDeliveryUseCase.NewOrderDeliveryUseCase(
+		orderDeliveryRepo,
+		orderRepo,
+		orderStateRepo,
+		orderFeedbackRepo,
+		userRepo,
+		notificationAdapter.NewLogNotificationAdapter(),
+		30*time.Second,
+	)
+
+	take, err := orderStateRepo.GetByCode(ctx, domain.OrderStateCodeTake)
+	if err != nil || take == nil {
+		t.Fatalf("take state not seeded, err = %v", err)
+	}
+
+	customer := domain.CreateUser(domain.UserCreateOption{
+		Role:     domain.CustomerUserRole,
+		Username: uuid.NewString() + "@test.editfolio",
+	})
+	editor := domain.CreateUser(domain.UserCreateOption{
+		Role:     domain.AdminUserRole,
+		Username: uuid.NewString() + "@test.editfolio",
+	})
+	order := domain.CreateOrder(domain.CreateOrderOption{
+		Orderer:   customer.Id,
+		EditCount: 2,
+		State:     take.Id,
+	})
+	order.Assignee = &editor.Id
+	delivery := domain.CreateOrderDelivery(domain.CreateOrderDeliveryOption{
+		OrderId:   order.Id,
+		Version:   1,
+		Files:     []domain.OrderDeliveryFile{{Name: "v1.mp4", Link: "https://example.com/v1.mp4"}},
+		CreatedBy: editor.Id,
+	})
+	for _, entity := range []interface{}{&customer, &editor, &order, &delivery} {
+		if err := db.Create(entity).Error; err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Cleanup(func() {
+		db.Where("`order_id` = ?", order.Id).Delete(&domain.OrderFeedback{})
+		db.Where("`order_id` = ?", order.Id).Delete(&domain.OrderDelivery{})
+		db.Delete(&order)
+		db.Delete(&editor)
+		db.Delete(&customer)
+	})
+
+	err = u.RequestEditOrder(ctx, domain.RequestEditOrder{
+		UserId:  customer.Id,
+		OrderId: order.Id,
+		Feedbacks: []domain.OrderFeedbackItem{{
+			Category:    domain.OrderFeedbackCategoryCut,
+			Description: "앞부분을 잘라주세요",
+		}},
+	})
+	if err != nil {
+		t.Fatalf("RequestEditOrder error = %v", err)
+	}
+
+	stored, err := orderDeliveryRepo.GetByOrderIdAndVersion(ctx, order.Id, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored == nil || stored.State != domain.OrderDeliveryStateRejected {
+		t.Fatalf("delivery v1 = %+v, want rejected", stored)
+	}
+
+	// 같은 버전을 동시에 전달하면 하나만 저장되고 나머지는 충돌
+	const calls = 4
+	var (
+		wg       sync.WaitGroup
+		start    = make(chan struct{})
+		versions = make([]uint8, calls)
+		errs     = make([]error, calls)
+	)
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			versions[i], errs[i] = du.DeliverOrder(ctx, domain.DeliverOrder{
+				UserId:  editor.Id,
+				OrderId: order.Id,
+				Files:   []domain.OrderDeliveryFileInfo{{Name: "v2.mp4", Link: "https://example.com/v2.mp4"}},
+			})
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	delivered := 0
+	for i := range errs {
+		switch errs[i] {
+		case nil:
+			delivered++
+			if versions[i] != 2 {
+				t.Errorf("call %d version = %d, want 2", i, versions[i])
+			}
+		case domain.ErrItemAlreadyExist:
+		default:
+			t.Errorf("call %d error = %v, want conflict error", i, errs[i])
+		}
+	}
+	if delivered != 1 {
+		t.Fatalf("delivered = %d, want 1", delivered)
+	}
+
+	editDone, err := orderStateRepo.GetByCode(ctx, domain.OrderStateCodeEditDone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := orderRepo.GetById(ctx, order.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if editDone != nil && got.State != editDone.Id {
+		t.Fatalf("order state = %d, want edit done %d", got.State, editDone.Id)
+	}
+}
@@ -2,8 +2,14 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
 	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/highlight"
 	"github.com/stockfolioofficial/back-editfolio/util/pointer"
 	"github.com/stockfolioofficial/back-editfolio/util/safe"
 	"golang.org/x/sync/errgroup"
@@ -13,26 +19,226 @@ func (u *ucase) GetRecentProcessingOrder(ctx context.Context, userId uuid.UUID)
 	c, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
-	order, err := u.orderRepo.GetRecentByOrdererId(c, userId)
+	order, err := domain.GetCustomerOrder(c, u.orderRepo, userId, uuid.Nil)
 	if err != nil {
 		return
 	}
 
-	if order == nil || order.IsDone() {
-		err = domain.ErrItemNotFound
+	return u.toRecentOrderInfo(c, order)
+}
+
+func (u *ucase) GetMyOrder(ctx context.Context, userId, orderId uuid.UUID) (res domain.MyOrderDetailInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	order, err := domain.GetCustomerOrder(c, u.orderRepo, userId, orderId)
+	if err != nil {
+		return
+	}
+
+	res.UsedEditCount = order.EditCount
+	res.TotalEditCount = order.TotalEditCount
+
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		res.RecentOrderInfo, err = u.toRecentOrderInfo(gc, order)
+		return
+	})
+	g.Go(func() (err error) {
+		list, err := u.orderDeliveryRepo.FetchByOrderId(gc, order.Id)
+		if err != nil {
+			return
+		}
+
+		res.Deliveries = make([]domain.OrderDeliveryInfo, len(list))
+		for i := range list {
+			res.Deliveries[i] = list[i].Info()
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		res.StateTimeline, err = u.getStateTimeline(gc, order)
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		res = domain.MyOrderDetailInfo{}
+	}
+
+	return
+}
+
+// getStateTimeline 기록이 없는 기존 의뢰는 현재 상태만 보여줌
+func (u *ucase) getStateTimeline(ctx context.Context, order *domain.Order) (res []domain.OrderStateTimelineInfo, err error) {
+	histories, err := u.orderRepo.FetchStateHistoryByOrderId(ctx, order.Id)
+	if err != nil {
 		return
 	}
 
+	if len(histories) == 0 {
+		histories = []domain.OrderStateHistory{{
+			OrderId:   order.Id,
+			StateId:   order.State,
+			ChangedAt: order.StateSince(),
+			Actor:     domain.OrderStateActorUser,
+		}}
+	}
+
+	stateIds := make([]uint8, len(histories))
+	for i := range histories {
+		stateIds[i] = histories[i].StateId
+	}
+
+	states, err := u.orderStateRepo.FetchByIds(ctx, stateIds)
+	if err != nil {
+		return
+	}
+
+	stateMap := make(map[uint8]*domain.OrderState, len(states))
+	for i := range states {
+		stateMap[states[i].Id] = &states[i]
+	}
+
+	res = make([]domain.OrderStateTimelineInfo, len(histories))
+	for i := range histories {
+		src := histories[i]
+		res[i] = domain.OrderStateTimelineInfo{
+			StateId:   src.StateId,
+			Content:   i18n.T(ctx, i18n.UnknownOrderState),
+			ChangedAt: src.ChangedAt,
+			Actor:     src.Actor,
+		}
+
+		if state, ok := stateMap[src.StateId]; ok {
+			res[i].Content = state.LongContent
+			res[i].Emoji = state.Emoji
+		}
+	}
+	return
+}
+
+func (u *ucase) FetchMyOrder(ctx context.Context, option domain.FetchMyOrderOption) (res []domain.RecentOrderInfo, page domain.Page, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	userId := option.UserId
+	list, page, err := u.orderRepo.FetchPage(c, domain.FetchOrderOption{
+		OrderState:  option.OrderState,
+		Orderer:     &userId,
+		OrderedFrom: option.OrderedFrom,
+		OrderedTo:   option.OrderedTo,
+		StateId:     option.StateId,
+		Sort:        domain.OrderSortOrderedAt,
+		Desc:        true,
+		Page:        option.Page,
+	})
+	if err != nil {
+		return
+	}
+
+	res = make([]domain.RecentOrderInfo, len(list))
+
+	orderIds := make([]uuid.UUID, len(list))
+	statesIds := make([]uint8, 0, len(list))
+	managerIds := make([]uuid.UUID, 0, len(list))
+
+	stateDst := make(map[uint8][]*domain.RecentOrderInfo)
+	managerDst := make(map[uuid.UUID][]*domain.RecentOrderInfo)
+	for i := range list {
+		src := list[i]
+		res[i] = domain.RecentOrderInfo{
+			OrderId:            src.Id,
+			OrderedAt:          src.OrderedAt,
+			DueDate:            src.DueDate,
+			OrderState:         src.State,
+			OrderStateContent:  i18n.T(ctx, i18n.UnknownOrderState),
+			RemainingEditCount: src.RemainingEditCount(),
+			Priority:           src.Priority,
+			TypeId:             src.TypeId,
+			Version:            src.Version,
+			DoneAt:             src.DoneAt,
+		}
+
+		dst := &res[i]
+		orderIds[i] = src.Id
+
+		stateDst[src.State] = append(stateDst[src.State], dst)
+		statesIds = append(statesIds, src.State)
+
+		if src.Assignee != nil {
+			dst.AssigneeNickname = pointer.String(i18n.T(ctx, i18n.UnknownEditor))
+			managerDst[*src.Assignee] = append(managerDst[*src.Assignee], dst)
+			managerIds = append(managerIds, *src.Assignee)
+		}
+	}
+
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		mList, err := u.managerRepo.FetchByIds(gc, managerIds)
+		if err != nil {
+			return err
+		}
+
+		for i := range mList {
+			src := mList[i]
+			for _, dst := range managerDst[src.Id] {
+				dst.AssigneeNickname = &src.Nickname
+			}
+		}
+
+		return nil
+	})
+	g.Go(func() error {
+		sList, err := u.orderStateRepo.FetchByIds(gc, statesIds)
+		if err != nil {
+			return err
+		}
+
+		for i := range sList {
+			src := sList[i]
+			for _, dst := range stateDst[src.Id] {
+				dst.OrderStateContent = src.LongContent
+				dst.OrderStateEmoji = src.Emoji
+			}
+		}
+
+		return nil
+	})
+	g.Go(func() error {
+		unread, err := u.orderMessageRepo.CountUnreadByOrderIds(gc, orderIds, userId)
+		if err != nil {
+			return err
+		}
+
+		for i := range res {
+			res[i].UnreadMessageCount = unread[res[i].OrderId]
+		}
+
+		return nil
+	})
+	err = g.Wait()
+	if err != nil {
+		res = []domain.RecentOrderInfo{}
+	}
+
+	return
+}
+
+func (u *ucase) toRecentOrderInfo(ctx context.Context, order *domain.Order) (res domain.RecentOrderInfo, err error) {
 	res = domain.RecentOrderInfo{
 		OrderId:            order.Id,
 		OrderedAt:          order.OrderedAt,
 		DueDate:            order.DueDate,
 		OrderState:         order.State,
-		OrderStateContent:  "알 수 없는 상태", // todo string resource
+		OrderStateContent:  i18n.T(ctx, i18n.UnknownOrderState),
 		RemainingEditCount: order.RemainingEditCount(),
+		Priority:           order.Priority,
+		TypeId:             order.TypeId,
+		Version:            order.Version,
+		DoneAt:             order.DoneAt,
 	}
 
-	g, gc := errgroup.WithContext(c)
+	g, gc := errgroup.WithContext(ctx)
 	g.Go(func() (err error) {
 		if order.Assignee == nil {
 			return
@@ -46,7 +252,7 @@ func (u *ucase) GetRecentProcessingOrder(ctx context.Context, userId uuid.UUID)
 		if assignee != nil {
 			res.AssigneeNickname = &assignee.Nickname
 		} else {
-			res.AssigneeNickname = pointer.String("알 수 없는 편집자") // todo string resource
+			res.AssigneeNickname = pointer.String(i18n.T(ctx, i18n.UnknownEditor))
 		}
 		return
 	})
@@ -63,6 +269,15 @@ func (u *ucase) GetRecentProcessingOrder(ctx context.Context, userId uuid.UUID)
 		}
 		return
 	})
+	g.Go(func() (err error) {
+		unread, err := u.orderMessageRepo.CountUnreadByOrderIds(gc, []uuid.UUID{order.Id}, order.Orderer)
+		if err != nil {
+			return
+		}
+
+		res.UnreadMessageCount = unread[order.Id]
+		return
+	})
 	err = g.Wait()
 	if err != nil {
 		res = domain.RecentOrderInfo{}
@@ -93,9 +308,12 @@ func (u *ucase) GetOrderDetailInfo(ctx context.Context, orderId uuid.UUID) (res
 		DueDate:            order.DueDate,
 		AssigneeInfo:       nil,
 		OrderState:         order.State,
-		OrderStateContent:  "알 수 없는 상태", // todo string resource
+		OrderStateContent:  i18n.T(ctx, i18n.UnknownOrderState),
 		RemainingEditCount: order.RemainingEditCount(),
 		Requirement:        safe.StringOrZero(order.Requirement),
+		Priority:           order.Priority,
+		TypeId:             order.TypeId,
+		Version:            order.Version,
 	}
 
 	g, gc := errgroup.WithContext(c)
@@ -117,9 +335,9 @@ func (u *ucase) GetOrderDetailInfo(ctx context.Context, orderId uuid.UUID) (res
 			}
 		} else {
 			res.AssigneeInfo = &domain.OrderAssigneeInfo{
-				Id:       assignee.Id,
-				Name:     "알 수 없는 편집자", // todo string resource
-				Nickname: "알 수 없는 편집자", // todo string resource
+				Id:       *order.Assignee,
+				Name:     i18n.T(ctx, i18n.UnknownEditor),
+				Nickname: i18n.T(ctx, i18n.UnknownEditor),
 			}
 		}
 		return
@@ -135,6 +353,41 @@ func (u *ucase) GetOrderDetailInfo(ctx context.Context, orderId uuid.UUID) (res
 		}
 		return
 	})
+	g.Go(func() (err error) {
+		feedbacks, err := u.orderFeedbackRepo.FetchByOrderId(gc, order.Id)
+		if err != nil {
+			return
+		}
+
+		res.Feedbacks = make([]domain.OrderFeedbackInfo, len(feedbacks))
+		for i := range feedbacks {
+			src := feedbacks[i]
+			res.Feedbacks[i] = domain.OrderFeedbackInfo{
+				FeedbackId:  src.Id,
+				Revision:    src.Revision,
+				StartMs:     src.StartMs,
+				EndMs:       src.EndMs,
+				Category:    src.Category,
+				Description: src.Description,
+				CreatedAt:   src.CreatedAt,
+				ResolvedAt:  src.ResolvedAt,
+				ResolvedBy:  src.ResolvedBy,
+			}
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		references, err := u.orderRepo.FetchReferenceByOrderId(gc, order.Id)
+		if err != nil {
+			return
+		}
+
+		res.References = make([]domain.OrderReferenceInfo, len(references))
+		for i := range references {
+			res.References[i] = references[i].Info()
+		}
+		return
+	})
 	err = g.Wait()
 	if err != nil {
 		return
@@ -144,13 +397,22 @@ func (u *ucase) GetOrderDetailInfo(ctx context.Context, orderId uuid.UUID) (res
 }
 
 
-func (u *ucase) Fetch(ctx context.Context, option domain.FetchOrderOption) (res []domain.OrderInfo, err error) {
+func (u *ucase) Fetch(ctx context.Context, option domain.FetchOrderOption) (res []domain.OrderInfo, page domain.Page, err error) {
 	c, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
-	list, err := u.orderRepo.Fetch(c, option)
+	now := time.Now()
+	atRiskWindow := time.Duration(config.SLA.AtRiskHours) * time.Hour
+	option.AtRiskWindow = atRiskWindow
+	list, page, err := u.orderRepo.FetchPage(c, option)
+	if err != nil {
+		return
+	}
+
 	res = make([]domain.OrderInfo, len(list))
 
+	orderIds := make([]uuid.UUID, len(list))
+
 	bufSize := int(float64(len(list)) * 0.7)
 
 	statesIds := make([]uint8, 0, bufSize)
@@ -163,12 +425,19 @@ func (u *ucase) Fetch(ctx context.Context, option domain.FetchOrderOption) (res
 	for i := range list {
 		src := list[i]
 		res[i] = domain.OrderInfo{
-			OrderId:   src.Id,
-			OrderedAt: src.OrderedAt,
-			DoneAt:    src.DoneAt,
+			OrderId:       src.Id,
+			OrderedAt:     src.OrderedAt,
+			DoneAt:        src.DoneAt,
+			Priority:      src.Priority,
+			TypeId:        src.TypeId,
+			DueDate:       src.DueDate,
+			RemainingTime: src.RemainingTime(now),
+			Overdue:       src.IsOverdue(now),
+			AtRisk:        src.IsAtRisk(now, atRiskWindow),
 		}
 
 		dst := &res[i]
+		orderIds[i] = src.Id
 
 		stateDst[src.State] = append(stateDst[src.State], dst)
 		statesIds = append(statesIds, src.State)
@@ -206,6 +475,7 @@ func (u *ucase) Fetch(ctx context.Context, option domain.FetchOrderOption) (res
 		return nil
 	})
 
+	emails := make(map[uuid.UUID]string)
 	g.Go(func() error {
 		cList, err := u.customerRepo.FetchByIds(gc, customerIds)
 		if err != nil {
@@ -214,6 +484,7 @@ func (u *ucase) Fetch(ctx context.Context, option domain.FetchOrderOption) (res
 
 		for i := range cList {
 			src := cList[i]
+			emails[src.Id] = src.Email
 			dsts := customerDst[src.Id]
 			if len(dsts) == 0 {
 				continue
@@ -251,11 +522,81 @@ func (u *ucase) Fetch(ctx context.Context, option domain.FetchOrderOption) (res
 
 		return nil
 	})
+	g.Go(func() error {
+		if option.Viewer == nil {
+			return nil
+		}
+
+		unread, err := u.orderMessageRepo.CountUnreadByOrderIds(gc, orderIds, *option.Viewer)
+		if err != nil {
+			return err
+		}
+
+		for i := range res {
+			res[i].UnreadMessageCount = unread[res[i].OrderId]
+		}
+
+		return nil
+	})
 
 	err = g.Wait()
 	if err != nil {
 		res = []domain.OrderInfo{}
+		return
+	}
+
+	if query := strings.TrimSpace(option.Query); len(query) > 0 {
+		for i := range res {
+			res[i].Highlights = orderSearchHighlights(list[i], res[i], emails[list[i].Orderer], query)
+		}
+	}
+
+	return
+}
+
+// Export 내보내기는 오래 걸릴 수 있어 기본 제한 시간 대신 내보내기 제한 시간을 씀
+func (u *ucase) Export(ctx context.Context, option domain.FetchOrderOption, fn func(row domain.OrderExportRow) error) error {
+	c, cancel := context.WithTimeout(ctx, time.Duration(config.Export.Timeout)*time.Second)
+	defer cancel()
+
+	option.Lang = string(i18n.FromContext(ctx))
+	return u.orderRepo.Export(c, option, fn)
+}
+
+// highlightAround 검색어 앞뒤로 보여줄 글자 수
+const highlightAround = 20
+
+// orderSearchHighlights 검색어가 들어간 항목마다 일치한 부분을 표시한 일부분
+func orderSearchHighlights(order domain.Order, info domain.OrderInfo, email, query string) (list []domain.OrderSearchHighlight) {
+	fields := []struct {
+		field domain.OrderSearchField
+		text  string
+	}{
+		{domain.OrderSearchFieldOrderId, order.Id.String()},
+		{domain.OrderSearchFieldOrdererName, info.OrdererName},
+		{domain.OrderSearchFieldChannelName, info.OrdererChannelName},
+		{domain.OrderSearchFieldEmail, email},
+		{domain.OrderSearchFieldRequirement, safe.StringOrZero(order.Requirement)},
+		{domain.OrderSearchFieldAssigneeName, safe.StringOrZero(info.AssigneeName)},
+		{domain.OrderSearchFieldAssigneeNickname, safe.StringOrZero(info.AssigneeNickname)},
 	}
 
+	list = []domain.OrderSearchHighlight{}
+	for _, f := range fields {
+		fragment, ok := highlight.Fragment(f.text, query, highlightAround)
+		if !ok {
+			continue
+		}
+
+		// 의뢰 아이디는 앞자리 검색만 지원
+		if f.field == domain.OrderSearchFieldOrderId && !strings.HasPrefix(fragment, highlight.OpenTag) {
+			continue
+		}
+
+		list = append(list, domain.OrderSearchHighlight{
+			Field:    f.field,
+			Fragment: fragment,
+		})
+	}
 	return
-}
\ No newline at end of file
+}
@@ -0,0 +1,197 @@
+package usecase_test
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/stockfolioofficial/back-editfolio/core/seed"
+	customerRepository "github.com/stockfolioofficial/back-editfolio/customer/repository"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	managerRepository "github.com/stockfolioofficial/back-editfolio/manager/repository"
+	orderRepository "github.com/stockfolioofficial/back-editfolio/order/repository"
+	"github.com/stockfolioofficial/back-editfolio/order/usecase"
+	orderDeliveryRepository "github.com/stockfolioofficial/back-editfolio/orderDelivery/repository"
+	orderFeedbackRepository "github.com/stockfolioofficial/back-editfolio/orderFeedback/repository"
+	orderMessageRepository "github.com/stockfolioofficial/back-editfolio/orderMessage/repository"
+	orderStateRepository "github.com/stockfolioofficial/back-editfolio/orderState/repository"
+	orderTicketRepository "github.com/stockfolioofficial/back-editfolio/orderTicket/repository"
+	orderTypeRepository "github.com/stockfolioofficial/back-editfolio/orderType/repository"
+	userRepository "github.com/stockfolioofficial/back-editfolio/user/repository"
+	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+)
+
+// testMySQLDSNEnv 비어 있으면 건너뜀, 예) docker run -e MYSQL_ROOT_PASSWORD=1234 -e MYSQL_DATABASE=editfolio_test -p 3306:3306 mysql:8
+// EDITFOLIO_TEST_MYSQL_DSN='root:1234@tcp(localhost:3306)/editfolio_test?charset=utf8mb4&parseTime=true&loc=UTC'
+const testMySQLDSNEnv = "EDITFOLIO_TEST_MYSQL_DSN"
+
+// noAssignment 의뢰 요청 뒤 자동 배정은 이 테스트와 관계없음
+type noAssignment struct {
+	domain.OrderAssignmentUseCase
+}
+
+func (noAssignment) AssignRequestedOrder(context.Context, uuid.UUID) error {
+	return nil
+}
+
+func openTestDB(t *testing.T) *gorm.DB {
+	dsn := os.Getenv(testMySQLDSNEnv)
+	if dsn == "" {
+		t.Skipf("%s is not set", testMySQLDSNEnv)
+	}
+
+	db, err := gorm.Open(mysql.Open(dsn), &gorm.Config{
+		Logger:                                   logger.Default.LogMode(logger.Warn),
+		DisableForeignKeyConstraintWhenMigrating: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = seed.NewSeeder(db).Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestRequestOrder_ConcurrentSameTicket(t *testing.T) {
+	db := openTestDB(t)
+	ctx := context.Background()
+
+	orderRepo := orderRepository.NewOrderRepository(db)
+	orderTicketRepo := orderTicketRepository.NewOrderTicketRepository(db)
+	orderTypeRepo := orderTypeRepository.NewOrderTypeRepository(db)
+	u := usecase.NewOrderUseCase(
+		orderRepo,
+		userRepository.NewUserRepository(db),
+		managerRepository.NewManagerRepository(db),
+		customerRepository.NewCustomerRepository(db),
+		orderStateRepository.NewOrderStateRepository(db),
+		orderTicketRepo,
+		orderDeliveryRepository.NewOrderDeliveryRepository(db),
+		orderMessageRepository.NewOrderMessageRepository(db),
+		orderFeedbackRepository.NewOrderFeedbackRepository(db),
+		orderTypeRepo,
+		noAssignment{},
+		30*time.Second,
+	)
+
+	shorts, err := orderTypeRepo.GetByCode(ctx, domain.OrderTypeCodeShorts)
+	if err != nil || shorts == nil {
+		t.Fatalf("shorts order type not seeded, err = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		totalCount  uint8
+		concurrent  uint8
+		calls       int
+		wantCreated int
+	}{
+		{"concurrent slot limit", 10, 2, 8, 2},
+		{"order count limit", 3, 5, 8, 3},
+		{"enough for all", 4, 4, 4, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user := domain.CreateUser(domain.UserCreateOption{
+				Role:     domain.CustomerUserRole,
+				Username: uuid.NewString() + "@test.editfolio",
+			})
+			ticket := domain.CreateOrderTicket(domain.CreateOrderTicketOption{
+				ExOrderId:            uuid.NewString(),
+				OwnerId:              user.Id,
+				TotalOrderCount:      tt.totalCount,
+				ConcurrentOrderCount: tt.concurrent,
+				StartAt:              pointer.Time(time.Now().Add(-time.Hour)),
+				EndAt:                pointer.Time(time.Now().Add(time.Hour)),
+			})
+			if err := db.Create(&user).Error; err != nil {
+				t.Fatal(err)
+			}
+			if err := db.Create(&ticket).Error; err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() {
+				db.Where("`orderer` = ?", user.Id).Delete(&domain.Order{})
+				db.Delete(&ticket)
+				db.Delete(&user)
+			})
+
+			var (
+				wg    sync.WaitGroup
+				start = make(chan struct{})
+				ids   = make([]uuid.UUID, tt.calls)
+				errs  = make([]error, tt.calls)
+			)
+			for i := 0; i < tt.calls; i++ {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					<-start
+					ids[i], errs[i] = u.RequestOrder(ctx, domain.RequestOrder{
+						UserId:      user.Id,
+						TypeId:      shorts.Id,
+						Requirement: "concurrent request",
+					})
+				}(i)
+			}
+			close(start)
+			wg.Wait()
+
+			created := 0
+			for i := range errs {
+				switch errs[i] {
+				case nil:
+					created++
+					if ids[i] == uuid.Nil {
+						t.Errorf("call %d created order without id", i)
+					}
+				case domain.ErrItemAlreadyExist, domain.ErrNotEnoughOrderCount:
+				default:
+					t.Errorf("call %d error = %v, want conflict error", i, errs[i])
+				}
+			}
+			if created != tt.wantCreated {
+				t.Fatalf("created = %d, want %d", created, tt.wantCreated)
+			}
+
+			var orders []domain.Order
+			err := db.Where("`orderer` = ?", user.Id).Find(&orders).Error
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(orders) != created {
+				t.Fatalf("stored orders = %d, want %d", len(orders), created)
+			}
+
+			slots := make(map[uint8]bool)
+			for i := range orders {
+				if orders[i].ActiveSlot == nil || *orders[i].ActiveSlot > tt.concurrent {
+					t.Fatalf("order %s active slot = %v, want 1 ~ %d", orders[i].Id, orders[i].ActiveSlot, tt.concurrent)
+				}
+				if slots[*orders[i].ActiveSlot] {
+					t.Fatalf("active slot %d used twice", *orders[i].ActiveSlot)
+				}
+				slots[*orders[i].ActiveSlot] = true
+			}
+
+			var stored domain.OrderTicket
+			err = db.First(&stored, "`id` = ?", ticket.Id).Error
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := uint8(created) * shorts.Cost(); stored.OrderCount != want {
+				t.Fatalf("ticket order count = %d, want %d", stored.OrderCount, want)
+			}
+		})
+	}
+}
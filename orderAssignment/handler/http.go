@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+const (
+	tag = "[ORDER-ASSIGNMENT] "
+)
+
+func NewOrderAssignmentController(useCase domain.OrderAssignmentUseCase) *OrderAssignmentController {
+	return &OrderAssignmentController{useCase: useCase}
+}
+
+type OrderAssignmentController struct {
+	useCase domain.OrderAssignmentUseCase
+}
+
+func (c *OrderAssignmentController) Bind(e *echo.Echo) {
+	//ADMIN
+	e.GET("/order/:orderId/assignment", c.fetchOrderAssignmentLog,
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+
+	//SUPER_ADMIN
+	e.GET("/order/assignment/setting", c.getOrderAssignmentSetting,
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.PUT("/order/assignment/setting", echox.UserID(c.updateOrderAssignmentSetting),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.POST("/order/assignment/run", echox.UserID(c.runAutoAssignOrder),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.PUT("/order/assignment/editor/:userId", echox.UserID(c.updateEditorAssignmentProfile),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+}
+
+type OrderAssignmentSettingResponse struct {
+	Enabled bool `json:"enabled" validate:"required" example:"true"`
+
+	// Strategy 배정 전략
+	// * ROUND_ROBIN - 편집자 순서대로 돌아가며 배정
+	// * LEAST_LOADED - 진행중인 의뢰가 가장 적은 편집자
+	// * SKILL_MATCH - 요구사항과 겹치는 작업 키워드가 가장 많은 편집자
+	Strategy  domain.OrderAssignmentStrategy `json:"strategy" validate:"required" example:"LEAST_LOADED" enums:"ROUND_ROBIN,LEAST_LOADED,SKILL_MATCH"`
+	UpdatedAt time.Time                      `json:"updatedAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+	UpdatedBy *uuid.UUID                     `json:"updatedBy" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name OrderAssignmentSettingResponse
+
+// @Tags (Order) 자동 배정
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 자동 배정 설정 조회
+// @Description 의뢰 자동 배정 사용 여부와 전략을 가져오는 기능, 역할(role)이 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Success 200 {object} OrderAssignmentSettingResponse true "자동 배정 설정"
+// @Router /order/assignment/setting [get]
+func (c *OrderAssignmentController) getOrderAssignmentSetting(ctx echo.Context) error {
+	res, err := c.useCase.GetSetting(ctx.Request().Context())
+	if err != nil {
+		log.WithError(err).Error(tag, "getOrderAssignmentSetting, unhandled error useCase.GetSetting")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	return ctx.JSON(http.StatusOK, OrderAssignmentSettingResponse{
+		Enabled:   res.Enabled,
+		Strategy:  res.Strategy,
+		UpdatedAt: res.UpdatedAt,
+		UpdatedBy: res.UpdatedBy,
+	})
+}
+
+type UpdateOrderAssignmentSettingRequest struct {
+	Enabled  bool                           `json:"enabled" example:"true"`
+	Strategy domain.OrderAssignmentStrategy `json:"strategy" validate:"required,oneof=ROUND_ROBIN LEAST_LOADED SKILL_MATCH" example:"LEAST_LOADED"`
+} // @name UpdateOrderAssignmentSettingRequest
+
+// @Tags (Order) 자동 배정
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 자동 배정 설정 변경
+// @Description 의뢰 자동 배정을 켜고 끄거나 전략을 바꾸는 기능, 바로 다음 의뢰부터 적용됨, 역할(role)이 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Param requestBody body UpdateOrderAssignmentSettingRequest true "자동 배정 설정 데이터 구조"
+// @Success 204 "설정 변경 완료"
+// @Router /order/assignment/setting [put]
+func (c *OrderAssignmentController) updateOrderAssignmentSetting(ctx echo.Context, userId uuid.UUID) error {
+	var req UpdateOrderAssignmentSettingRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "update order assignment setting, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.UpdateSetting(ctx.Request().Context(), domain.UpdateOrderAssignmentSetting{
+		UserId:   userId,
+		Enabled:  req.Enabled,
+		Strategy: req.Strategy,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "unknown strategy"})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "updateOrderAssignmentSetting, unhandled error useCase.UpdateSetting")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type RunAutoAssignOrderRequest struct {
+	// OrderId 비어있으면 배정 대기중인 모든 의뢰
+	OrderId uuid.UUID `json:"orderId" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name RunAutoAssignOrderRequest
+
+type OrderAssignmentLogResponse struct {
+	OrderId  uuid.UUID                      `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Assignee uuid.UUID                      `json:"assignee" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Strategy domain.OrderAssignmentStrategy `json:"strategy" validate:"required" example:"LEAST_LOADED" enums:"ROUND_ROBIN,LEAST_LOADED,SKILL_MATCH"`
+
+	// Trigger 배정 계기
+	// * ORDER_REQUESTED - 의뢰 요청 직후
+	// * MANUAL - 최고 관리자가 직접 실행
+	Trigger domain.OrderAssignmentTrigger `json:"trigger" validate:"required" example:"ORDER_REQUESTED" enums:"ORDER_REQUESTED,MANUAL"`
+
+	// ReasonCode 해당 편집자를 고른 이유
+	// * ROUND_ROBIN_NEXT - 이전 배정 편집자 다음 순서
+	// * ROUND_ROBIN_FIRST - 첫 번째 순서
+	// * LEAST_LOADED - 진행중인 의뢰가 가장 적음
+	// * NO_SKILL_MATCH - 일치하는 작업 키워드가 없어 진행중인 의뢰가 가장 적은 편집자
+	// * SKILL_MATCH - 작업 키워드가 가장 많이 일치
+	ReasonCode domain.OrderAssignmentReason `json:"reasonCode" validate:"required" example:"LEAST_LOADED" enums:"ROUND_ROBIN_NEXT,ROUND_ROBIN_FIRST,LEAST_LOADED,NO_SKILL_MATCH,SKILL_MATCH"`
+
+	// Reason 해당 편집자를 고른 이유를 설명하는 문장
+	Reason string `json:"reason" validate:"required" example:"진행중인 의뢰 1건으로 가장 적음 (최대 5건)"`
+
+	// Load 배정 직전 편집자가 진행중이던 의뢰 수
+	Load      int64     `json:"load" validate:"required" example:"1"`
+	CreatedAt time.Time `json:"createdAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+} // @name OrderAssignmentLogResponse
+
+type OrderAssignmentLogListResponse []OrderAssignmentLogResponse
+
+// @Tags (Order) 자동 배정
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 자동 배정 실행
+// @Description 현재 전략으로 의뢰를 바로 배정하는 기능, 자동 배정이 꺼져 있어도 실행됨, 배정할 수 있는 편집자가 없으면 남은 의뢰는 대기 상태로 둠, 역할(role)이 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param requestBody body RunAutoAssignOrderRequest true "자동 배정 실행 데이터 구조"
+// @Success 200 {object} OrderAssignmentLogListResponse true "배정 결과"
+// @Router /order/assignment/run [post]
+func (c *OrderAssignmentController) runAutoAssignOrder(ctx echo.Context, userId uuid.UUID) error {
+	var req RunAutoAssignOrderRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "run auto assign order, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	list, err := c.useCase.RunAutoAssignOrder(ctx.Request().Context(), domain.RunAutoAssignOrder{
+		UserId:  userId,
+		OrderId: req.OrderId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusOK, useCaseToOrderAssignmentLogListResponse(list))
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "already assigned order"})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("req", req).
+			Error(tag, "runAutoAssignOrder, unhandled error useCase.RunAutoAssignOrder")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type UpdateEditorAssignmentProfileRequest struct {
+	UserId uuid.UUID `json:"-" param:"userId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// MaxConcurrentOrder 동시에 맡을 수 있는 최대 의뢰 수, 0 이면 기본값 사용
+	MaxConcurrentOrder uint8 `json:"maxConcurrentOrder" validate:"max=100" example:"5"`
+
+	// Skills 잘하는 작업 키워드, 스킬 매칭 전략에서 요구사항과 비교함
+	Skills []string `json:"skills" validate:"max=20,dive,required,max=30" example:"자막,컷편집"`
+
+	// Away 휴가 등으로 자동 배정에서 제외
+	Away bool `json:"away" example:"false"`
+} // @name UpdateEditorAssignmentProfileRequest
+
+// @Tags (Order) 자동 배정
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 편집자 자동 배정 정보 수정
+// @Description 편집자의 최대 동시 의뢰 수, 작업 키워드, 자동 배정 제외 여부를 수정하는 기능, 역할(role)이 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Param user_id path string true "편집자 식별 아이디(UUID)"
+// @Param requestBody body UpdateEditorAssignmentProfileRequest true "편집자 자동 배정 정보 데이터 구조"
+// @Success 204 "수정 완료"
+// @Router /order/assignment/editor/{user_id} [put]
+func (c *OrderAssignmentController) updateEditorAssignmentProfile(ctx echo.Context, userId uuid.UUID) error {
+	var req UpdateEditorAssignmentProfileRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "update editor assignment profile, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.UpdateEditorProfile(ctx.Request().Context(), domain.UpdateEditorAssignmentProfile{
+		UserId:             userId,
+		EditorId:           req.UserId,
+		MaxConcurrentOrder: req.MaxConcurrentOrder,
+		Skills:             req.Skills,
+		Away:               req.Away,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("req", req).
+			Error(tag, "updateEditorAssignmentProfile, unhandled error useCase.UpdateEditorProfile")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+// @Tags (Order) 자동 배정
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 의뢰 자동 배정 기록
+// @Description 의뢰가 어떤 이유로 누구에게 자동 배정되었는지 가져오는 기능, 최신순, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Success 200 {object} OrderAssignmentLogListResponse true "자동 배정 기록"
+// @Success 204 "자동 배정 기록 없음"
+// @Router /order/{order_id}/assignment [get]
+func (c *OrderAssignmentController) fetchOrderAssignmentLog(ctx echo.Context) error {
+	var req struct {
+		OrderId uuid.UUID `json:"-" param:"orderId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch order assignment log, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	list, err := c.useCase.FetchLogByOrderId(ctx.Request().Context(), req.OrderId)
+	if err != nil {
+		log.WithError(err).Error(tag, "fetchOrderAssignmentLog, unhandled error useCase.FetchLogByOrderId")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	return ctx.JSON(http.StatusOK, useCaseToOrderAssignmentLogListResponse(list))
+}
+
+func useCaseToOrderAssignmentLogListResponse(list []domain.OrderAssignmentLogInfo) OrderAssignmentLogListResponse {
+	res := make(OrderAssignmentLogListResponse, len(list))
+	for i := range list {
+		src := list[i]
+		res[i] = OrderAssignmentLogResponse{
+			OrderId:    src.OrderId,
+			Assignee:   src.Assignee,
+			Strategy:   src.Strategy,
+			Trigger:    src.Trigger,
+			ReasonCode: src.Reason,
+			Reason:     src.ReasonText,
+			Load:       src.Load,
+			CreatedAt:  src.CreatedAt,
+		}
+	}
+	return res
+}
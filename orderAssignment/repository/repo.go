@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"gorm.io/gorm"
+)
+
+func NewOrderAssignmentRepository(db *gorm.DB) domain.OrderAssignmentRepository {
+	db.AutoMigrate(&domain.OrderAssignmentSetting{}, &domain.OrderAssignmentLog{})
+	return &repo{db: db}
+}
+
+type repo struct {
+	db *gorm.DB
+}
+
+func (r *repo) Get() *gorm.DB {
+	return r.db
+}
+
+func (r *repo) Transaction(ctx context.Context, fn func(orderAssignmentRepo domain.OrderAssignmentTxRepository) error, options ...*sql.TxOptions) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&repo{db: tx})
+	}, options...)
+}
+
+func (r *repo) With(tx gormx.Tx) domain.OrderAssignmentTxRepository {
+	return &repo{db: tx.Get()}
+}
+
+func (r *repo) GetSetting(ctx context.Context) (setting domain.OrderAssignmentSetting, err error) {
+	setting = domain.DefaultOrderAssignmentSetting()
+	err = r.db.WithContext(ctx).First(&setting, setting.Id).Error
+	if err == gorm.ErrRecordNotFound {
+		setting = domain.DefaultOrderAssignmentSetting()
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) SaveSetting(ctx context.Context, setting *domain.OrderAssignmentSetting) error {
+	return gormx.Upsert(ctx, r.db, setting)
+}
+
+func (r *repo) SaveLog(ctx context.Context, log *domain.OrderAssignmentLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+func (r *repo) FetchLogByOrderId(ctx context.Context, orderId uuid.UUID) (list []domain.OrderAssignmentLog, err error) {
+	err = r.db.WithContext(ctx).
+		Order("`created_at` desc").
+		Where("`order_id` = ?", orderId).
+		Find(&list).Error
+	return
+}
@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+// candidate 자동 배정 후보 편집자, load 는 진행중인 의뢰 수
+type candidate struct {
+	manager domain.Manager
+	load    int64
+	limit   uint8
+}
+
+// reason 편집자를 고른 이유, args 는 이유마다 정해진 순서로 채움
+type reason struct {
+	code domain.OrderAssignmentReason
+	args []string
+}
+
+// strategy 배정 전략, candidates 는 비어있지 않고 편집자 아이디 순으로 정렬되어 있음
+type strategy interface {
+	pick(order domain.Order, candidates []candidate, setting domain.OrderAssignmentSetting) (picked int, why reason)
+}
+
+// strategies 새 전략은 여기에 등록
+var strategies = map[domain.OrderAssignmentStrategy]strategy{
+	domain.OrderAssignmentStrategyRoundRobin:  roundRobin{},
+	domain.OrderAssignmentStrategyLeastLoaded: leastLoaded{},
+	domain.OrderAssignmentStrategySkillMatch:  skillMatch{},
+}
+
+type roundRobin struct{}
+
+func (roundRobin) pick(_ domain.Order, candidates []candidate, setting domain.OrderAssignmentSetting) (int, reason) {
+	if setting.LastAssignee != nil {
+		for i := range candidates {
+			if bytes.Compare(candidates[i].manager.Id[:], setting.LastAssignee[:]) > 0 {
+				return i, reason{
+					code: domain.OrderAssignmentReasonRoundRobinNext,
+					args: []string{setting.LastAssignee.String()},
+				}
+			}
+		}
+	}
+
+	return 0, reason{code: domain.OrderAssignmentReasonRoundRobinFirst}
+}
+
+type leastLoaded struct{}
+
+func (leastLoaded) pick(_ domain.Order, candidates []candidate, _ domain.OrderAssignmentSetting) (int, reason) {
+	picked := leastLoadedIndex(candidates, nil)
+	return picked, reason{
+		code: domain.OrderAssignmentReasonLeastLoaded,
+		args: []string{
+			strconv.FormatInt(candidates[picked].load, 10),
+			strconv.Itoa(int(candidates[picked].limit)),
+		},
+	}
+}
+
+type skillMatch struct{}
+
+func (skillMatch) pick(order domain.Order, candidates []candidate, setting domain.OrderAssignmentSetting) (int, reason) {
+	var requirement string
+	if order.Requirement != nil {
+		requirement = strings.ToLower(*order.Requirement)
+	}
+
+	var (
+		best    = 0
+		matched = make([][]string, len(candidates))
+	)
+	for i := range candidates {
+		for _, skill := range candidates[i].manager.SkillList() {
+			if strings.Contains(requirement, strings.ToLower(skill)) {
+				matched[i] = append(matched[i], skill)
+			}
+		}
+
+		if len(matched[i]) > best {
+			best = len(matched[i])
+		}
+	}
+
+	if best == 0 {
+		picked, why := leastLoaded{}.pick(order, candidates, setting)
+		why.code = domain.OrderAssignmentReasonNoSkillMatch
+		return picked, why
+	}
+
+	picked := leastLoadedIndex(candidates, func(i int) bool {
+		return len(matched[i]) == best
+	})
+	return picked, reason{
+		code: domain.OrderAssignmentReasonSkillMatch,
+		args: []string{
+			strconv.Itoa(best),
+			strings.Join(matched[picked], ", "),
+			strconv.FormatInt(candidates[picked].load, 10),
+		},
+	}
+}
+
+// reasonKeys 이유를 문장으로 바꿀 카탈로그 키
+var reasonKeys = map[domain.OrderAssignmentReason]string{
+	domain.OrderAssignmentReasonRoundRobinNext:  i18n.AssignmentReasonRoundRobinNext,
+	domain.OrderAssignmentReasonRoundRobinFirst: i18n.AssignmentReasonRoundRobinFirst,
+	domain.OrderAssignmentReasonLeastLoaded:     i18n.AssignmentReasonLeastLoaded,
+	domain.OrderAssignmentReasonNoSkillMatch:    i18n.AssignmentReasonNoSkillMatch,
+	domain.OrderAssignmentReasonSkillMatch:      i18n.AssignmentReasonSkillMatch,
+}
+
+// reasonText 기록된 이유를 요청 언어의 문장으로 바꿈, 모르는 이유면 코드를 그대로 씀
+func reasonText(ctx context.Context, code domain.OrderAssignmentReason, args []string) string {
+	key, ok := reasonKeys[code]
+	if !ok {
+		return string(code)
+	}
+
+	values := make([]interface{}, len(args))
+	for i := range args {
+		values[i] = args[i]
+	}
+	return i18n.T(ctx, key, values...)
+}
+
+// leastLoadedIndex filter 를 통과한 후보 중 진행중인 의뢰가 가장 적은 후보, 같으면 앞 순서
+func leastLoadedIndex(candidates []candidate, filter func(i int) bool) int {
+	picked := -1
+	for i := range candidates {
+		if filter != nil && !filter(i) {
+			continue
+		}
+
+		if picked < 0 || candidates[i].load < candidates[picked].load {
+			picked = i
+		}
+	}
+	return picked
+}
@@ -0,0 +1,419 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+func NewOrderAssignmentUseCase(
+	orderAssignmentRepo domain.OrderAssignmentRepository,
+	orderRepo domain.OrderRepository,
+	orderStateRepo domain.OrderStateRepository,
+	orderTypeRepo domain.OrderTypeRepository,
+	userRepo domain.UserRepository,
+	managerRepo domain.ManagerRepository,
+	timeout time.Duration,
+) domain.OrderAssignmentUseCase {
+	return &ucase{
+		orderAssignmentRepo: orderAssignmentRepo,
+		orderRepo:           orderRepo,
+		orderStateRepo:      orderStateRepo,
+		orderTypeRepo:       orderTypeRepo,
+		userRepo:            userRepo,
+		managerRepo:         managerRepo,
+		timeout:             timeout,
+	}
+}
+
+type ucase struct {
+	orderAssignmentRepo domain.OrderAssignmentRepository
+	orderRepo           domain.OrderRepository
+	orderStateRepo      domain.OrderStateRepository
+	orderTypeRepo       domain.OrderTypeRepository
+	userRepo            domain.UserRepository
+	managerRepo         domain.ManagerRepository
+	timeout             time.Duration
+}
+
+func (u *ucase) AssignRequestedOrder(ctx context.Context, orderId uuid.UUID) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	setting, err := u.orderAssignmentRepo.GetSetting(c)
+	if err != nil || !setting.Enabled {
+		return
+	}
+
+	order, err := u.orderRepo.GetById(c, orderId)
+	if err != nil || order == nil {
+		return
+	}
+
+	_, err = u.assignOrders(c, setting, []domain.Order{*order}, domain.OrderAssignmentTriggerOrderRequested)
+	return
+}
+
+func (u *ucase) RunAutoAssignOrder(ctx context.Context, in domain.RunAutoAssignOrder) (res []domain.OrderAssignmentLogInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var (
+		setting domain.OrderAssignmentSetting
+		orders  []domain.Order
+	)
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() (err error) {
+		setting, err = u.orderAssignmentRepo.GetSetting(gc)
+		return
+	})
+	g.Go(func() (err error) {
+		if in.OrderId == uuid.Nil {
+			orders, err = u.orderRepo.Fetch(gc, domain.FetchOrderOption{
+				OrderState: domain.OrderGeneralStateReady,
+			})
+			return
+		}
+
+		order, err := u.orderRepo.GetById(gc, in.OrderId)
+		if err != nil {
+			return
+		}
+
+		if order == nil || order.IsDone() {
+			return domain.ErrItemNotFound
+		}
+
+		if order.Assignee != nil {
+			return domain.ErrItemAlreadyExist
+		}
+
+		orders = []domain.Order{*order}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	return u.assignOrders(c, setting, orders, domain.OrderAssignmentTriggerManual)
+}
+
+// assignOrders 배정할 수 있는 편집자가 없으면 남은 의뢰는 대기 상태로 둠
+func (u *ucase) assignOrders(ctx context.Context, setting domain.OrderAssignmentSetting, orders []domain.Order, trigger domain.OrderAssignmentTrigger) (res []domain.OrderAssignmentLogInfo, err error) {
+	res = []domain.OrderAssignmentLogInfo{}
+	if len(orders) == 0 {
+		return
+	}
+
+	picker, ok := strategies[setting.Strategy]
+	if !ok {
+		err = errors.New("unknown order assignment strategy " + string(setting.Strategy))
+		return
+	}
+
+	var (
+		candidates []candidate
+		take       *domain.OrderState
+		types      []domain.OrderType
+	)
+	g, gc := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		candidates, err = u.fetchCandidates(gc)
+		return
+	})
+	g.Go(func() (err error) {
+		take, _ = u.orderStateRepo.GetByCode(gc, domain.OrderStateCodeTake)
+		if take == nil {
+			err = errors.New("orderStateRepo.GetByCode domain.OrderStateCodeTake not exists state")
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		types, err = u.orderTypeRepo.FetchAll(gc, false)
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	typeMap := make(map[uint8]*domain.OrderType, len(types))
+	for i := range types {
+		typeMap[types[i].Id] = &types[i]
+	}
+
+	for i := range orders {
+		if len(candidates) == 0 {
+			return
+		}
+
+		picked, why := picker.pick(orders[i], candidates, setting)
+		var assigned *domain.OrderAssignmentLog
+		err = u.orderAssignmentRepo.Transaction(ctx, func(oar domain.OrderAssignmentTxRepository) (err error) {
+			or := u.orderRepo.With(oar)
+			order, err := or.GetById(ctx, orders[i].Id)
+			if err != nil {
+				return
+			}
+
+			// 그 사이 다른 편집자가 가져간 의뢰
+			if order == nil || order.IsDone() || order.Assignee != nil {
+				return
+			}
+
+			assignee := candidates[picked].manager.Id
+			order.Assignee = &assignee
+			order.ChangeState(order.TakeStateId(typeMap[order.TypeId], take.Id))
+			err = or.Save(ctx, order)
+			if err != nil {
+				return
+			}
+
+			log := domain.CreateOrderAssignmentLog(domain.CreateOrderAssignmentLogOption{
+				OrderId:    order.Id,
+				Assignee:   assignee,
+				Strategy:   setting.Strategy,
+				Trigger:    trigger,
+				Reason:     why.code,
+				ReasonArgs: why.args,
+				Load:       candidates[picked].load,
+			})
+			err = oar.SaveLog(ctx, &log)
+			if err != nil {
+				return
+			}
+
+			setting.LastAssignee = &assignee
+			err = oar.SaveSetting(ctx, &setting)
+			if err != nil {
+				return
+			}
+
+			assigned = &log
+			return
+		})
+		// 불러온 뒤 다른 곳에서 먼저 수정한 의뢰는 건너뜀
+		if err == domain.ErrVersionConflict {
+			err = nil
+			continue
+		}
+		if err != nil {
+			return
+		}
+
+		if assigned == nil {
+			continue
+		}
+
+		res = append(res, domainToOrderAssignmentLogInfo(ctx, *assigned))
+
+		candidates[picked].load++
+		if candidates[picked].load >= int64(candidates[picked].limit) {
+			candidates = append(candidates[:picked], candidates[picked+1:]...)
+		}
+	}
+
+	return
+}
+
+// fetchCandidates 자동 배정에서 제외되지 않았고, 오늘 휴무가 아니며, 오늘 근무 시간이 남았고, 최대 의뢰 수에 닿지 않은 편집자
+func (u *ucase) fetchCandidates(ctx context.Context) (res []candidate, err error) {
+	var (
+		admins  []domain.User
+		loads   map[uuid.UUID]int64
+		dayOffs []domain.ManagerDayOff
+	)
+	now := time.Now()
+	g, gc := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		admins, err = u.userRepo.FetchAllAdmin(gc, domain.FetchAdminOption{})
+		return
+	})
+	g.Go(func() (err error) {
+		loads, err = u.orderRepo.CountProcessingGroupByAssignee(gc)
+		return
+	})
+	g.Go(func() (err error) {
+		dayOffs, err = u.managerRepo.FetchDayOff(gc, domain.FetchManagerDayOffOption{
+			From: now,
+			To:   now,
+		})
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	offToday := make(map[uuid.UUID]bool, len(dayOffs))
+	for i := range dayOffs {
+		offToday[dayOffs[i].ManagerId] = true
+	}
+
+	ids := make([]uuid.UUID, 0, len(admins))
+	for i := range admins {
+		if admins[i].Manager != nil {
+			ids = append(ids, admins[i].Id)
+		}
+	}
+
+	list, err := u.managerRepo.FetchWorkingHoursByManagerIds(ctx, ids)
+	if err != nil {
+		return
+	}
+
+	hours := make(map[uuid.UUID][]domain.ManagerWorkingHour)
+	for i := range list {
+		hours[list[i].ManagerId] = append(hours[list[i].ManagerId], list[i])
+	}
+
+	loc := config.Assignment.Location()
+	y, m, d := now.In(loc).Date()
+	endOfToday := time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+
+	for i := range admins {
+		admin := admins[i]
+		if !domain.CheckUserAlive(&admin, domain.User.IsAdmin, domain.User.IsSuperAdmin) ||
+			admin.Manager == nil || admin.Manager.Away || offToday[admin.Id] ||
+			!domain.HasWorkingTime(hours[admin.Id], now, endOfToday, loc) {
+			continue
+		}
+
+		limit := admin.Manager.ConcurrentOrderLimit(config.Assignment.DefaultMaxConcurrentOrder)
+		if loads[admin.Id] >= int64(limit) {
+			continue
+		}
+
+		res = append(res, candidate{
+			manager: *admin.Manager,
+			load:    loads[admin.Id],
+			limit:   limit,
+		})
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return bytes.Compare(res[i].manager.Id[:], res[j].manager.Id[:]) < 0
+	})
+	return
+}
+
+func (u *ucase) GetSetting(ctx context.Context) (res domain.OrderAssignmentSettingInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	setting, err := u.orderAssignmentRepo.GetSetting(c)
+	if err != nil {
+		return
+	}
+
+	res = domain.OrderAssignmentSettingInfo{
+		Enabled:   setting.Enabled,
+		Strategy:  setting.Strategy,
+		UpdatedAt: setting.UpdatedAt,
+		UpdatedBy: setting.UpdatedBy,
+	}
+	return
+}
+
+func (u *ucase) UpdateSetting(ctx context.Context, in domain.UpdateOrderAssignmentSetting) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if !in.Strategy.IsValid() {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	var setting domain.OrderAssignmentSetting
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() (err error) {
+		setting, err = u.orderAssignmentRepo.GetSetting(gc)
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	setting.Update(in.Enabled, in.Strategy, in.UserId)
+	return u.orderAssignmentRepo.SaveSetting(c, &setting)
+}
+
+func (u *ucase) UpdateEditorProfile(ctx context.Context, in domain.UpdateEditorAssignmentProfile) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var manager *domain.Manager
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() (err error) {
+		editor, err := u.userRepo.GetById(gc, in.EditorId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(editor, domain.User.IsAdmin, domain.User.IsSuperAdmin) {
+			return domain.ErrItemNotFound
+		}
+
+		manager, err = u.managerRepo.GetById(gc, in.EditorId)
+		if err == nil && manager == nil {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	manager.MaxConcurrentOrder = in.MaxConcurrentOrder
+	manager.UpdateSkills(in.Skills)
+	manager.Away = in.Away
+	return u.managerRepo.Save(c, manager)
+}
+
+func (u *ucase) FetchLogByOrderId(ctx context.Context, orderId uuid.UUID) (res []domain.OrderAssignmentLogInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	list, err := u.orderAssignmentRepo.FetchLogByOrderId(c, orderId)
+	if err != nil {
+		return
+	}
+
+	res = make([]domain.OrderAssignmentLogInfo, len(list))
+	for i := range list {
+		res[i] = domainToOrderAssignmentLogInfo(ctx, list[i])
+	}
+	return
+}
+
+func domainToOrderAssignmentLogInfo(ctx context.Context, src domain.OrderAssignmentLog) domain.OrderAssignmentLogInfo {
+	return domain.OrderAssignmentLogInfo{
+		OrderId:    src.OrderId,
+		Assignee:   src.Assignee,
+		Strategy:   src.Strategy,
+		Trigger:    src.Trigger,
+		Reason:     src.Reason,
+		ReasonText: reasonText(ctx, src.Reason, src.ReasonArgList()),
+		Load:       src.Load,
+		CreatedAt:  src.CreatedAt,
+	}
+}
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+const (
+	tag = "[ORDER-DELIVERY] "
+)
+
+func NewOrderDeliveryController(useCase domain.OrderDeliveryUseCase) *OrderDeliveryController {
+	return &OrderDeliveryController{useCase: useCase}
+}
+
+type OrderDeliveryController struct {
+	useCase domain.OrderDeliveryUseCase
+}
+
+func (c *OrderDeliveryController) Bind(e *echo.Echo) {
+	//CUSTOMER
+	// 결과물 목록
+	e.GET("/customer/me/orders/:orderId/delivery", echox.UserID(c.fetchMyOrderDelivery),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	// 결과물 승인
+	e.POST("/order/:orderId/delivery/:version/approve", echox.UserID(c.approveOrderDelivery),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	// 결과물 반려 (수정 요청)
+	e.POST("/order/:orderId/delivery/:version/reject", echox.UserID(c.rejectOrderDelivery),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+
+	//ADMIN
+	e.GET("/order/:orderId/delivery", c.fetchOrderDelivery,
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.POST("/order/:orderId/delivery", echox.UserID(c.createOrderDelivery),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+}
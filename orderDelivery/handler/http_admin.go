@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+type OrderDeliveryFileRequest struct {
+	Name string `json:"name" validate:"required,max=300" example:"최종본.mp4"`
+	Link string `json:"link" validate:"required,url,max=2048" example:"https://onedrive.live.com/..."`
+} // @name OrderDeliveryFileRequest
+
+type CreateOrderDeliveryRequest struct {
+	OrderId uuid.UUID                  `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Files   []OrderDeliveryFileRequest `json:"files" validate:"required,min=1,max=20,dive"`
+	Note    *string                    `json:"note" validate:"omitempty,max=2000" example:"자막 폰트 변경했습니다"`
+} // @name CreateOrderDeliveryRequest
+
+type CreateOrderDeliveryResponse struct {
+	Version uint8 `json:"version" validate:"required" example:"1"`
+} // @name CreateOrderDeliveryResponse
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 결과물 전달
+// @Description 편집 결과물을 전달하는 기능, 반려 후 전달하면 버전이 증가함, 담당 편집자이거나 역할(role)이 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param requestBody body CreateOrderDeliveryRequest true "결과물 전달 데이터 구조"
+// @Success 201 {object} CreateOrderDeliveryResponse true "결과물 전달 완료"
+// @Router /order/{order_id}/delivery [post]
+func (c *OrderDeliveryController) createOrderDelivery(ctx echo.Context, userId uuid.UUID) error {
+	var req CreateOrderDeliveryRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "create order delivery, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	files := make([]domain.OrderDeliveryFileInfo, len(req.Files))
+	for i := range req.Files {
+		files[i] = domain.OrderDeliveryFileInfo{
+			Name: req.Files[i].Name,
+			Link: req.Files[i].Link,
+		}
+	}
+
+	var in = domain.DeliverOrder{
+		UserId:  userId,
+		OrderId: req.OrderId,
+		Files:   files,
+		Note:    req.Note,
+	}
+	version, err := c.useCase.DeliverOrder(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusCreated, CreateOrderDeliveryResponse{Version: version})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "not assigned order"})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "pending delivery exists"})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", in).
+			Error(tag, "createOrderDelivery, unhandled error useCase.DeliverOrder")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 결과물 목록
+// @Description 의뢰의 결과물 목록을 버전 순으로 가져오는 기능, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Success 200 {object} OrderDeliveryInfoListResponse true "결과물 목록"
+// @Success 204 "결과물 없음"
+// @Router /order/{order_id}/delivery [get]
+func (c *OrderDeliveryController) fetchOrderDelivery(ctx echo.Context) error {
+	var req struct {
+		OrderId uuid.UUID `json:"-" param:"orderId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch order delivery, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	list, err := c.useCase.FetchByOrderId(ctx.Request().Context(), req.OrderId)
+	if err != nil {
+		log.WithError(err).Error(tag, "fetchOrderDelivery, unhandled error useCase.FetchByOrderId")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	return ctx.JSON(http.StatusOK, useCaseToOrderDeliveryInfoListResponse(list))
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+type OrderDeliveryFileResponse struct {
+	Name string `json:"name" validate:"required" example:"최종본.mp4"`
+	Link string `json:"link" validate:"required" example:"https://onedrive.live.com/..."`
+} // @name OrderDeliveryFileResponse
+
+type OrderDeliveryInfoResponse struct {
+	// Version 결과물 버전, 수정 요청 후 전달될 때마다 1씩 증가
+	Version uint8 `json:"version" validate:"required" example:"1"`
+
+	// State 결과물 상태
+	// * PENDING - 고객 검토 대기
+	// * APPROVED - 승인
+	// * REJECTED - 반려
+	State domain.OrderDeliveryState `json:"state" validate:"required" example:"PENDING" enums:"PENDING,APPROVED,REJECTED"`
+
+	Files      []OrderDeliveryFileResponse `json:"files" validate:"required"`
+	Note       *string                     `json:"note" example:"자막 폰트 변경했습니다"`
+	CreatedBy  uuid.UUID                   `json:"createdBy" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CreatedAt  time.Time                   `json:"createdAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+	ReviewedAt *time.Time                  `json:"reviewedAt" example:"2021-10-28T04:44:18+00:00"`
+} // @name OrderDeliveryInfoResponse
+
+type OrderDeliveryInfoListResponse []OrderDeliveryInfoResponse
+
+func useCaseToOrderDeliveryInfoResponse(src domain.OrderDeliveryInfo) OrderDeliveryInfoResponse {
+	files := make([]OrderDeliveryFileResponse, len(src.Files))
+	for i := range src.Files {
+		files[i] = OrderDeliveryFileResponse{
+			Name: src.Files[i].Name,
+			Link: src.Files[i].Link,
+		}
+	}
+
+	return OrderDeliveryInfoResponse{
+		Version:    src.Version,
+		State:      src.State,
+		Files:      files,
+		Note:       src.Note,
+		CreatedBy:  src.CreatedBy,
+		CreatedAt:  src.CreatedAt,
+		ReviewedAt: src.ReviewedAt,
+	}
+}
+
+func useCaseToOrderDeliveryInfoListResponse(list []domain.OrderDeliveryInfo) (res OrderDeliveryInfoListResponse) {
+	res = make(OrderDeliveryInfoListResponse, len(list))
+	for i := range list {
+		res[i] = useCaseToOrderDeliveryInfoResponse(list[i])
+	}
+
+	return
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 결과물 목록
+// @Description 고객이 자신의 의뢰 결과물 목록을 가져오는 기능, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Success 200 {object} OrderDeliveryInfoListResponse true "결과물 목록"
+// @Success 204 "결과물 없음"
+// @Router /customer/me/orders/{order_id}/delivery [get]
+func (c *OrderDeliveryController) fetchMyOrderDelivery(ctx echo.Context, userId uuid.UUID) error {
+	var req struct {
+		OrderId uuid.UUID `json:"-" param:"orderId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch my order delivery, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	list, err := c.useCase.FetchMyOrderDelivery(ctx.Request().Context(), userId, req.OrderId)
+
+	switch err {
+	case nil:
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "fetchMyOrderDelivery, unhandled error useCase.FetchMyOrderDelivery")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	return ctx.JSON(http.StatusOK, useCaseToOrderDeliveryInfoListResponse(list))
+}
+
+type ReviewOrderDeliveryRequest struct {
+	OrderId uuid.UUID `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Version uint8     `json:"-" param:"version" validate:"required" example:"1"`
+
+	// Feedbacks 반려할 때 함께 남기는 수정 요청 항목
+	Feedbacks []domain.OrderFeedbackItemRequest `json:"feedbacks" validate:"max=50,dive"`
+} // @name ReviewOrderDeliveryRequest
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 결과물 승인
+// @Description 고객이 전달받은 결과물을 승인하는 기능, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param version path int true "결과물 버전"
+// @Success 204 "승인 완료"
+// @Router /order/{order_id}/delivery/{version}/approve [post]
+func (c *OrderDeliveryController) approveOrderDelivery(ctx echo.Context, userId uuid.UUID) error {
+	return c.internalReviewOrderDelivery(ctx, userId, c.useCase.ApproveOrderDelivery)
+}
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 결과물 반려
+// @Description 고객이 전달받은 결과물을 반려하는 기능, 수정 횟수를 1회 소모함, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param version path int true "결과물 버전"
+// @Param requestBody body ReviewOrderDeliveryRequest false "수정 요청 항목"
+// @Success 204 "반려 완료"
+// @Router /order/{order_id}/delivery/{version}/reject [post]
+func (c *OrderDeliveryController) rejectOrderDelivery(ctx echo.Context, userId uuid.UUID) error {
+	return c.internalReviewOrderDelivery(ctx, userId, c.useCase.RejectOrderDelivery)
+}
+
+func (c *OrderDeliveryController) internalReviewOrderDelivery(
+	ctx echo.Context,
+	userId uuid.UUID,
+	review func(ctx context.Context, in domain.ReviewOrderDelivery) error,
+) error {
+	var req ReviewOrderDeliveryRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "review order delivery, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	var in = domain.ReviewOrderDelivery{
+		UserId:    userId,
+		OrderId:   req.OrderId,
+		Version:   req.Version,
+		Feedbacks: domain.ToOrderFeedbackItems(req.Feedbacks),
+	}
+	err = review(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "empty remaining edit count"})
+	case domain.ErrInvalidFeedback:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid feedback timecode range"})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "already reviewed delivery"})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", in).
+			Error(tag, "reviewOrderDelivery, unhandled error")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"gorm.io/gorm"
+)
+
+func NewOrderDeliveryRepository(db *gorm.DB) domain.OrderDeliveryRepository {
+	db.AutoMigrate(&domain.OrderDelivery{}, &domain.OrderDeliveryFile{})
+	return &repo{db: db}
+}
+
+type repo struct {
+	db *gorm.DB
+}
+
+func (r *repo) Save(ctx context.Context, delivery *domain.OrderDelivery) error {
+	return gormx.Upsert(ctx, r.db, delivery)
+}
+
+func (r *repo) Create(ctx context.Context, delivery *domain.OrderDelivery) error {
+	err := r.db.WithContext(ctx).Create(delivery).Error
+	if gormx.IsDuplicateEntry(err) {
+		return domain.ErrItemAlreadyExist
+	}
+	return err
+}
+
+func (r *repo) Transaction(ctx context.Context, fn func(deliveryRepo domain.OrderDeliveryTxRepository) error, options ...*sql.TxOptions) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&repo{db: tx})
+	}, options...)
+}
+
+func (r *repo) With(tx gormx.Tx) domain.OrderDeliveryTxRepository {
+	return &repo{db: tx.Get()}
+}
+
+func (r *repo) Get() *gorm.DB {
+	return r.db
+}
+
+func (r *repo) GetByOrderIdAndVersion(ctx context.Context, orderId uuid.UUID, version uint8) (res *domain.OrderDelivery, err error) {
+	var entity domain.OrderDelivery
+	err = r.db.WithContext(ctx).
+		Preload("Files").
+		Where("`order_id` = ? AND `version` = ?", orderId, version).
+		First(&entity).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) GetLatestByOrderId(ctx context.Context, orderId uuid.UUID) (res *domain.OrderDelivery, err error) {
+	var entity domain.OrderDelivery
+	err = r.db.WithContext(ctx).
+		Preload("Files").
+		Order("`version` desc").
+		Where("`order_id` = ?", orderId).
+		First(&entity).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) ExistsApprovedByOrderId(ctx context.Context, orderId uuid.UUID) (exists bool, err error) {
+	var cnt int64
+	err = r.db.WithContext(ctx).
+		Model(&domain.OrderDelivery{}).
+		Where("`order_id` = ? AND `state` = ?", orderId, domain.OrderDeliveryStateApproved).
+		Count(&cnt).Error
+	exists = cnt > 0
+	return
+}
+
+func (r *repo) FetchByOrderId(ctx context.Context, orderId uuid.UUID) (list []domain.OrderDelivery, err error) {
+	err = r.db.WithContext(ctx).
+		Preload("Files").
+		Order("`version` asc").
+		Where("`order_id` = ?", orderId).
+		Find(&list).Error
+	return
+}
+
+func (r *repo) FetchAwaitingCompletion(ctx context.Context) (list []domain.OrderDelivery, err error) {
+	err = r.db.WithContext(ctx).
+		Joins("JOIN `order` ON `order`.`id` = `order_delivery`.`order_id`").
+		Where("`order`.`done_at` IS NULL").
+		Where("`order`.`state` NOT IN (?)", r.db.Model(&domain.OrderState{}).
+			Select("`id`").
+			Where("`code` = ?", domain.OrderStateCodeRequestEdit)).
+		Where("`order_delivery`.`state` IN ?", []domain.OrderDeliveryState{
+			domain.OrderDeliveryStatePending,
+			domain.OrderDeliveryStateApproved,
+		}).
+		Where("NOT EXISTS (SELECT 1 FROM `order_delivery` AS `newer` " +
+			"WHERE `newer`.`order_id` = `order_delivery`.`order_id` AND `newer`.`version` > `order_delivery`.`version`)").
+		Find(&list).Error
+	return
+}
@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+func NewOrderDeliveryUseCase(
+	orderDeliveryRepo domain.OrderDeliveryRepository,
+	orderRepo domain.OrderRepository,
+	orderStateRepo domain.OrderStateRepository,
+	orderFeedbackRepo domain.OrderFeedbackRepository,
+	userRepo domain.UserRepository,
+	notification domain.NotificationAdapter,
+	timeout time.Duration,
+) domain.OrderDeliveryUseCase {
+	return &ucase{
+		orderDeliveryRepo: orderDeliveryRepo,
+		orderRepo:         orderRepo,
+		orderStateRepo:    orderStateRepo,
+		orderFeedbackRepo: orderFeedbackRepo,
+		userRepo:          userRepo,
+		notification:      notification,
+		timeout:           timeout,
+	}
+}
+
+type ucase struct {
+	orderDeliveryRepo domain.OrderDeliveryRepository
+	orderRepo         domain.OrderRepository
+	orderStateRepo    domain.OrderStateRepository
+	orderFeedbackRepo domain.OrderFeedbackRepository
+	userRepo          domain.UserRepository
+	notification      domain.NotificationAdapter
+	timeout           time.Duration
+}
+
+func (u *ucase) DeliverOrder(ctx context.Context, in domain.DeliverOrder) (version uint8, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var (
+		user   *domain.User
+		order  *domain.Order
+		latest *domain.OrderDelivery
+	)
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		user, err = u.userRepo.GetById(gc, in.UserId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(user,
+			domain.User.IsAdmin,
+			domain.User.IsSuperAdmin) {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		order, err = u.orderRepo.GetById(gc, in.OrderId)
+		if err != nil {
+			return
+		}
+
+		if order == nil || order.IsDone() {
+			err = domain.ErrItemNotFound
+			return
+		}
+
+		if order.Assignee == nil {
+			err = domain.ErrWeirdData
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		latest, err = u.orderDeliveryRepo.GetLatestByOrderId(gc, in.OrderId)
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	// 결과물은 담당 편집자만 올림, 최고 관리자는 대신 올릴 수 있음
+	if !user.IsSuperAdmin() && *order.Assignee != user.Id {
+		err = domain.ErrNoPermission
+		return
+	}
+
+	if latest != nil && latest.IsPending() {
+		err = domain.ErrItemAlreadyExist
+		return
+	}
+
+	version = 1
+	if latest != nil {
+		version = latest.Version + 1
+	}
+
+	files := make([]domain.OrderDeliveryFile, len(in.Files))
+	for i := range in.Files {
+		files[i] = domain.OrderDeliveryFile{
+			Name: in.Files[i].Name,
+			Link: in.Files[i].Link,
+		}
+	}
+
+	delivery := domain.CreateOrderDelivery(domain.CreateOrderDeliveryOption{
+		OrderId:   order.Id,
+		Version:   version,
+		Files:     files,
+		Note:      in.Note,
+		CreatedBy: in.UserId,
+	})
+
+	// 수정 요청 중이던 의뢰는 결과물 전달과 함께 수정 완료 상태로 이동
+	state, err := u.orderStateRepo.GetById(c, order.State)
+	if err != nil {
+		return
+	}
+
+	var stateChanged bool
+	if state != nil && state.Code == domain.OrderStateCodeRequestEdit {
+		var editDone *domain.OrderState
+		editDone, err = u.orderStateRepo.GetByCode(c, domain.OrderStateCodeEditDone)
+		if err != nil {
+			return
+		}
+
+		if editDone != nil {
+			order.ChangeState(editDone.Id)
+			stateChanged = true
+		}
+	}
+
+	// 동시에 전달하면 같은 버전을 만들게 되는데, 먼저 저장한 쪽만 남고 나머지는 ErrItemAlreadyExist
+	err = u.orderDeliveryRepo.Transaction(c, func(dr domain.OrderDeliveryTxRepository) (err error) {
+		err = dr.Create(c, &delivery)
+		if err != nil || !stateChanged {
+			return
+		}
+
+		return u.orderRepo.With(dr).Save(c, order)
+	})
+	if err != nil {
+		version = 0
+	}
+	return
+}
+
+func (u *ucase) ApproveOrderDelivery(ctx context.Context, in domain.ReviewOrderDelivery) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	_, delivery, err := u.getReviewTarget(c, in)
+	if err != nil {
+		return
+	}
+
+	delivery.Approve()
+	return u.orderDeliveryRepo.Save(c, delivery)
+}
+
+func (u *ucase) RejectOrderDelivery(ctx context.Context, in domain.ReviewOrderDelivery) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if !domain.IsValidOrderFeedbackItems(in.Feedbacks) {
+		err = domain.ErrInvalidFeedback
+		return
+	}
+
+	order, delivery, err := u.getReviewTarget(c, in)
+	if err != nil {
+		return
+	}
+
+	if order.IsEmptyEditCount() {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	state, err := u.orderStateRepo.GetByCode(c, domain.OrderStateCodeRequestEdit)
+	if err != nil {
+		return
+	}
+
+	if state == nil {
+		err = errors.New("orderStateRepo.GetByCode domain.OrderStateCodeRequestEdit not exists state")
+		return
+	}
+
+	// 반려는 수정 요청 1회를 소모하고, 다음 결과물은 다음 버전으로 전달됨
+	delivery.Reject()
+	order.UseEdit()
+	order.ChangeState(state.Id)
+
+	feedbacks := domain.CreateOrderFeedbackList(order.Id, order.EditCount, in.UserId, in.Feedbacks)
+	return u.orderDeliveryRepo.Transaction(c, func(dr domain.OrderDeliveryTxRepository) (err error) {
+		err = dr.Save(c, delivery)
+		if err != nil {
+			return
+		}
+
+		err = u.orderRepo.With(dr).Save(c, order)
+		if err != nil {
+			return
+		}
+
+		return u.orderFeedbackRepo.With(dr).SaveAll(c, feedbacks)
+	})
+}
+
+func (u *ucase) getReviewTarget(ctx context.Context, in domain.ReviewOrderDelivery) (order *domain.Order, delivery *domain.OrderDelivery, err error) {
+	g, gc := errgroup.WithContext(ctx)
+	g.Go(func() (err error) {
+		user, err := u.userRepo.GetById(gc, in.UserId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(user, domain.User.IsCustomer) {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		order, err = u.orderRepo.GetById(gc, in.OrderId)
+		if err != nil {
+			return
+		}
+
+		if order == nil || order.IsDone() {
+			err = domain.ErrItemNotFound
+			return
+		}
+
+		if order.Orderer != in.UserId {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		delivery, err = u.orderDeliveryRepo.GetByOrderIdAndVersion(gc, in.OrderId, in.Version)
+		if err != nil {
+			return
+		}
+
+		if delivery == nil {
+			err = domain.ErrItemNotFound
+			return
+		}
+
+		if !delivery.IsPending() {
+			err = domain.ErrItemAlreadyExist
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		order, delivery = nil, nil
+	}
+	return
+}
@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+const (
+	tag = "[ORDER_DELIVERY] "
+)
+
+func (u *ucase) AutoCompleteInactive(ctx context.Context) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	deliveries, err := u.orderDeliveryRepo.FetchAwaitingCompletion(c)
+	if err != nil {
+		return
+	}
+
+	if len(deliveries) == 0 {
+		return
+	}
+
+	done, err := u.orderStateRepo.GetByCode(c, domain.OrderStateCodeDone)
+	if err != nil {
+		return
+	}
+
+	if done == nil {
+		return errors.New("orderStateRepo.GetByCode domain.OrderStateCodeDone not exists state")
+	}
+
+	// 한 의뢰가 실패해도 나머지 의뢰는 계속 처리
+	now := time.Now()
+	for i := range deliveries {
+		dErr := u.autoComplete(ctx, &deliveries[i], done.Id, now)
+		if dErr != nil {
+			log.WithError(dErr).
+				WithField("orderId", deliveries[i].OrderId).
+				Error(tag, "autoCompleteInactive, autoComplete failed")
+		}
+	}
+	return
+}
+
+// autoComplete 알림을 보낸 적이 없으면 기한이 지났어도 먼저 알리고, 알림 뒤 ReminderDays 가 지나야 완료함
+func (u *ucase) autoComplete(ctx context.Context, delivery *domain.OrderDelivery, doneStateId uint8, now time.Time) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	order, err := u.orderRepo.GetById(c, delivery.OrderId)
+	if err != nil || order == nil || order.IsDone() {
+		return
+	}
+
+	// 불러온 뒤 고객이 수정을 요청했으면 다음 결과물을 기다림
+	state, err := u.orderStateRepo.GetById(c, order.State)
+	if err != nil || (state != nil && state.Code == domain.OrderStateCodeRequestEdit) {
+		return
+	}
+
+	since := delivery.LastActivityAt()
+	if order.StateSince().After(since) {
+		since = order.StateSince()
+	}
+
+	completeAt := delivery.AutoCompleteAt(since, config.AutoComplete.Days, config.AutoComplete.ReminderDays)
+	remindAt := completeAt.AddDate(0, 0, -config.AutoComplete.ReminderDays)
+	if now.Before(remindAt) {
+		return
+	}
+
+	if !delivery.IsReminded() {
+		delivery.Remind()
+		completeAt = delivery.AutoCompleteAt(since, config.AutoComplete.Days, config.AutoComplete.ReminderDays)
+		err = u.orderDeliveryRepo.Save(c, delivery)
+		if err != nil {
+			return
+		}
+
+		return u.notification.Notify(c, domain.Notification{
+			UserIds: []uuid.UUID{order.Orderer},
+			Title:   i18n.T(c, i18n.AutoCompleteReminderTitle),
+			Message: i18n.T(c, i18n.AutoCompleteReminderMessage,
+				order.Id, completeAt.Format(time.RFC3339)),
+		})
+	}
+
+	if now.Before(completeAt) {
+		return
+	}
+
+	// 검토 대기 중인 결과물은 고객 대신 승인하고 완료
+	if delivery.IsPending() {
+		delivery.Approve()
+	}
+	order.Done()
+	order.ChangeStateBy(doneStateId, domain.OrderStateActorSystem)
+
+	err = u.orderDeliveryRepo.Transaction(c, func(dr domain.OrderDeliveryTxRepository) (err error) {
+		err = dr.Save(c, delivery)
+		if err != nil {
+			return
+		}
+
+		return u.orderRepo.With(dr).Save(c, order)
+	})
+	if err != nil {
+		return
+	}
+
+	return u.notification.Notify(c, domain.Notification{
+		UserIds: []uuid.UUID{order.Orderer},
+		Title:   i18n.T(c, i18n.AutoCompleteTitle),
+		Message: i18n.T(c, i18n.AutoCompleteMessage,
+			order.Id, config.AutoComplete.Days),
+	})
+}
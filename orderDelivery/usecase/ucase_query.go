@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+func (u *ucase) FetchByOrderId(ctx context.Context, orderId uuid.UUID) (res []domain.OrderDeliveryInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	list, err := u.orderDeliveryRepo.FetchByOrderId(c, orderId)
+	if err != nil {
+		return
+	}
+
+	res = domainToOrderDeliveryInfoList(list)
+	return
+}
+
+func (u *ucase) FetchMyOrderDelivery(ctx context.Context, userId, orderId uuid.UUID) (res []domain.OrderDeliveryInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	order, err := u.orderRepo.GetById(c, orderId)
+	if err != nil {
+		return
+	}
+
+	if order == nil {
+		err = domain.ErrItemNotFound
+		return
+	}
+
+	if order.Orderer != userId {
+		err = domain.ErrNoPermission
+		return
+	}
+
+	list, err := u.orderDeliveryRepo.FetchByOrderId(c, orderId)
+	if err != nil {
+		return
+	}
+
+	res = domainToOrderDeliveryInfoList(list)
+	return
+}
+
+func domainToOrderDeliveryInfoList(list []domain.OrderDelivery) (res []domain.OrderDeliveryInfo) {
+	res = make([]domain.OrderDeliveryInfo, len(list))
+	for i := range list {
+		res[i] = list[i].Info()
+	}
+
+	return
+}
@@ -0,0 +1,152 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+const (
+	tag = "[ORDER-ESCALATION] "
+)
+
+func NewOrderEscalationController(useCase domain.OrderEscalationUseCase) *OrderEscalationController {
+	return &OrderEscalationController{useCase: useCase}
+}
+
+type OrderEscalationController struct {
+	useCase domain.OrderEscalationUseCase
+}
+
+func (c *OrderEscalationController) Bind(e *echo.Echo) {
+	//SUPER_ADMIN
+	e.GET("/order/escalation", c.fetchOrderEscalation,
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.POST("/order/escalation/:escalationId/resolve", echox.UserID(c.resolveOrderEscalation),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+}
+
+type FetchOrderEscalationRequest struct {
+	OnlyOpen bool `json:"-" query:"open" example:"true"`
+} // @name FetchOrderEscalationRequest
+
+type OrderEscalationResponse struct {
+	EscalationId uint64    `json:"escalationId" validate:"required" example:"1"`
+	OrderId      uuid.UUID `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Reason 사유
+	// * OVERDUE - 마감 초과
+	// * STUCK - 한 상태에 기준 시간 이상 머무름
+	// * LOW_RATING - 고객이 낮은 평점을 줌
+	Reason domain.OrderEscalationReason `json:"reason" validate:"required" example:"OVERDUE" enums:"OVERDUE,STUCK,LOW_RATING"`
+
+	// Since 위반 기준 시각, OVERDUE 는 마감 시각, STUCK 은 상태가 바뀐 시각, LOW_RATING 은 평가 시각
+	Since time.Time `json:"since" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+
+	StateId    uint8                 `json:"stateId" validate:"required" example:"2"`
+	StateCode  domain.OrderStateCode `json:"stateCode" validate:"required" example:"TAKE"`
+	Assignee   *uuid.UUID            `json:"assignee" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CreatedAt  time.Time             `json:"createdAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+	ResolvedAt *time.Time            `json:"resolvedAt" example:"2021-10-28T04:44:18+00:00"`
+	ResolvedBy *uuid.UUID            `json:"resolvedBy" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name OrderEscalationResponse
+
+type OrderEscalationListResponse []OrderEscalationResponse
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] SLA 위반 목록
+// @Description 마감 초과, 한 상태에 오래 머문 의뢰 목록을 가져오는 기능, 역할(role)이 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param open query boolean false "해결되지 않은 것만 보기"
+// @Success 200 {object} OrderEscalationListResponse true "SLA 위반 목록"
+// @Success 204 "SLA 위반 없음"
+// @Router /order/escalation [get]
+func (c *OrderEscalationController) fetchOrderEscalation(ctx echo.Context) error {
+	var req FetchOrderEscalationRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch order escalation, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	list, err := c.useCase.Fetch(ctx.Request().Context(), domain.FetchOrderEscalationOption{
+		OnlyOpen: req.OnlyOpen,
+	})
+	if err != nil {
+		log.WithError(err).Error(tag, "fetchOrderEscalation, unhandled error useCase.Fetch")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	res := make(OrderEscalationListResponse, len(list))
+	for i := range list {
+		src := list[i]
+		res[i] = OrderEscalationResponse{
+			EscalationId: src.EscalationId,
+			OrderId:      src.OrderId,
+			Reason:       src.Reason,
+			Since:        src.Since,
+			StateId:      src.StateId,
+			StateCode:    src.StateCode,
+			Assignee:     src.Assignee,
+			CreatedAt:    src.CreatedAt,
+			ResolvedAt:   src.ResolvedAt,
+			ResolvedBy:   src.ResolvedBy,
+		}
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] SLA 위반 해결
+// @Description SLA 위반을 확인하고 해결 처리하는 기능, 역할(role)이 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Param escalation_id path int true "SLA 위반 식별 번호"
+// @Success 204 "해결 처리 완료"
+// @Router /order/escalation/{escalation_id}/resolve [post]
+func (c *OrderEscalationController) resolveOrderEscalation(ctx echo.Context, userId uuid.UUID) error {
+	var req struct {
+		EscalationId uint64 `json:"-" param:"escalationId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "resolve order escalation, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.ResolveOrderEscalation(ctx.Request().Context(), domain.ResolveOrderEscalation{
+		UserId:       userId,
+		EscalationId: req.EscalationId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "already resolved escalation"})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "resolveOrderEscalation, unhandled error useCase.ResolveOrderEscalation")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
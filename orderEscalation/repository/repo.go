@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func NewOrderEscalationRepository(db *gorm.DB) domain.OrderEscalationRepository {
+	db.AutoMigrate(&domain.OrderEscalation{})
+	return &repo{db: db}
+}
+
+type repo struct {
+	db *gorm.DB
+}
+
+func (r *repo) Save(ctx context.Context, escalation *domain.OrderEscalation) error {
+	return gormx.Upsert(ctx, r.db, escalation)
+}
+
+func (r *repo) CreateIfNotExists(ctx context.Context, escalation *domain.OrderEscalation) (created bool, err error) {
+	res := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(escalation)
+	err = res.Error
+	created = err == nil && res.RowsAffected > 0
+	return
+}
+
+func (r *repo) GetById(ctx context.Context, id uint64) (res *domain.OrderEscalation, err error) {
+	var entity domain.OrderEscalation
+	err = r.db.WithContext(ctx).First(&entity, id).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) Fetch(ctx context.Context, option domain.FetchOrderEscalationOption) (list []domain.OrderEscalation, err error) {
+	db := r.db.WithContext(ctx).
+		Order("`created_at` desc")
+	if option.OnlyOpen {
+		db = db.Where("`resolved_at` IS NULL")
+	}
+
+	err = db.Find(&list).Error
+	return
+}
@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+func NewOrderEscalationUseCase(
+	orderEscalationRepo domain.OrderEscalationRepository,
+	orderRepo domain.OrderRepository,
+	orderStateRepo domain.OrderStateRepository,
+	userRepo domain.UserRepository,
+	notification domain.NotificationAdapter,
+	timeout time.Duration,
+) domain.OrderEscalationUseCase {
+	return &ucase{
+		orderEscalationRepo: orderEscalationRepo,
+		orderRepo:           orderRepo,
+		orderStateRepo:      orderStateRepo,
+		userRepo:            userRepo,
+		notification:        notification,
+		timeout:             timeout,
+	}
+}
+
+type ucase struct {
+	orderEscalationRepo domain.OrderEscalationRepository
+	orderRepo           domain.OrderRepository
+	orderStateRepo      domain.OrderStateRepository
+	userRepo            domain.UserRepository
+	notification        domain.NotificationAdapter
+	timeout             time.Duration
+}
+
+func (u *ucase) MonitorSLA(ctx context.Context) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	states, err := u.orderStateRepo.FetchFull(c)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	stateMap := make(map[uint8]domain.OrderState, len(states))
+	stuckBefore := make(map[uint8]time.Time)
+	for i := range states {
+		stateMap[states[i].Id] = states[i]
+		if threshold, ok := stateThreshold(states[i].Code); ok {
+			stuckBefore[states[i].Id] = now.Add(-threshold)
+		}
+	}
+
+	// 끝나지 않은 의뢰 전체가 아니라 마감이 지났거나 오래 머문 의뢰만 가져옴
+	orders, err := u.orderRepo.FetchSLABreached(c, domain.FetchSLABreachedOption{
+		StuckBefore: stuckBefore,
+	})
+	if err != nil {
+		return
+	}
+
+	var created []domain.OrderEscalation
+	for i := range orders {
+		order := orders[i]
+		state := stateMap[order.State]
+
+		var candidates []domain.OrderEscalation
+		if order.IsOverdue(now) {
+			candidates = append(candidates, domain.CreateOrderEscalation(domain.CreateOrderEscalationOption{
+				Order:  order,
+				State:  state,
+				Reason: domain.OrderEscalationReasonOverdue,
+				Since:  *order.Deadline(),
+			}))
+		}
+
+		threshold, ok := stateThreshold(state.Code)
+		if ok && now.Sub(order.StateSince()) > threshold {
+			candidates = append(candidates, domain.CreateOrderEscalation(domain.CreateOrderEscalationOption{
+				Order:  order,
+				State:  state,
+				Reason: domain.OrderEscalationReasonStuck,
+				Since:  order.StateSince(),
+			}))
+		}
+
+		for j := range candidates {
+			var ok bool
+			ok, err = u.orderEscalationRepo.CreateIfNotExists(c, &candidates[j])
+			if err != nil {
+				return
+			}
+
+			if ok {
+				created = append(created, candidates[j])
+			}
+		}
+	}
+
+	if len(created) == 0 {
+		return
+	}
+
+	return u.notifySuperAdmin(c, created)
+}
+
+func (u *ucase) Escalate(ctx context.Context, escalation domain.OrderEscalation) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	ok, err := u.orderEscalationRepo.CreateIfNotExists(c, &escalation)
+	if err != nil || !ok {
+		return
+	}
+
+	return u.notifySuperAdmin(c, []domain.OrderEscalation{escalation})
+}
+
+func (u *ucase) notifySuperAdmin(ctx context.Context, escalations []domain.OrderEscalation) (err error) {
+	admins, err := u.userRepo.FetchAllAdmin(ctx, domain.FetchAdminOption{})
+	if err != nil {
+		return
+	}
+
+	userIds := make([]uuid.UUID, 0, len(admins))
+	for i := range admins {
+		if admins[i].IsSuperAdmin() {
+			userIds = append(userIds, admins[i].Id)
+		}
+	}
+
+	if len(userIds) == 0 {
+		return
+	}
+
+	for i := range escalations {
+		escalation := escalations[i]
+		title := i18n.EscalationSLATitle
+		if escalation.Reason == domain.OrderEscalationReasonLowRating {
+			title = i18n.EscalationLowRatingTitle
+		}
+
+		err = u.notification.Notify(ctx, domain.Notification{
+			UserIds: userIds,
+			Title:   i18n.T(ctx, title),
+			Message: i18n.T(ctx, i18n.EscalationMessage,
+				escalation.OrderId, escalation.Reason, escalation.StateCode,
+				escalation.Since.Format(time.RFC3339)),
+		})
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func (u *ucase) ResolveOrderEscalation(ctx context.Context, in domain.ResolveOrderEscalation) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var escalation *domain.OrderEscalation
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		user, err := u.userRepo.GetById(gc, in.UserId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(user, domain.User.IsSuperAdmin) {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		escalation, err = u.orderEscalationRepo.GetById(gc, in.EscalationId)
+		if err != nil {
+			return
+		}
+
+		if escalation == nil {
+			err = domain.ErrItemNotFound
+			return
+		}
+
+		if escalation.IsResolved() {
+			err = domain.ErrItemAlreadyExist
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	escalation.Resolve(in.UserId)
+	return u.orderEscalationRepo.Save(c, escalation)
+}
+
+func (u *ucase) Fetch(ctx context.Context, option domain.FetchOrderEscalationOption) (res []domain.OrderEscalationInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	list, err := u.orderEscalationRepo.Fetch(c, option)
+	if err != nil {
+		return
+	}
+
+	res = make([]domain.OrderEscalationInfo, len(list))
+	for i := range list {
+		src := list[i]
+		res[i] = domain.OrderEscalationInfo{
+			EscalationId: src.Id,
+			OrderId:      src.OrderId,
+			Reason:       src.Reason,
+			Since:        src.Since,
+			StateId:      src.StateId,
+			StateCode:    src.StateCode,
+			Assignee:     src.Assignee,
+			CreatedAt:    src.CreatedAt,
+			ResolvedAt:   src.ResolvedAt,
+			ResolvedBy:   src.ResolvedBy,
+		}
+	}
+	return
+}
+
+// stateThreshold config.SLA 의 상태 코드별 허용 시간, 설정이 없는 상태는 검사하지 않음
+func stateThreshold(code domain.OrderStateCode) (threshold time.Duration, ok bool) {
+	hours, ok := config.SLA.StateThresholdHours[string(code)]
+	if !ok || hours <= 0 {
+		return 0, false
+	}
+
+	return time.Duration(hours) * time.Hour, true
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"gorm.io/gorm"
+)
+
+func NewOrderFeedbackRepository(db *gorm.DB) domain.OrderFeedbackRepository {
+	db.AutoMigrate(&domain.OrderFeedback{})
+	return &repo{db: db}
+}
+
+type repo struct {
+	db *gorm.DB
+}
+
+func (r *repo) Save(ctx context.Context, feedback *domain.OrderFeedback) error {
+	return gormx.Upsert(ctx, r.db, feedback)
+}
+
+func (r *repo) SaveAll(ctx context.Context, feedbacks []domain.OrderFeedback) error {
+	if len(feedbacks) == 0 {
+		return nil
+	}
+
+	return gormx.Upsert(ctx, r.db, &feedbacks)
+}
+
+func (r *repo) With(tx gormx.Tx) domain.OrderFeedbackTxRepository {
+	return &repo{db: tx.Get()}
+}
+
+func (r *repo) Get() *gorm.DB {
+	return r.db
+}
+
+func (r *repo) GetById(ctx context.Context, id uuid.UUID) (res *domain.OrderFeedback, err error) {
+	var entity domain.OrderFeedback
+	err = r.db.WithContext(ctx).First(&entity, "`id` = ?", id).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) FetchByOrderId(ctx context.Context, orderId uuid.UUID) (list []domain.OrderFeedback, err error) {
+	err = r.db.WithContext(ctx).
+		Order("`revision` asc").
+		Order("`created_at` asc").
+		Where("`order_id` = ?", orderId).
+		Find(&list).Error
+	return
+}
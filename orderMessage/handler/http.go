@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+const (
+	tag = "[ORDER-MESSAGE] "
+)
+
+func NewOrderMessageController(useCase domain.OrderMessageUseCase) *OrderMessageController {
+	return &OrderMessageController{useCase: useCase}
+}
+
+type OrderMessageController struct {
+	useCase domain.OrderMessageUseCase
+}
+
+func (c *OrderMessageController) Bind(e *echo.Echo) {
+	//CUSTOMER, ADMIN
+	// 고객은 자신의 의뢰, 어드민은 담당 의뢰만 가능
+	// 메시지 목록
+	e.GET("/order/:orderId/message", echox.UserID(c.fetchOrderMessage),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole, domain.SuperAdminUserRole, domain.AdminUserRole))
+	// 메시지 보내기
+	e.POST("/order/:orderId/message", echox.UserID(c.sendOrderMessage),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole, domain.SuperAdminUserRole, domain.AdminUserRole))
+	// 메시지 읽음 표시
+	e.POST("/order/:orderId/message/read", echox.UserID(c.readOrderMessage),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole, domain.SuperAdminUserRole, domain.AdminUserRole))
+}
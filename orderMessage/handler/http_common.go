@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+type OrderMessageAttachmentRequest struct {
+	Name string `json:"name" validate:"required,max=300" example:"참고영상.mp4"`
+	Link string `json:"link" validate:"required,url,max=2048" example:"https://onedrive.live.com/..."`
+} // @name OrderMessageAttachmentRequest
+
+type SendOrderMessageRequest struct {
+	OrderId     uuid.UUID                       `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Content     string                          `json:"content" validate:"required,max=4000" example:"인트로는 5초 이내로 부탁드려요"`
+	Attachments []OrderMessageAttachmentRequest `json:"attachments" validate:"max=10,dive"`
+} // @name SendOrderMessageRequest
+
+type SendOrderMessageResponse struct {
+	MessageId uuid.UUID `json:"messageId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name SendOrderMessageResponse
+
+// @Tags (Order) 메시지
+// @Security Auth-Jwt-Bearer
+// @Summary 의뢰 메시지 보내기
+// @Description 의뢰 메시지를 보내는 기능, 고객은 자신의 의뢰, 어드민은 담당 의뢰에만 보낼 수 있음
+// @Accept json
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param requestBody body SendOrderMessageRequest true "메시지 데이터 구조"
+// @Success 201 {object} SendOrderMessageResponse true "메시지 보내기 완료"
+// @Router /order/{order_id}/message [post]
+func (c *OrderMessageController) sendOrderMessage(ctx echo.Context, userId uuid.UUID) error {
+	var req SendOrderMessageRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "send order message, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	attachments := make([]domain.OrderMessageAttachmentInfo, len(req.Attachments))
+	for i := range req.Attachments {
+		attachments[i] = domain.OrderMessageAttachmentInfo{
+			Name: req.Attachments[i].Name,
+			Link: req.Attachments[i].Link,
+		}
+	}
+
+	var in = domain.SendOrderMessage{
+		UserId:      userId,
+		OrderId:     req.OrderId,
+		Content:     req.Content,
+		Attachments: attachments,
+	}
+	messageId, err := c.useCase.SendOrderMessage(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusCreated, SendOrderMessageResponse{MessageId: messageId})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("orderId", in.OrderId).
+			Error(tag, "sendOrderMessage, unhandled error useCase.SendOrderMessage")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type FetchOrderMessageRequest struct {
+	OrderId uuid.UUID `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Page    int       `json:"-" query:"page" validate:"omitempty,min=1" example:"1"`
+	Size    int       `json:"-" query:"size" validate:"omitempty,min=1,max=100" example:"30"`
+} // @name FetchOrderMessageRequest
+
+type OrderMessageAttachmentResponse struct {
+	Name string `json:"name" validate:"required" example:"참고영상.mp4"`
+	Link string `json:"link" validate:"required" example:"https://onedrive.live.com/..."`
+} // @name OrderMessageAttachmentResponse
+
+type OrderMessageResponse struct {
+	MessageId   uuid.UUID                        `json:"messageId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	SenderId    uuid.UUID                        `json:"senderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Content     string                           `json:"content" validate:"required" example:"인트로는 5초 이내로 부탁드려요"`
+	Attachments []OrderMessageAttachmentResponse `json:"attachments" validate:"required"`
+	CreatedAt   time.Time                        `json:"createdAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+} // @name OrderMessageResponse
+
+type OrderMessageReadResponse struct {
+	UserId     uuid.UUID `json:"userId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	LastReadAt time.Time `json:"lastReadAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+} // @name OrderMessageReadResponse
+
+type OrderMessagePageResponse struct {
+	// Messages 최신 메시지부터 정렬
+	Messages []OrderMessageResponse `json:"messages" validate:"required"`
+
+	// Reads 참여자별 마지막으로 읽은 시각, 이후 메시지는 해당 참여자가 읽지 않음
+	Reads []OrderMessageReadResponse `json:"reads" validate:"required"`
+
+	Total int64 `json:"total" validate:"required" example:"42"`
+	Page  int   `json:"page" validate:"required" example:"1"`
+	Size  int   `json:"size" validate:"required" example:"30"`
+} // @name OrderMessagePageResponse
+
+// @Tags (Order) 메시지
+// @Security Auth-Jwt-Bearer
+// @Summary 의뢰 메시지 목록
+// @Description 의뢰 메시지를 최신순으로 페이지 단위로 가져오는 기능, 고객은 자신의 의뢰, 어드민은 담당 의뢰만 볼 수 있음
+// @Accept json
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param page query int false "페이지 (1부터 시작)"
+// @Param size query int false "페이지 크기 (기본 30, 최대 100)"
+// @Success 200 {object} OrderMessagePageResponse true "메시지 목록"
+// @Router /order/{order_id}/message [get]
+func (c *OrderMessageController) fetchOrderMessage(ctx echo.Context, userId uuid.UUID) error {
+	var req FetchOrderMessageRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch order message, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	res, err := c.useCase.FetchOrderMessage(ctx.Request().Context(), domain.FetchOrderMessage{
+		UserId:  userId,
+		OrderId: req.OrderId,
+		Page:    req.Page,
+		Size:    req.Size,
+	})
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusOK, useCaseToOrderMessagePageResponse(res))
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("req", req).
+			Error(tag, "fetchOrderMessage, unhandled error useCase.FetchOrderMessage")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+// @Tags (Order) 메시지
+// @Security Auth-Jwt-Bearer
+// @Summary 의뢰 메시지 읽음 표시
+// @Description 지금까지의 의뢰 메시지를 모두 읽은 것으로 표시하는 기능
+// @Accept json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Success 204 "읽음 표시 완료"
+// @Router /order/{order_id}/message/read [post]
+func (c *OrderMessageController) readOrderMessage(ctx echo.Context, userId uuid.UUID) error {
+	var req struct {
+		OrderId uuid.UUID `json:"-" param:"orderId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "read order message, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.ReadOrderMessage(ctx.Request().Context(), domain.ReadOrderMessage{
+		UserId:  userId,
+		OrderId: req.OrderId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "readOrderMessage, unhandled error useCase.ReadOrderMessage")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+func useCaseToOrderMessagePageResponse(src domain.OrderMessagePageInfo) OrderMessagePageResponse {
+	messages := make([]OrderMessageResponse, len(src.Messages))
+	for i := range src.Messages {
+		message := src.Messages[i]
+		attachments := make([]OrderMessageAttachmentResponse, len(message.Attachments))
+		for j := range message.Attachments {
+			attachments[j] = OrderMessageAttachmentResponse{
+				Name: message.Attachments[j].Name,
+				Link: message.Attachments[j].Link,
+			}
+		}
+
+		messages[i] = OrderMessageResponse{
+			MessageId:   message.MessageId,
+			SenderId:    message.SenderId,
+			Content:     message.Content,
+			Attachments: attachments,
+			CreatedAt:   message.CreatedAt,
+		}
+	}
+
+	reads := make([]OrderMessageReadResponse, len(src.Reads))
+	for i := range src.Reads {
+		reads[i] = OrderMessageReadResponse{
+			UserId:     src.Reads[i].UserId,
+			LastReadAt: src.Reads[i].LastReadAt,
+		}
+	}
+
+	return OrderMessagePageResponse{
+		Messages: messages,
+		Reads:    reads,
+		Total:    src.Total,
+		Page:     src.Page,
+		Size:     src.Size,
+	}
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"gorm.io/gorm"
+)
+
+func NewOrderMessageRepository(db *gorm.DB) domain.OrderMessageRepository {
+	db.AutoMigrate(&domain.OrderMessage{}, &domain.OrderMessageAttachment{}, &domain.OrderMessageRead{})
+	return &repo{db: db}
+}
+
+type repo struct {
+	db *gorm.DB
+}
+
+func (r *repo) Save(ctx context.Context, message *domain.OrderMessage) error {
+	return gormx.Upsert(ctx, r.db, message)
+}
+
+func (r *repo) SaveRead(ctx context.Context, read *domain.OrderMessageRead) error {
+	return gormx.Upsert(ctx, r.db, read)
+}
+
+func (r *repo) Transaction(ctx context.Context, fn func(messageRepo domain.OrderMessageTxRepository) error, options ...*sql.TxOptions) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&repo{db: tx})
+	}, options...)
+}
+
+func (r *repo) With(tx gormx.Tx) domain.OrderMessageTxRepository {
+	return &repo{db: tx.Get()}
+}
+
+func (r *repo) Get() *gorm.DB {
+	return r.db
+}
+
+func (r *repo) Fetch(ctx context.Context, option domain.FetchOrderMessageOption) (list []domain.OrderMessage, err error) {
+	err = r.db.WithContext(ctx).
+		Preload("Attachments").
+		Order("`created_at` desc").
+		Where("`order_id` = ?", option.OrderId).
+		Offset(option.Offset).
+		Limit(option.Limit).
+		Find(&list).Error
+	return
+}
+
+func (r *repo) CountByOrderId(ctx context.Context, orderId uuid.UUID) (cnt int64, err error) {
+	err = r.db.WithContext(ctx).
+		Model(&domain.OrderMessage{}).
+		Where("`order_id` = ?", orderId).
+		Count(&cnt).Error
+	return
+}
+
+func (r *repo) FetchReadByOrderId(ctx context.Context, orderId uuid.UUID) (list []domain.OrderMessageRead, err error) {
+	err = r.db.WithContext(ctx).
+		Where("`order_id` = ?", orderId).
+		Find(&list).Error
+	return
+}
+
+func (r *repo) CountUnreadByOrderIds(ctx context.Context, orderIds []uuid.UUID, userId uuid.UUID) (res map[uuid.UUID]int64, err error) {
+	res = make(map[uuid.UUID]int64)
+	if len(orderIds) == 0 {
+		return
+	}
+
+	var rows []struct {
+		OrderId uuid.UUID
+		Cnt     int64
+	}
+	err = r.db.WithContext(ctx).
+		Table("`order_message` AS m").
+		Select("m.`order_id` AS `order_id`, COUNT(*) AS `cnt`").
+		Joins("LEFT JOIN `order_message_read` AS r ON r.`order_id` = m.`order_id` AND r.`user_id` = ?", userId).
+		Where("m.`order_id` IN ? AND m.`sender_id` <> ?", orderIds, userId).
+		Where("r.`last_read_at` IS NULL OR m.`created_at` > r.`last_read_at`").
+		Group("m.`order_id`").
+		Scan(&rows).Error
+	if err != nil {
+		return
+	}
+
+	for i := range rows {
+		res[rows[i].OrderId] = rows[i].Cnt
+	}
+	return
+}
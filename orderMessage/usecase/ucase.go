@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+const (
+	defaultPageSize = 30
+)
+
+func NewOrderMessageUseCase(
+	orderMessageRepo domain.OrderMessageRepository,
+	orderRepo domain.OrderRepository,
+	userRepo domain.UserRepository,
+	timeout time.Duration,
+) domain.OrderMessageUseCase {
+	return &ucase{
+		orderMessageRepo: orderMessageRepo,
+		orderRepo:        orderRepo,
+		userRepo:         userRepo,
+		timeout:          timeout,
+	}
+}
+
+type ucase struct {
+	orderMessageRepo domain.OrderMessageRepository
+	orderRepo        domain.OrderRepository
+	userRepo         domain.UserRepository
+	timeout          time.Duration
+}
+
+func (u *ucase) SendOrderMessage(ctx context.Context, in domain.SendOrderMessage) (newId uuid.UUID, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err = domain.CheckOrderParticipant(c, u.userRepo, u.orderRepo, in.UserId, in.OrderId)
+	if err != nil {
+		return
+	}
+
+	attachments := make([]domain.OrderMessageAttachment, len(in.Attachments))
+	for i := range in.Attachments {
+		attachments[i] = domain.OrderMessageAttachment{
+			Name: in.Attachments[i].Name,
+			Link: in.Attachments[i].Link,
+		}
+	}
+
+	message := domain.CreateOrderMessage(domain.CreateOrderMessageOption{
+		OrderId:     in.OrderId,
+		SenderId:    in.UserId,
+		Content:     in.Content,
+		Attachments: attachments,
+	})
+
+	err = u.orderMessageRepo.Transaction(c, func(mr domain.OrderMessageTxRepository) (err error) {
+		err = mr.Save(c, &message)
+		if err != nil {
+			return
+		}
+
+		// 보낸 사람은 자신의 메시지까지 읽은 것으로 처리
+		return mr.SaveRead(c, &domain.OrderMessageRead{
+			OrderId:    in.OrderId,
+			UserId:     in.UserId,
+			LastReadAt: message.CreatedAt,
+		})
+	})
+	if err != nil {
+		return
+	}
+
+	newId = message.Id
+	return
+}
+
+func (u *ucase) ReadOrderMessage(ctx context.Context, in domain.ReadOrderMessage) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err = domain.CheckOrderParticipant(c, u.userRepo, u.orderRepo, in.UserId, in.OrderId)
+	if err != nil {
+		return
+	}
+
+	return u.orderMessageRepo.SaveRead(c, &domain.OrderMessageRead{
+		OrderId:    in.OrderId,
+		UserId:     in.UserId,
+		LastReadAt: time.Now(),
+	})
+}
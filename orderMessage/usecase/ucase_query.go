@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+func (u *ucase) FetchOrderMessage(ctx context.Context, in domain.FetchOrderMessage) (res domain.OrderMessagePageInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err = domain.CheckOrderParticipant(c, u.userRepo, u.orderRepo, in.UserId, in.OrderId)
+	if err != nil {
+		return
+	}
+
+	if in.Page < 1 {
+		in.Page = 1
+	}
+
+	if in.Size < 1 {
+		in.Size = defaultPageSize
+	}
+
+	res.Page = in.Page
+	res.Size = in.Size
+
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		list, err := u.orderMessageRepo.Fetch(gc, domain.FetchOrderMessageOption{
+			OrderId: in.OrderId,
+			Offset:  (in.Page - 1) * in.Size,
+			Limit:   in.Size,
+		})
+		if err != nil {
+			return err
+		}
+
+		res.Messages = make([]domain.OrderMessageInfo, len(list))
+		for i := range list {
+			res.Messages[i] = domainToOrderMessageInfo(list[i])
+		}
+		return nil
+	})
+	g.Go(func() (err error) {
+		res.Total, err = u.orderMessageRepo.CountByOrderId(gc, in.OrderId)
+		return
+	})
+	g.Go(func() error {
+		list, err := u.orderMessageRepo.FetchReadByOrderId(gc, in.OrderId)
+		if err != nil {
+			return err
+		}
+
+		res.Reads = make([]domain.OrderMessageReadInfo, len(list))
+		for i := range list {
+			res.Reads[i] = domain.OrderMessageReadInfo{
+				UserId:     list[i].UserId,
+				LastReadAt: list[i].LastReadAt,
+			}
+		}
+		return nil
+	})
+	err = g.Wait()
+	if err != nil {
+		res = domain.OrderMessagePageInfo{}
+	}
+
+	return
+}
+
+func domainToOrderMessageInfo(src domain.OrderMessage) domain.OrderMessageInfo {
+	attachments := make([]domain.OrderMessageAttachmentInfo, len(src.Attachments))
+	for i := range src.Attachments {
+		attachments[i] = domain.OrderMessageAttachmentInfo{
+			Name: src.Attachments[i].Name,
+			Link: src.Attachments[i].Link,
+		}
+	}
+
+	return domain.OrderMessageInfo{
+		MessageId:   src.Id,
+		SenderId:    src.SenderId,
+		Content:     src.Content,
+		Attachments: attachments,
+		CreatedAt:   src.CreatedAt,
+	}
+}
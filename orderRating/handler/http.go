@@ -0,0 +1,292 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+const (
+	tag = "[ORDER_RATING] "
+)
+
+func NewOrderRatingController(useCase domain.OrderRatingUseCase) *OrderRatingController {
+	return &OrderRatingController{useCase: useCase}
+}
+
+type OrderRatingController struct {
+	useCase domain.OrderRatingUseCase
+}
+
+func (c *OrderRatingController) Bind(e *echo.Echo) {
+	//CUSTOMER
+	e.POST("/customer/me/orders/:orderId/rating", echox.UserID(c.rateOrder),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	e.GET("/customer/me/orders/:orderId/rating", echox.UserID(c.getMyOrderRating),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+
+	//ADMIN, SUPER_ADMIN
+	e.GET("/order-rating", echox.UserID(c.fetchOrderRating),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.GET("/admin/rating-score", echox.UserID(c.fetchEditorScore),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+}
+
+type RateOrderRequest struct {
+	OrderId uuid.UUID `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Score 별점 1 ~ 5
+	Score uint8 `json:"score" validate:"required,min=1,max=5" example:"5"`
+
+	// Tags 좋았던(또는 아쉬웠던) 점
+	// * QUALITY - 결과물 품질
+	// * SPEED - 작업 속도
+	// * COMMUNICATION - 소통
+	// * CREATIVITY - 창의성
+	// * REVISION - 수정 요청 반영
+	Tags []domain.OrderRatingTag `json:"tags" validate:"max=5,dive,oneof=QUALITY SPEED COMMUNICATION CREATIVITY REVISION" example:"QUALITY,SPEED"`
+
+	Comment *string `json:"comment" validate:"omitempty,max=1000" example:"자막이 깔끔해서 좋았어요"`
+} // @name RateOrderRequest
+
+// @Tags (Order Rating) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 완료된 의뢰 평가
+// @Description 완료된 의뢰에 별점, 태그, 코멘트를 남기는 기능, 완료 후 정해진 기간 안에 한 번만 가능, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param requestBody body RateOrderRequest true "평가 데이터 구조"
+// @Success 201 "평가 완료"
+// @Failure 403 {object} domain.ErrorResponse "완료되지 않았거나 평가 기간이 지남"
+// @Failure 409 {object} domain.ErrorResponse "이미 평가함"
+// @Router /customer/me/orders/{order_id}/rating [post]
+func (c *OrderRatingController) rateOrder(ctx echo.Context, userId uuid.UUID) error {
+	var req RateOrderRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "rate order, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.RateOrder(ctx.Request().Context(), domain.RateOrder{
+		UserId:  userId,
+		OrderId: req.OrderId,
+		Score:   req.Score,
+		Tags:    req.Tags,
+		Comment: req.Comment,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusCreated)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrRatingClosed:
+		return ctx.JSON(http.StatusForbidden, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "already rated"})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", req).
+			Error(tag, "rateOrder, unhandled error useCase.RateOrder")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type OrderRatingResponse struct {
+	OrderId  uuid.UUID  `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Orderer  uuid.UUID  `json:"orderer" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Assignee *uuid.UUID `json:"assignee" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Score 별점 1 ~ 5
+	Score     uint8                   `json:"score" validate:"required" example:"5"`
+	Tags      []domain.OrderRatingTag `json:"tags" validate:"required" example:"QUALITY,SPEED"`
+	Comment   *string                 `json:"comment" example:"자막이 깔끔해서 좋았어요"`
+	CreatedAt time.Time               `json:"createdAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+} // @name OrderRatingResponse
+
+func useCaseToOrderRatingResponse(src domain.OrderRatingInfo) OrderRatingResponse {
+	tags := src.Tags
+	if tags == nil {
+		tags = []domain.OrderRatingTag{}
+	}
+
+	return OrderRatingResponse{
+		OrderId:   src.OrderId,
+		Orderer:   src.Orderer,
+		Assignee:  src.Assignee,
+		Score:     src.Score,
+		Tags:      tags,
+		Comment:   src.Comment,
+		CreatedAt: src.CreatedAt,
+	}
+}
+
+type MyOrderRatingRequest struct {
+	OrderId uuid.UUID `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name MyOrderRatingRequest
+
+// @Tags (Order Rating) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 내 의뢰 평가 보기
+// @Description 자신이 남긴 의뢰 평가를 가져오는 기능, 역할(role)이 'CUSTOMER' 이여야함
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Success 200 {object} OrderRatingResponse true "평가"
+// @Failure 404 {object} domain.ErrorResponse "의뢰가 없거나 아직 평가하지 않음"
+// @Router /customer/me/orders/{order_id}/rating [get]
+func (c *OrderRatingController) getMyOrderRating(ctx echo.Context, userId uuid.UUID) error {
+	var req MyOrderRatingRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "get my order rating, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	res, err := c.useCase.GetMyOrderRating(ctx.Request().Context(), domain.GetMyOrderRating{
+		UserId:  userId,
+		OrderId: req.OrderId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusOK, useCaseToOrderRatingResponse(res))
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "getMyOrderRating, unhandled error useCase.GetMyOrderRating")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type FetchOrderRatingRequest struct {
+	// EditorId 이 편집자가 맡은 의뢰의 평가만
+	EditorId *uuid.UUID `json:"-" query:"editorId" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// MaxScore 이 점수 이하만
+	MaxScore *uint8 `json:"-" query:"maxScore" validate:"omitempty,min=1,max=5" example:"2"`
+} // @name FetchOrderRatingRequest
+
+// @Tags (Order Rating) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 의뢰 평가 목록
+// @Description 고객이 남긴 의뢰 평가 목록, 최신 순, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Produce json
+// @Param editorId query string false "편집자 아이디"
+// @Param maxScore query int false "이 점수 이하만"
+// @Success 200 {array} OrderRatingResponse true "평가 목록"
+// @Success 204 "평가 없음"
+// @Router /order-rating [get]
+func (c *OrderRatingController) fetchOrderRating(ctx echo.Context, userId uuid.UUID) error {
+	var req FetchOrderRatingRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch order rating, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	list, err := c.useCase.Fetch(ctx.Request().Context(), domain.FetchOrderRating{
+		UserId:   userId,
+		Assignee: req.EditorId,
+		MaxScore: req.MaxScore,
+	})
+
+	switch err {
+	case nil:
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "fetchOrderRating, unhandled error useCase.Fetch")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	res := make([]OrderRatingResponse, len(list))
+	for i := range list {
+		res[i] = useCaseToOrderRatingResponse(list[i])
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+type EditorScoreResponse struct {
+	EditorId uuid.UUID `json:"editorId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Name     string    `json:"name" validate:"required" example:"(대충 편집자 이름)"`
+	Nickname string    `json:"nickname" validate:"required" example:"(대충 편집자 닉네임)"`
+
+	// Average 전체 평균 별점
+	Average float64 `json:"average" validate:"required" example:"4.3"`
+	Count   int64   `json:"count" validate:"required" example:"42"`
+
+	// RecentAverage 최근 기간 평균, 평가가 없으면 0
+	RecentAverage float64 `json:"recentAverage" example:"4.5"`
+	RecentCount   int64   `json:"recentCount" example:"10"`
+
+	// PreviousAverage 최근 기간 바로 전 같은 길이 기간의 평균, 평가가 없으면 0
+	PreviousAverage float64 `json:"previousAverage" example:"4.1"`
+	PreviousCount   int64   `json:"previousCount" example:"8"`
+
+	// Trend 최근 평균 - 이전 평균, 두 기간 중 하나라도 평가가 없으면 0
+	Trend float64 `json:"trend" example:"0.4"`
+} // @name EditorScoreResponse
+
+// @Tags (Order Rating) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 편집자 평점
+// @Description 편집자별 평균 별점, 평가 수, 최근 추세, 평균이 낮은 순, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Produce json
+// @Success 200 {array} EditorScoreResponse true "편집자 평점 목록"
+// @Success 204 "평가 없음"
+// @Router /admin/rating-score [get]
+func (c *OrderRatingController) fetchEditorScore(ctx echo.Context, userId uuid.UUID) error {
+	list, err := c.useCase.FetchEditorScore(ctx.Request().Context(), userId)
+
+	switch err {
+	case nil:
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "fetchEditorScore, unhandled error useCase.FetchEditorScore")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	res := make([]EditorScoreResponse, len(list))
+	for i := range list {
+		src := list[i]
+		res[i] = EditorScoreResponse{
+			EditorId:        src.EditorId,
+			Name:            src.Name,
+			Nickname:        src.Nickname,
+			Average:         src.Average,
+			Count:           src.Count,
+			RecentAverage:   src.RecentAverage,
+			RecentCount:     src.RecentCount,
+			PreviousAverage: src.PreviousAverage,
+			PreviousCount:   src.PreviousCount,
+			Trend:           src.Trend,
+		}
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"gorm.io/gorm"
+)
+
+func NewOrderRatingRepository(db *gorm.DB) domain.OrderRatingRepository {
+	db.AutoMigrate(&domain.OrderRating{})
+	return &repo{db: db}
+}
+
+type repo struct {
+	db *gorm.DB
+}
+
+func (r *repo) Create(ctx context.Context, rating *domain.OrderRating) error {
+	err := r.db.WithContext(ctx).Create(rating).Error
+	if gormx.IsDuplicateEntry(err) {
+		return domain.ErrItemAlreadyExist
+	}
+	return err
+}
+
+func (r *repo) GetByOrderId(ctx context.Context, orderId uuid.UUID) (res *domain.OrderRating, err error) {
+	var entity domain.OrderRating
+	err = r.db.WithContext(ctx).
+		Where("`order_id` = ?", orderId).
+		First(&entity).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) Fetch(ctx context.Context, option domain.FetchOrderRatingOption) (list []domain.OrderRating, err error) {
+	db := r.db.WithContext(ctx).
+		Order("`created_at` desc")
+	if option.Assignee != nil {
+		db = db.Where("`assignee` = ?", option.Assignee)
+	}
+	if option.MaxScore != nil {
+		db = db.Where("`score` <= ?", option.MaxScore)
+	}
+
+	err = db.Find(&list).Error
+	return
+}
+
+func (r *repo) FetchEditorScore(ctx context.Context, option domain.FetchEditorScoreOption) (list []domain.EditorScore, err error) {
+	previousFrom := option.RecentFrom.Add(-option.Now.Sub(option.RecentFrom))
+	err = r.db.WithContext(ctx).
+		Model(&domain.OrderRating{}).
+		Select("`assignee` AS `manager_id`, "+
+			"AVG(`score`) AS `average`, "+
+			"COUNT(*) AS `count`, "+
+			"COALESCE(AVG(CASE WHEN `created_at` >= ? THEN `score` END), 0) AS `recent_average`, "+
+			"COUNT(CASE WHEN `created_at` >= ? THEN 1 END) AS `recent_count`, "+
+			"COALESCE(AVG(CASE WHEN `created_at` >= ? AND `created_at` < ? THEN `score` END), 0) AS `previous_average`, "+
+			"COUNT(CASE WHEN `created_at` >= ? AND `created_at` < ? THEN 1 END) AS `previous_count`",
+			option.RecentFrom, option.RecentFrom,
+			previousFrom, option.RecentFrom, previousFrom, option.RecentFrom).
+		Where("`assignee` IS NOT NULL").
+		Group("`assignee`").
+		Scan(&list).Error
+	return
+}
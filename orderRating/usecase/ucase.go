@@ -0,0 +1,235 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+const (
+	tag = "[ORDER_RATING] "
+)
+
+func NewOrderRatingUseCase(
+	orderRatingRepo domain.OrderRatingRepository,
+	orderRepo domain.OrderRepository,
+	orderStateRepo domain.OrderStateRepository,
+	userRepo domain.UserRepository,
+	managerRepo domain.ManagerRepository,
+	orderEscalationUseCase domain.OrderEscalationUseCase,
+	timeout time.Duration,
+) domain.OrderRatingUseCase {
+	return &ucase{
+		orderRatingRepo:        orderRatingRepo,
+		orderRepo:              orderRepo,
+		orderStateRepo:         orderStateRepo,
+		userRepo:               userRepo,
+		managerRepo:            managerRepo,
+		orderEscalationUseCase: orderEscalationUseCase,
+		timeout:                timeout,
+	}
+}
+
+type ucase struct {
+	orderRatingRepo        domain.OrderRatingRepository
+	orderRepo              domain.OrderRepository
+	orderStateRepo         domain.OrderStateRepository
+	userRepo               domain.UserRepository
+	managerRepo            domain.ManagerRepository
+	orderEscalationUseCase domain.OrderEscalationUseCase
+	timeout                time.Duration
+}
+
+func (u *ucase) RateOrder(ctx context.Context, in domain.RateOrder) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err = domain.CheckUserRole(c, u.userRepo, in.UserId, domain.User.IsCustomer)
+	if err != nil {
+		return
+	}
+
+	order, err := domain.GetCustomerOrder(c, u.orderRepo, in.UserId, in.OrderId)
+	if err != nil {
+		return
+	}
+
+	window := time.Duration(config.Rating.WindowDays) * 24 * time.Hour
+	if !order.CanRate(time.Now(), window) {
+		err = domain.ErrRatingClosed
+		return
+	}
+
+	rating := domain.CreateOrderRating(domain.CreateOrderRatingOption{
+		Order:   *order,
+		Score:   in.Score,
+		Tags:    in.Tags,
+		Comment: in.Comment,
+	})
+	err = u.orderRatingRepo.Create(c, &rating)
+	if err != nil {
+		return
+	}
+
+	if !rating.IsLow(config.Rating.LowScore) {
+		return
+	}
+
+	// 평가는 저장됐으므로 알림 실패는 기록만 함
+	if eErr := u.escalateLowRating(c, order, &rating); eErr != nil {
+		log.WithError(eErr).
+			WithField("orderId", order.Id).
+			Error(tag, "rateOrder, escalate low rating failed")
+	}
+	return
+}
+
+func (u *ucase) escalateLowRating(ctx context.Context, order *domain.Order, rating *domain.OrderRating) (err error) {
+	state, err := u.orderStateRepo.GetById(ctx, order.State)
+	if err != nil {
+		return
+	}
+
+	if state == nil {
+		state = &domain.OrderState{Id: order.State, Code: domain.OrderStateCodeNone}
+	}
+
+	return u.orderEscalationUseCase.Escalate(ctx, domain.CreateOrderEscalation(domain.CreateOrderEscalationOption{
+		Order:  *order,
+		State:  *state,
+		Reason: domain.OrderEscalationReasonLowRating,
+		Since:  rating.CreatedAt,
+	}))
+}
+
+func (u *ucase) GetMyOrderRating(ctx context.Context, in domain.GetMyOrderRating) (res domain.OrderRatingInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err = domain.CheckUserRole(c, u.userRepo, in.UserId, domain.User.IsCustomer)
+	if err != nil {
+		return
+	}
+
+	order, err := domain.GetCustomerOrder(c, u.orderRepo, in.UserId, in.OrderId)
+	if err != nil {
+		return
+	}
+
+	rating, err := u.orderRatingRepo.GetByOrderId(c, order.Id)
+	if err != nil {
+		return
+	}
+
+	if rating == nil {
+		err = domain.ErrItemNotFound
+		return
+	}
+
+	res = domainToOrderRatingInfo(rating)
+	return
+}
+
+func (u *ucase) Fetch(ctx context.Context, in domain.FetchOrderRating) (res []domain.OrderRatingInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err = domain.CheckUserRole(c, u.userRepo, in.UserId, domain.User.IsAdmin, domain.User.IsSuperAdmin)
+	if err != nil {
+		return
+	}
+
+	list, err := u.orderRatingRepo.Fetch(c, domain.FetchOrderRatingOption{
+		Assignee: in.Assignee,
+		MaxScore: in.MaxScore,
+	})
+	if err != nil {
+		return
+	}
+
+	res = make([]domain.OrderRatingInfo, len(list))
+	for i := range list {
+		res[i] = domainToOrderRatingInfo(&list[i])
+	}
+	return
+}
+
+func (u *ucase) FetchEditorScore(ctx context.Context, userId uuid.UUID) (res []domain.EditorScoreInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err = domain.CheckUserRole(c, u.userRepo, userId, domain.User.IsAdmin, domain.User.IsSuperAdmin)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	scores, err := u.orderRatingRepo.FetchEditorScore(c, domain.FetchEditorScoreOption{
+		RecentFrom: now.AddDate(0, 0, -config.Rating.TrendDays),
+		Now:        now,
+	})
+	if err != nil {
+		return
+	}
+
+	managerIds := make([]uuid.UUID, len(scores))
+	for i := range scores {
+		managerIds[i] = scores[i].ManagerId
+	}
+
+	managers, err := u.managerRepo.FetchByIds(c, managerIds)
+	if err != nil {
+		return
+	}
+
+	managerMap := make(map[uuid.UUID]*domain.Manager, len(managers))
+	for i := range managers {
+		managerMap[managers[i].Id] = &managers[i]
+	}
+
+	res = make([]domain.EditorScoreInfo, len(scores))
+	for i := range scores {
+		src := &scores[i]
+		res[i] = domain.EditorScoreInfo{
+			EditorId:        src.ManagerId,
+			Name:            i18n.T(ctx, i18n.Unknown),
+			Nickname:        i18n.T(ctx, i18n.Unknown),
+			Average:         src.Average,
+			Count:           src.Count,
+			RecentAverage:   src.RecentAverage,
+			RecentCount:     src.RecentCount,
+			PreviousAverage: src.PreviousAverage,
+			PreviousCount:   src.PreviousCount,
+			Trend:           src.Trend(),
+		}
+
+		if manager, ok := managerMap[src.ManagerId]; ok {
+			res[i].Name = manager.Name
+			res[i].Nickname = manager.Nickname
+		}
+	}
+
+	// 평균이 낮은 편집자부터
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Average < res[j].Average
+	})
+	return
+}
+
+func domainToOrderRatingInfo(src *domain.OrderRating) domain.OrderRatingInfo {
+	return domain.OrderRatingInfo{
+		OrderId:   src.OrderId,
+		Orderer:   src.Orderer,
+		Assignee:  src.Assignee,
+		Score:     src.Score,
+		Tags:      src.TagList(),
+		Comment:   src.Comment,
+		CreatedAt: src.CreatedAt,
+	}
+}
@@ -0,0 +1,529 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+const (
+	tag = "[ORDER_SCHEDULE] "
+)
+
+func NewOrderScheduleController(useCase domain.OrderScheduleUseCase) *OrderScheduleController {
+	return &OrderScheduleController{useCase: useCase}
+}
+
+type OrderScheduleController struct {
+	useCase domain.OrderScheduleUseCase
+}
+
+func (c *OrderScheduleController) Bind(e *echo.Echo) {
+	//CUSTOMER
+	e.POST("/customer/me/order-schedules", echox.UserID(c.addMyOrderSchedule),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	e.GET("/customer/me/order-schedules", echox.UserID(c.fetchMyOrderSchedule),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	e.PUT("/customer/me/order-schedules/:scheduleId", echox.UserID(c.updateOrderSchedule),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	e.DELETE("/customer/me/order-schedules/:scheduleId", echox.UserID(c.deleteOrderSchedule),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	e.GET("/customer/me/order-drafts", echox.UserID(c.fetchMyOrderDraft),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	e.POST("/customer/me/order-drafts/:draftId/confirm", echox.UserID(c.confirmOrderDraft),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	e.DELETE("/customer/me/order-drafts/:draftId", echox.UserID(c.dismissOrderDraft),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+
+	//ADMIN, SUPER_ADMIN
+	e.POST("/order-schedule", echox.UserID(c.addOrderSchedule),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.GET("/order-schedule", echox.UserID(c.fetchOrderSchedule),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.PUT("/order-schedule/:scheduleId", echox.UserID(c.updateOrderSchedule),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+	e.DELETE("/order-schedule/:scheduleId", echox.UserID(c.deleteOrderSchedule),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+}
+
+type AddMyOrderScheduleRequest struct {
+	// Weekdays 의뢰 요일, 0: 일요일 ~ 6: 토요일
+	Weekdays []uint8 `json:"weekdays" validate:"required,min=1,max=7,dive,max=6" example:"2,5"`
+
+	// Minute 의뢰 시각, 0시부터 분 단위 (한국 시간)
+	Minute uint16 `json:"minute" validate:"max=1439" example:"600"`
+
+	// TypeId 의뢰 종류
+	TypeId uint8 `json:"typeId" validate:"required" example:"1"`
+
+	// Priority 우선순위, 비어있으면 NORMAL
+	Priority domain.OrderPriority `json:"priority" validate:"omitempty,oneof=NORMAL HIGH RUSH" example:"NORMAL"`
+
+	// Requirement 매번 의뢰 초안에 들어갈 기본 요구사항
+	Requirement *string `json:"requirement" validate:"omitempty,max=2000" example:"(대충 매주 같은 형식의 브이로그 편집 요구사항)"`
+} // @name AddMyOrderScheduleRequest
+
+type AddOrderScheduleRequest struct {
+	// CustomerId 일정을 만들어 줄 고객
+	CustomerId uuid.UUID `json:"customerId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	AddMyOrderScheduleRequest
+} // @name AddOrderScheduleRequest
+
+type AddOrderScheduleResponse struct {
+	ScheduleId uuid.UUID `json:"scheduleId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name AddOrderScheduleResponse
+
+// @Tags (Order Schedule) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 정기 의뢰 일정 추가
+// @Description 정해진 요일, 시각마다 의뢰 초안을 만들고 업로드 알림을 보내는 일정을 추가, 의뢰 횟수는 초안을 확인할 때 차감됨, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Produce json
+// @Param requestBody body AddMyOrderScheduleRequest true "일정 데이터 구조"
+// @Success 201 {object} AddOrderScheduleResponse true "추가 완료"
+// @Failure 403 {object} domain.ErrorResponse "사용 중인 구독이 없거나 플랜에서 허용하지 않는 우선순위"
+// @Router /customer/me/order-schedules [post]
+func (c *OrderScheduleController) addMyOrderSchedule(ctx echo.Context, userId uuid.UUID) error {
+	var req AddMyOrderScheduleRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "add my order schedule, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	return c.internalAddOrderSchedule(ctx, userId, nil, req)
+}
+
+// @Tags (Order Schedule) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 고객 정기 의뢰 일정 추가
+// @Description 고객 대신 정기 의뢰 일정을 추가, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param requestBody body AddOrderScheduleRequest true "일정 데이터 구조"
+// @Success 201 {object} AddOrderScheduleResponse true "추가 완료"
+// @Failure 403 {object} domain.ErrorResponse "사용 중인 구독이 없거나 플랜에서 허용하지 않는 우선순위"
+// @Router /order-schedule [post]
+func (c *OrderScheduleController) addOrderSchedule(ctx echo.Context, userId uuid.UUID) error {
+	var req AddOrderScheduleRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "add order schedule, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	return c.internalAddOrderSchedule(ctx, userId, &req.CustomerId, req.AddMyOrderScheduleRequest)
+}
+
+func (c *OrderScheduleController) internalAddOrderSchedule(ctx echo.Context, userId uuid.UUID, customerId *uuid.UUID, req AddMyOrderScheduleRequest) error {
+	newId, err := c.useCase.AddOrderSchedule(ctx.Request().Context(), domain.AddOrderSchedule{
+		UserId:      userId,
+		CustomerId:  customerId,
+		Weekdays:    req.Weekdays,
+		Minute:      req.Minute,
+		TypeId:      req.TypeId,
+		Priority:    req.Priority,
+		Requirement: req.Requirement,
+	})
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusCreated, AddOrderScheduleResponse{ScheduleId: newId})
+	case domain.ErrWeirdData, domain.ErrUserNotCustomer:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: "order type not found"})
+	case domain.ErrNoActiveTicket, domain.ErrPriorityNotAllowed:
+		return ctx.JSON(http.StatusForbidden, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", req).
+			Error(tag, "addOrderSchedule, unhandled error useCase.AddOrderSchedule")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type OrderScheduleResponse struct {
+	ScheduleId uuid.UUID `json:"scheduleId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CustomerId uuid.UUID `json:"customerId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Weekdays 의뢰 요일, 0: 일요일 ~ 6: 토요일
+	Weekdays []uint8 `json:"weekdays" validate:"required" example:"2,5"`
+
+	// Minute 의뢰 시각, 0시부터 분 단위 (한국 시간)
+	Minute      uint16               `json:"minute" validate:"required" example:"600"`
+	TypeId      uint8                `json:"typeId" validate:"required" example:"1"`
+	Priority    domain.OrderPriority `json:"priority" validate:"required" example:"NORMAL"`
+	Requirement *string              `json:"requirement" example:"(대충 매주 같은 형식의 브이로그 편집 요구사항)"`
+
+	// NextRunAt 다음 의뢰 초안이 만들어질 시각
+	NextRunAt time.Time  `json:"nextRunAt" validate:"required" example:"2021-11-02T01:00:00+00:00"`
+	PausedAt  *time.Time `json:"pausedAt" example:"2021-11-02T01:00:00+00:00"`
+
+	// PauseReason 멈춘 이유
+	// * USER - 고객이나 관리자가 멈춤
+	// * TICKET_ENDED - 구독이 끝나서 자동으로 멈춤
+	PauseReason *domain.OrderSchedulePauseReason `json:"pauseReason" enums:"USER,TICKET_ENDED" example:"TICKET_ENDED"`
+	CreatedBy   uuid.UUID                        `json:"createdBy" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	CreatedAt   time.Time                        `json:"createdAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+} // @name OrderScheduleResponse
+
+func useCaseToOrderScheduleResponse(src domain.OrderScheduleInfo) OrderScheduleResponse {
+	weekdays := src.Weekdays
+	if weekdays == nil {
+		weekdays = []uint8{}
+	}
+
+	return OrderScheduleResponse{
+		ScheduleId:  src.Id,
+		CustomerId:  src.Owner,
+		Weekdays:    weekdays,
+		Minute:      src.Minute,
+		TypeId:      src.TypeId,
+		Priority:    src.Priority,
+		Requirement: src.Requirement,
+		NextRunAt:   src.NextRunAt,
+		PausedAt:    src.PausedAt,
+		PauseReason: src.PauseReason,
+		CreatedBy:   src.CreatedBy,
+		CreatedAt:   src.CreatedAt,
+	}
+}
+
+// @Tags (Order Schedule) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 내 정기 의뢰 일정 목록
+// @Description 자신의 정기 의뢰 일정 목록, 만든 순, 역할(role)이 'CUSTOMER' 이여야함
+// @Produce json
+// @Success 200 {array} OrderScheduleResponse true "일정 목록"
+// @Success 204 "일정 없음"
+// @Router /customer/me/order-schedules [get]
+func (c *OrderScheduleController) fetchMyOrderSchedule(ctx echo.Context, userId uuid.UUID) error {
+	return c.internalFetchOrderSchedule(ctx, userId, nil)
+}
+
+type FetchOrderScheduleRequest struct {
+	// CustomerId 이 고객의 일정만, 없으면 모든 고객
+	CustomerId *uuid.UUID `json:"-" query:"customerId" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name FetchOrderScheduleRequest
+
+// @Tags (Order Schedule) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 정기 의뢰 일정 목록
+// @Description 고객들의 정기 의뢰 일정 목록, 만든 순, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Produce json
+// @Param customerId query string false "고객 아이디"
+// @Success 200 {array} OrderScheduleResponse true "일정 목록"
+// @Success 204 "일정 없음"
+// @Router /order-schedule [get]
+func (c *OrderScheduleController) fetchOrderSchedule(ctx echo.Context, userId uuid.UUID) error {
+	var req FetchOrderScheduleRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch order schedule, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	return c.internalFetchOrderSchedule(ctx, userId, req.CustomerId)
+}
+
+func (c *OrderScheduleController) internalFetchOrderSchedule(ctx echo.Context, userId uuid.UUID, customerId *uuid.UUID) error {
+	list, err := c.useCase.Fetch(ctx.Request().Context(), domain.FetchOrderSchedule{
+		UserId:     userId,
+		CustomerId: customerId,
+	})
+
+	switch err {
+	case nil:
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "fetchOrderSchedule, unhandled error useCase.Fetch")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	res := make([]OrderScheduleResponse, len(list))
+	for i := range list {
+		res[i] = useCaseToOrderScheduleResponse(list[i])
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+type UpdateOrderScheduleRequest struct {
+	ScheduleId uuid.UUID `json:"-" param:"scheduleId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Weekdays 의뢰 요일, 0: 일요일 ~ 6: 토요일
+	Weekdays []uint8 `json:"weekdays" validate:"required,min=1,max=7,dive,max=6" example:"2,5"`
+
+	// Minute 의뢰 시각, 0시부터 분 단위 (한국 시간)
+	Minute uint16 `json:"minute" validate:"max=1439" example:"600"`
+
+	TypeId uint8 `json:"typeId" validate:"required" example:"1"`
+
+	// Priority 우선순위, 비어있으면 NORMAL
+	Priority    domain.OrderPriority `json:"priority" validate:"omitempty,oneof=NORMAL HIGH RUSH" example:"NORMAL"`
+	Requirement *string              `json:"requirement" validate:"omitempty,max=2000" example:"(대충 매주 같은 형식의 브이로그 편집 요구사항)"`
+
+	// Paused true 면 멈춤, false 로 바꾸면 다음 일정부터 다시 시작, 사용 중인 구독이 있어야함
+	Paused bool `json:"paused" example:"false"`
+} // @name UpdateOrderScheduleRequest
+
+// @Tags (Order Schedule) 정기 의뢰 일정
+// @Security Auth-Jwt-Bearer
+// @Summary 정기 의뢰 일정 수정
+// @Description 요일, 시각, 기본 의뢰 내용을 바꾸거나 일정을 멈추고 다시 시작하는 기능, 고객은 자신의 일정만 가능
+// @Accept json
+// @Param schedule_id path string true "일정 식별 아이디(UUID)"
+// @Param requestBody body UpdateOrderScheduleRequest true "일정 데이터 구조"
+// @Success 204 "수정 완료"
+// @Failure 403 {object} domain.ErrorResponse "사용 중인 구독이 없거나 플랜에서 허용하지 않는 우선순위"
+// @Failure 404 {object} domain.ErrorResponse "일정이나 의뢰 종류가 없음"
+// @Router /customer/me/order-schedules/{schedule_id} [put]
+// @Router /order-schedule/{schedule_id} [put]
+func (c *OrderScheduleController) updateOrderSchedule(ctx echo.Context, userId uuid.UUID) error {
+	var req UpdateOrderScheduleRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "update order schedule, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.UpdateOrderSchedule(ctx.Request().Context(), domain.UpdateOrderSchedule{
+		UserId:      userId,
+		ScheduleId:  req.ScheduleId,
+		Weekdays:    req.Weekdays,
+		Minute:      req.Minute,
+		TypeId:      req.TypeId,
+		Priority:    req.Priority,
+		Requirement: req.Requirement,
+		Paused:      req.Paused,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoActiveTicket, domain.ErrPriorityNotAllowed:
+		return ctx.JSON(http.StatusForbidden, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", req).
+			Error(tag, "updateOrderSchedule, unhandled error useCase.UpdateOrderSchedule")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type DeleteOrderScheduleRequest struct {
+	ScheduleId uuid.UUID `json:"-" param:"scheduleId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name DeleteOrderScheduleRequest
+
+// @Tags (Order Schedule) 정기 의뢰 일정
+// @Security Auth-Jwt-Bearer
+// @Summary 정기 의뢰 일정 삭제
+// @Description 정기 의뢰 일정을 지우는 기능, 이미 만들어진 초안은 남음, 고객은 자신의 일정만 가능
+// @Param schedule_id path string true "일정 식별 아이디(UUID)"
+// @Success 204 "삭제 완료"
+// @Failure 404 {object} domain.ErrorResponse "일정이 없음"
+// @Router /customer/me/order-schedules/{schedule_id} [delete]
+// @Router /order-schedule/{schedule_id} [delete]
+func (c *OrderScheduleController) deleteOrderSchedule(ctx echo.Context, userId uuid.UUID) error {
+	var req DeleteOrderScheduleRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "delete order schedule, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.DeleteOrderSchedule(ctx.Request().Context(), domain.DeleteOrderSchedule{
+		UserId:     userId,
+		ScheduleId: req.ScheduleId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "deleteOrderSchedule, unhandled error useCase.DeleteOrderSchedule")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type OrderDraftResponse struct {
+	DraftId    uuid.UUID `json:"draftId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	ScheduleId uuid.UUID `json:"scheduleId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// OccurrenceAt 초안이 만들어진 일정 시각
+	OccurrenceAt time.Time            `json:"occurrenceAt" validate:"required" example:"2021-11-02T01:00:00+00:00"`
+	TypeId       uint8                `json:"typeId" validate:"required" example:"1"`
+	Priority     domain.OrderPriority `json:"priority" validate:"required" example:"NORMAL"`
+	Requirement  *string              `json:"requirement" example:"(대충 매주 같은 형식의 브이로그 편집 요구사항)"`
+	CreatedAt    time.Time            `json:"createdAt" validate:"required" example:"2021-11-02T01:00:00+00:00"`
+} // @name OrderDraftResponse
+
+// @Tags (Order Schedule) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 확인 대기 중인 의뢰 초안 목록
+// @Description 정기 의뢰 일정으로 만들어졌지만 아직 확인하지 않은 의뢰 초안 목록, 오래된 순, 역할(role)이 'CUSTOMER' 이여야함
+// @Produce json
+// @Success 200 {array} OrderDraftResponse true "초안 목록"
+// @Success 204 "초안 없음"
+// @Router /customer/me/order-drafts [get]
+func (c *OrderScheduleController) fetchMyOrderDraft(ctx echo.Context, userId uuid.UUID) error {
+	list, err := c.useCase.FetchMyOrderDraft(ctx.Request().Context(), userId)
+
+	switch err {
+	case nil:
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "fetchMyOrderDraft, unhandled error useCase.FetchMyOrderDraft")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	res := make([]OrderDraftResponse, len(list))
+	for i := range list {
+		res[i] = OrderDraftResponse{
+			DraftId:      list[i].Id,
+			ScheduleId:   list[i].ScheduleId,
+			OccurrenceAt: list[i].OccurrenceAt,
+			TypeId:       list[i].TypeId,
+			Priority:     list[i].Priority,
+			Requirement:  list[i].Requirement,
+			CreatedAt:    list[i].CreatedAt,
+		}
+	}
+	return ctx.JSON(http.StatusOK, res)
+}
+
+type ConfirmOrderDraftRequest struct {
+	DraftId uuid.UUID `json:"-" param:"draftId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+
+	// Requirement 있으면 초안의 기본 요구사항 대신 사용
+	Requirement *string `json:"requirement" validate:"omitempty,max=2000" example:"(대충 이번 주 영상 편집 요구사항)"`
+} // @name ConfirmOrderDraftRequest
+
+type ConfirmOrderDraftResponse struct {
+	OrderId uuid.UUID `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name ConfirmOrderDraftResponse
+
+// @Tags (Order Schedule) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 의뢰 초안 확인
+// @Description 의뢰 초안으로 의뢰를 요청하는 기능, 일반 의뢰 요청과 같이 의뢰 횟수가 차감됨, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Produce json
+// @Param draft_id path string true "초안 식별 아이디(UUID)"
+// @Param requestBody body ConfirmOrderDraftRequest false "확인 데이터 구조"
+// @Success 201 {object} ConfirmOrderDraftResponse true "의뢰 요청 완료"
+// @Failure 403 {object} domain.ErrorResponse "사용 중인 구독 티켓 없음, 플랜에서 허용하지 않는 우선순위"
+// @Failure 409 {object} domain.ErrorResponse "이미 확인했거나 넘긴 초안, 진행 중인 의뢰가 한도에 도달, 의뢰 횟수 부족"
+// @Router /customer/me/order-drafts/{draft_id}/confirm [post]
+func (c *OrderScheduleController) confirmOrderDraft(ctx echo.Context, userId uuid.UUID) error {
+	var req ConfirmOrderDraftRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "confirm order draft, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	orderId, err := c.useCase.ConfirmOrderDraft(ctx.Request().Context(), domain.ConfirmOrderDraft{
+		UserId:      userId,
+		DraftId:     req.DraftId,
+		Requirement: req.Requirement,
+	})
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusCreated, ConfirmOrderDraftResponse{OrderId: orderId})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemAlreadyExist, domain.ErrNotEnoughOrderCount, domain.ErrVersionConflict:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoActiveTicket, domain.ErrPriorityNotAllowed:
+		return ctx.JSON(http.StatusForbidden, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "confirmOrderDraft, unhandled error useCase.ConfirmOrderDraft")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type DismissOrderDraftRequest struct {
+	DraftId uuid.UUID `json:"-" param:"draftId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+} // @name DismissOrderDraftRequest
+
+// @Tags (Order Schedule) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 의뢰 초안 넘기기
+// @Description 이번 일정은 의뢰하지 않고 넘기는 기능, 의뢰 횟수는 차감되지 않음, 역할(role)이 'CUSTOMER' 이여야함
+// @Param draft_id path string true "초안 식별 아이디(UUID)"
+// @Success 204 "넘김 완료"
+// @Failure 409 {object} domain.ErrorResponse "이미 확인했거나 넘긴 초안"
+// @Router /customer/me/order-drafts/{draft_id} [delete]
+func (c *OrderScheduleController) dismissOrderDraft(ctx echo.Context, userId uuid.UUID) error {
+	var req DismissOrderDraftRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "dismiss order draft, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.DismissOrderDraft(ctx.Request().Context(), domain.DismissOrderDraft{
+		UserId:  userId,
+		DraftId: req.DraftId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "dismissOrderDraft, unhandled error useCase.DismissOrderDraft")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
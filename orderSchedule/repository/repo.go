@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"gorm.io/gorm"
+)
+
+func NewOrderScheduleRepository(db *gorm.DB) domain.OrderScheduleRepository {
+	db.AutoMigrate(&domain.OrderSchedule{}, &domain.OrderDraft{})
+	return &repo{db: db}
+}
+
+type repo struct {
+	db *gorm.DB
+}
+
+func (r *repo) Save(ctx context.Context, schedule *domain.OrderSchedule) error {
+	return gormx.Upsert(ctx, r.db, schedule)
+}
+
+func (r *repo) Delete(ctx context.Context, schedule *domain.OrderSchedule) error {
+	return r.db.WithContext(ctx).Delete(schedule).Error
+}
+
+func (r *repo) GetById(ctx context.Context, id uuid.UUID) (res *domain.OrderSchedule, err error) {
+	var entity domain.OrderSchedule
+	err = r.db.WithContext(ctx).
+		Where("`id` = ?", id).
+		First(&entity).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) Fetch(ctx context.Context, option domain.FetchOrderScheduleOption) (list []domain.OrderSchedule, err error) {
+	db := r.db.WithContext(ctx).
+		Order("`created_at` asc")
+	if option.Owner != nil {
+		db = db.Where("`owner` = ?", option.Owner)
+	}
+
+	err = db.Find(&list).Error
+	return
+}
+
+func (r *repo) FetchDue(ctx context.Context, now time.Time) (list []domain.OrderSchedule, err error) {
+	err = r.db.WithContext(ctx).
+		Where("`paused_at` IS NULL AND `next_run_at` <= ?", now).
+		Order("`next_run_at` asc").
+		Find(&list).Error
+	return
+}
+
+func (r *repo) CreateDraft(ctx context.Context, draft *domain.OrderDraft) error {
+	err := r.db.WithContext(ctx).Create(draft).Error
+	if gormx.IsDuplicateEntry(err) {
+		return domain.ErrItemAlreadyExist
+	}
+	return err
+}
+
+func (r *repo) SaveDraft(ctx context.Context, draft *domain.OrderDraft) error {
+	return gormx.Upsert(ctx, r.db, draft)
+}
+
+func (r *repo) ChangeDraftState(ctx context.Context, id uuid.UUID, from, to domain.OrderDraftState) (changed bool, err error) {
+	db := r.db.WithContext(ctx).
+		Model(&domain.OrderDraft{}).
+		Where("`id` = ? AND `state` = ?", id, from).
+		Update("state", to)
+	err = db.Error
+	changed = db.RowsAffected > 0
+	return
+}
+
+func (r *repo) GetDraftById(ctx context.Context, id uuid.UUID) (res *domain.OrderDraft, err error) {
+	var entity domain.OrderDraft
+	err = r.db.WithContext(ctx).
+		Where("`id` = ?", id).
+		First(&entity).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) FetchPendingDraftByOwner(ctx context.Context, owner uuid.UUID) (list []domain.OrderDraft, err error) {
+	err = r.db.WithContext(ctx).
+		Where("`owner` = ? AND `state` = ?", owner, domain.OrderDraftStatePending).
+		Order("`occurrence_at` asc").
+		Find(&list).Error
+	return
+}
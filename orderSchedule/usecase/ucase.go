@@ -0,0 +1,470 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+const (
+	tag = "[ORDER_SCHEDULE] "
+)
+
+func NewOrderScheduleUseCase(
+	orderScheduleRepo domain.OrderScheduleRepository,
+	orderTicketRepo domain.OrderTicketRepository,
+	orderTypeRepo domain.OrderTypeRepository,
+	userRepo domain.UserRepository,
+	orderUseCase domain.OrderUseCase,
+	notification domain.NotificationAdapter,
+	timeout time.Duration,
+) domain.OrderScheduleUseCase {
+	return &ucase{
+		orderScheduleRepo: orderScheduleRepo,
+		orderTicketRepo:   orderTicketRepo,
+		orderTypeRepo:     orderTypeRepo,
+		userRepo:          userRepo,
+		orderUseCase:      orderUseCase,
+		notification:      notification,
+		timeout:           timeout,
+	}
+}
+
+type ucase struct {
+	orderScheduleRepo domain.OrderScheduleRepository
+	orderTicketRepo   domain.OrderTicketRepository
+	orderTypeRepo     domain.OrderTypeRepository
+	userRepo          domain.UserRepository
+	orderUseCase      domain.OrderUseCase
+	notification      domain.NotificationAdapter
+	timeout           time.Duration
+}
+
+func (u *ucase) AddOrderSchedule(ctx context.Context, in domain.AddOrderSchedule) (newId uuid.UUID, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if !isValidTime(in.Weekdays, in.Minute) {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	user, err := u.userRepo.GetById(c, in.UserId)
+	if err != nil {
+		return
+	}
+
+	var owner uuid.UUID
+	switch {
+	case domain.CheckUserAlive(user, domain.User.IsCustomer):
+		owner = user.Id
+	case domain.CheckUserAlive(user, domain.User.IsAdmin, domain.User.IsSuperAdmin):
+		if in.CustomerId == nil {
+			err = domain.ErrWeirdData
+			return
+		}
+
+		var customer *domain.User
+		customer, err = u.userRepo.GetById(c, *in.CustomerId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(customer, domain.User.IsCustomer) {
+			err = domain.ErrUserNotCustomer
+			return
+		}
+		owner = customer.Id
+	default:
+		err = domain.ErrNoPermission
+		return
+	}
+
+	now := time.Now()
+	err = u.checkTemplate(c, owner, in.TypeId, in.Priority, now)
+	if err != nil {
+		return
+	}
+
+	schedule := domain.CreateOrderSchedule(domain.CreateOrderScheduleOption{
+		Owner:       owner,
+		Weekdays:    in.Weekdays,
+		Minute:      in.Minute,
+		TypeId:      in.TypeId,
+		Priority:    in.Priority,
+		Requirement: in.Requirement,
+		CreatedBy:   in.UserId,
+		Now:         now,
+		Location:    config.Schedule.Location(),
+	})
+	err = u.orderScheduleRepo.Save(c, &schedule)
+	if err != nil {
+		return
+	}
+
+	newId = schedule.Id
+	return
+}
+
+func (u *ucase) UpdateOrderSchedule(ctx context.Context, in domain.UpdateOrderSchedule) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	if !isValidTime(in.Weekdays, in.Minute) {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	schedule, err := u.getSchedule(c, in.UserId, in.ScheduleId)
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+	loc := config.Schedule.Location()
+	if !in.Paused {
+		err = u.checkTemplate(c, schedule.Owner, in.TypeId, in.Priority, now)
+		if err != nil {
+			return
+		}
+	}
+
+	schedule.SetWeekdays(in.Weekdays)
+	schedule.Minute = in.Minute
+	schedule.TypeId = in.TypeId
+	schedule.Priority = in.Priority
+	schedule.Requirement = in.Requirement
+
+	switch {
+	case in.Paused:
+		schedule.Pause(domain.OrderSchedulePauseReasonUser)
+	case schedule.IsPaused():
+		schedule.Resume(now, loc)
+	default:
+		schedule.Advance(now, loc)
+	}
+	return u.orderScheduleRepo.Save(c, schedule)
+}
+
+func (u *ucase) DeleteOrderSchedule(ctx context.Context, in domain.DeleteOrderSchedule) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	schedule, err := u.getSchedule(c, in.UserId, in.ScheduleId)
+	if err != nil {
+		return
+	}
+
+	return u.orderScheduleRepo.Delete(c, schedule)
+}
+
+func (u *ucase) Fetch(ctx context.Context, in domain.FetchOrderSchedule) (res []domain.OrderScheduleInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	user, err := u.userRepo.GetById(c, in.UserId)
+	if err != nil {
+		return
+	}
+
+	var option domain.FetchOrderScheduleOption
+	switch {
+	case domain.CheckUserAlive(user, domain.User.IsCustomer):
+		option.Owner = &user.Id
+	case domain.CheckUserAlive(user, domain.User.IsAdmin, domain.User.IsSuperAdmin):
+		option.Owner = in.CustomerId
+	default:
+		err = domain.ErrNoPermission
+		return
+	}
+
+	list, err := u.orderScheduleRepo.Fetch(c, option)
+	if err != nil {
+		return
+	}
+
+	res = make([]domain.OrderScheduleInfo, len(list))
+	for i := range list {
+		src := &list[i]
+		res[i] = domain.OrderScheduleInfo{
+			Id:          src.Id,
+			Owner:       src.Owner,
+			Weekdays:    src.WeekdayList(),
+			Minute:      src.Minute,
+			TypeId:      src.TypeId,
+			Priority:    src.Priority,
+			Requirement: src.Requirement,
+			NextRunAt:   src.NextRunAt,
+			PausedAt:    src.PausedAt,
+			PauseReason: src.PauseReason,
+			CreatedBy:   src.CreatedBy,
+			CreatedAt:   src.CreatedAt,
+		}
+	}
+	return
+}
+
+func (u *ucase) FetchMyOrderDraft(ctx context.Context, userId uuid.UUID) (res []domain.OrderDraftInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err = u.checkCustomer(c, userId)
+	if err != nil {
+		return
+	}
+
+	list, err := u.orderScheduleRepo.FetchPendingDraftByOwner(c, userId)
+	if err != nil {
+		return
+	}
+
+	res = make([]domain.OrderDraftInfo, len(list))
+	for i := range list {
+		src := &list[i]
+		res[i] = domain.OrderDraftInfo{
+			Id:           src.Id,
+			ScheduleId:   src.ScheduleId,
+			OccurrenceAt: src.OccurrenceAt,
+			TypeId:       src.TypeId,
+			Priority:     src.Priority,
+			Requirement:  src.Requirement,
+			CreatedAt:    src.CreatedAt,
+		}
+	}
+	return
+}
+
+func (u *ucase) ConfirmOrderDraft(ctx context.Context, in domain.ConfirmOrderDraft) (orderId uuid.UUID, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	draft, err := u.getMyDraft(c, in.UserId, in.DraftId)
+	if err != nil {
+		return
+	}
+
+	// 같은 초안을 동시에 확인해도 의뢰는 하나만 만들어지도록 먼저 확인 상태로 바꿈
+	changed, err := u.orderScheduleRepo.ChangeDraftState(c, draft.Id,
+		domain.OrderDraftStatePending, domain.OrderDraftStateConfirmed)
+	if err != nil {
+		return
+	}
+
+	if !changed {
+		err = domain.ErrItemAlreadyExist
+		return
+	}
+
+	requirement := draft.Requirement
+	if in.Requirement != nil {
+		requirement = in.Requirement
+	}
+
+	req := domain.RequestOrder{
+		UserId:   in.UserId,
+		TypeId:   draft.TypeId,
+		Priority: draft.Priority,
+	}
+	if requirement != nil {
+		req.Requirement = *requirement
+	}
+
+	orderId, err = u.orderUseCase.RequestOrder(c, req)
+	if err != nil {
+		// 의뢰가 만들어지지 않았으므로 다시 확인할 수 있게 되돌림
+		if _, rErr := u.orderScheduleRepo.ChangeDraftState(c, draft.Id,
+			domain.OrderDraftStateConfirmed, domain.OrderDraftStatePending); rErr != nil {
+			log.WithError(rErr).
+				WithField("draftId", draft.Id).
+				Error(tag, "confirmOrderDraft, revert draft state failed")
+		}
+		return
+	}
+
+	draft.Requirement = requirement
+	draft.Confirm(orderId)
+	err = u.orderScheduleRepo.SaveDraft(c, draft)
+	return
+}
+
+func (u *ucase) DismissOrderDraft(ctx context.Context, in domain.DismissOrderDraft) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	draft, err := u.getMyDraft(c, in.UserId, in.DraftId)
+	if err != nil {
+		return
+	}
+
+	changed, err := u.orderScheduleRepo.ChangeDraftState(c, draft.Id,
+		domain.OrderDraftStatePending, domain.OrderDraftStateDismissed)
+	if err == nil && !changed {
+		err = domain.ErrItemAlreadyExist
+	}
+	return
+}
+
+func (u *ucase) RunDueSchedules(ctx context.Context) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	now := time.Now()
+	schedules, err := u.orderScheduleRepo.FetchDue(c, now)
+	if err != nil {
+		return
+	}
+
+	// 한 일정이 실패해도 나머지 일정은 계속 처리
+	for i := range schedules {
+		sErr := u.runSchedule(ctx, &schedules[i], now)
+		if sErr != nil {
+			log.WithError(sErr).
+				WithField("scheduleId", schedules[i].Id).
+				Error(tag, "runDueSchedules, runSchedule failed")
+		}
+	}
+	return
+}
+
+func (u *ucase) runSchedule(ctx context.Context, schedule *domain.OrderSchedule, now time.Time) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	ticket, err := u.orderTicketRepo.GetByOwnerIdBetweenStartAndEnd(c, schedule.Owner, now)
+	if err != nil {
+		return
+	}
+
+	if ticket == nil {
+		schedule.Pause(domain.OrderSchedulePauseReasonTicketEnded)
+		err = u.orderScheduleRepo.Save(c, schedule)
+		if err != nil {
+			return
+		}
+
+		return u.notification.Notify(c, domain.Notification{
+			UserIds: []uuid.UUID{schedule.Owner},
+			Title:   i18n.T(c, i18n.ScheduleTicketEndedTitle),
+			Message: i18n.T(c, i18n.ScheduleTicketEndedMessage),
+		})
+	}
+
+	// 이전 실행에서 초안만 만들고 일정 저장에 실패했을 수 있으므로 이미 있는 초안은 넘어감
+	draft := domain.CreateOrderDraft(*schedule)
+	err = u.orderScheduleRepo.CreateDraft(c, &draft)
+	created := err == nil
+	if err != nil && err != domain.ErrItemAlreadyExist {
+		return
+	}
+
+	schedule.Advance(now, config.Schedule.Location())
+	err = u.orderScheduleRepo.Save(c, schedule)
+	if err != nil || !created {
+		return
+	}
+
+	return u.notification.Notify(c, domain.Notification{
+		UserIds: []uuid.UUID{schedule.Owner},
+		Title:   i18n.T(c, i18n.ScheduleDueTitle),
+		Message: i18n.T(c, i18n.ScheduleDueMessage, draft.Id),
+	})
+}
+
+func isValidTime(weekdays []uint8, minute uint16) bool {
+	return domain.IsValidOrderScheduleWeekdays(weekdays) && minute < 24*60
+}
+
+// checkTemplate 일정을 새로 만들거나 재개할 때 의뢰 종류와 구독 티켓으로 의뢰할 수 있는지 확인
+func (u *ucase) checkTemplate(ctx context.Context, owner uuid.UUID, typeId uint8, priority domain.OrderPriority, now time.Time) (err error) {
+	orderType, err := u.orderTypeRepo.GetById(ctx, typeId)
+	if err != nil {
+		return
+	}
+
+	if orderType == nil || !orderType.Active {
+		return domain.ErrItemNotFound
+	}
+
+	ticket, err := u.orderTicketRepo.GetByOwnerIdBetweenStartAndEnd(ctx, owner, now)
+	if err != nil {
+		return
+	}
+
+	if ticket == nil {
+		return domain.ErrNoActiveTicket
+	}
+
+	if !ticket.AllowsPriority(priority) {
+		return domain.ErrPriorityNotAllowed
+	}
+	return
+}
+
+// getSchedule 고객은 자신의 일정만, 관리자는 모든 일정
+func (u *ucase) getSchedule(ctx context.Context, userId, scheduleId uuid.UUID) (schedule *domain.OrderSchedule, err error) {
+	user, err := u.userRepo.GetById(ctx, userId)
+	if err != nil {
+		return
+	}
+
+	isCustomer := domain.CheckUserAlive(user, domain.User.IsCustomer)
+	if !isCustomer && !domain.CheckUserAlive(user, domain.User.IsAdmin, domain.User.IsSuperAdmin) {
+		return nil, domain.ErrNoPermission
+	}
+
+	schedule, err = u.orderScheduleRepo.GetById(ctx, scheduleId)
+	if err != nil {
+		return
+	}
+
+	if schedule == nil {
+		return nil, domain.ErrItemNotFound
+	}
+
+	if isCustomer && schedule.Owner != userId {
+		return nil, domain.ErrNoPermission
+	}
+	return
+}
+
+func (u *ucase) getMyDraft(ctx context.Context, userId, draftId uuid.UUID) (draft *domain.OrderDraft, err error) {
+	err = u.checkCustomer(ctx, userId)
+	if err != nil {
+		return
+	}
+
+	draft, err = u.orderScheduleRepo.GetDraftById(ctx, draftId)
+	if err != nil {
+		return
+	}
+
+	if draft == nil {
+		return nil, domain.ErrItemNotFound
+	}
+
+	if draft.Owner != userId {
+		return nil, domain.ErrNoPermission
+	}
+
+	if !draft.IsPending() {
+		return nil, domain.ErrItemAlreadyExist
+	}
+	return
+}
+
+func (u *ucase) checkCustomer(ctx context.Context, userId uuid.UUID) (err error) {
+	user, err := u.userRepo.GetById(ctx, userId)
+	if err != nil {
+		return
+	}
+
+	if !domain.CheckUserAlive(user, domain.User.IsCustomer) {
+		err = domain.ErrNoPermission
+	}
+	return
+}
@@ -3,7 +3,9 @@ package handler
 import (
 	"github.com/labstack/echo/v4"
 	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
 	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
 	"net/http"
 )
 
@@ -95,5 +97,21 @@ func (c *OrderStateController) fetchSub(ctx echo.Context) error {
 func (c *OrderStateController) Bind(e *echo.Echo) {
 	e.GET("/order/state/full", c.fetchFull)
 	e.GET("/order/state/:orderStateId/sub", c.fetchSub)
+
+	//SUPER_ADMIN
+	e.GET("/order-state", echox.UserID(c.fetchOrderStateDetail),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.POST("/order-state", echox.UserID(c.addOrderState),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.PUT("/order-state/sort", echox.UserID(c.sortOrderState),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.PUT("/order-state/:orderStateId", echox.UserID(c.updateOrderState),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.DELETE("/order-state/:orderStateId", echox.UserID(c.deleteOrderState),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.PUT("/order-state/:orderStateId/translation/:lang", echox.UserID(c.saveOrderStateTranslation),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.DELETE("/order-state/:orderStateId/translation/:lang", echox.UserID(c.deleteOrderStateTranslation),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
 }
 
@@ -0,0 +1,391 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+type OrderStateDetailResponse struct {
+	Id uint8 `json:"id" validate:"required" example:"3"`
+
+	// Code NONE 이 아니면 시스템 상태, 지우거나 끄거나 부모, 그룹을 바꿀 수 없음
+	Code        domain.OrderStateCode `json:"code" validate:"required" example:"NONE" enums:"NONE,DEFAULT,TAKE,THUMBNAIL_TAKE,REQUEST_EDIT,EDIT_DONE,DONE,CANCELED"`
+	Content     string                `json:"content" validate:"required" example:"편집 중"`
+	LongContent string                `json:"longContent" validate:"required" example:"영상을 이쁘게 자르고 붙이는 중..."`
+	Emoji       string                `json:"emoji" validate:"required" example:"😍"`
+	ParentId    *uint8                `json:"parentId" example:"2"`
+	GroupId     *uint8                `json:"groupId" example:"1"`
+
+	// SortOrder 같은 부모 아래에서 작은 순
+	SortOrder uint16 `json:"sortOrder" example:"0"`
+	Active    bool   `json:"active" validate:"required" example:"true"`
+
+	// Translations 기본 언어(ko) 외의 번역, Accept-Language 가 이 언어면 번역된 이름, 설명을 보여줌
+	Translations []OrderStateTranslationResponse `json:"translations" validate:"required"`
+} // @name OrderStateDetailResponse
+
+type OrderStateTranslationResponse struct {
+	Lang        string `json:"lang" validate:"required" example:"en"`
+	Content     string `json:"content" validate:"required" example:"Editing"`
+	LongContent string `json:"longContent" validate:"required" example:"Cutting and stitching your video..."`
+} // @name OrderStateTranslationResponse
+
+// @Tags (Order State) 의뢰 상태 관리
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 의뢰 상태 목록
+// @Description 꺼진 상태까지 모든 의뢰 상태를 번역과 함께 가져오는 기능, 이름, 설명은 번역되지 않은 기본 내용, 정렬 순서, 아이디 순
+// @Produce json
+// @Success 200 {array} OrderStateDetailResponse true "의뢰 상태 목록"
+// @Router /order-state [get]
+func (c *OrderStateController) fetchOrderStateDetail(ctx echo.Context, userId uuid.UUID) error {
+	list, err := c.useCase.FetchDetail(ctx.Request().Context(), userId)
+
+	switch err {
+	case nil:
+		res := make([]OrderStateDetailResponse, len(list))
+		for i := range list {
+			src := list[i]
+			res[i] = OrderStateDetailResponse{
+				Id:          src.Id,
+				Code:        src.Code,
+				Content:     src.Content,
+				LongContent: src.LongContent,
+				Emoji:       src.Emoji,
+				ParentId:    src.ParentId,
+				GroupId:     src.GroupId,
+				SortOrder:   src.SortOrder,
+				Active:      src.Active,
+
+				Translations: make([]OrderStateTranslationResponse, len(src.Translations)),
+			}
+			for j, t := range src.Translations {
+				res[i].Translations[j] = OrderStateTranslationResponse{
+					Lang:        t.Lang,
+					Content:     t.Content,
+					LongContent: t.LongContent,
+				}
+			}
+		}
+		return ctx.JSON(http.StatusOK, res)
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "fetchOrderStateDetail, unhandled error useCase.FetchDetail")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type AddOrderStateRequest struct {
+	Content     string `json:"content" validate:"required,max=150" example:"자막 작업 중"`
+	LongContent string `json:"longContent" validate:"required,max=300" example:"자막을 한 줄씩 꼼꼼히 넣고 있어요"`
+	Emoji       string `json:"emoji" validate:"required,max=16" example:"💬"`
+
+	// ParentId 부모 상태, 켜진 상태여야 함
+	ParentId *uint8 `json:"parentId" validate:"omitempty,min=1" example:"2"`
+	GroupId  *uint8 `json:"groupId" validate:"omitempty,min=1" example:"1"`
+
+	// SortOrder 같은 부모 아래에서 작은 순
+	SortOrder uint16 `json:"sortOrder" example:"3"`
+} // @name AddOrderStateRequest
+
+type AddOrderStateResponse struct {
+	StateId uint8 `json:"stateId" validate:"required" example:"13"`
+} // @name AddOrderStateResponse
+
+// @Tags (Order State) 의뢰 상태 관리
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 의뢰 상태 추가
+// @Description 새 의뢰 상태를 추가하는 기능, 추가한 상태의 코드는 NONE
+// @Accept json
+// @Produce json
+// @Param requestBody body AddOrderStateRequest true "의뢰 상태 데이터 구조"
+// @Success 201 {object} AddOrderStateResponse true "추가 완료"
+// @Failure 400 {object} domain.ErrorResponse "없거나 꺼진 부모 상태"
+// @Router /order-state [post]
+func (c *OrderStateController) addOrderState(ctx echo.Context, userId uuid.UUID) error {
+	var req AddOrderStateRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "add order state, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	newId, err := c.useCase.AddOrderState(ctx.Request().Context(), domain.AddOrderState{
+		UserId:      userId,
+		Content:     req.Content,
+		LongContent: req.LongContent,
+		Emoji:       req.Emoji,
+		ParentId:    req.ParentId,
+		GroupId:     req.GroupId,
+		SortOrder:   req.SortOrder,
+	})
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusCreated, AddOrderStateResponse{StateId: newId})
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid parent state"})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "addOrderState, unhandled error useCase.AddOrderState")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type UpdateOrderStateRequest struct {
+	StateId     uint8  `json:"-" param:"orderStateId" validate:"required" example:"3"`
+	Content     string `json:"content" validate:"required,max=150" example:"편집 중"`
+	LongContent string `json:"longContent" validate:"required,max=300" example:"영상을 이쁘게 자르고 붙이는 중..."`
+	Emoji       string `json:"emoji" validate:"required,max=16" example:"😍"`
+
+	// ParentId 부모 상태, 켜진 상태여야 하고 자기 하위 상태는 안 됨
+	ParentId *uint8 `json:"parentId" validate:"omitempty,min=1" example:"2"`
+	GroupId  *uint8 `json:"groupId" validate:"omitempty,min=1" example:"1"`
+
+	// SortOrder 같은 부모 아래에서 작은 순
+	SortOrder uint16 `json:"sortOrder" example:"0"`
+
+	// Active false 면 이 상태로 의뢰를 옮길 수 없음, 이미 이 상태인 의뢰는 그대로
+	Active bool `json:"active" example:"true"`
+} // @name UpdateOrderStateRequest
+
+// @Tags (Order State) 의뢰 상태 관리
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 의뢰 상태 수정
+// @Description 의뢰 상태의 이름, 설명, 이모지, 부모, 그룹, 정렬 순서를 바꾸거나 끄는 기능, 시스템 상태는 끄거나 부모, 그룹을 바꿀 수 없음
+// @Accept json
+// @Param order_state_id path int true "의뢰 상태 아이디"
+// @Param requestBody body UpdateOrderStateRequest true "의뢰 상태 데이터 구조"
+// @Success 204 "수정 완료"
+// @Failure 400 {object} domain.ErrorResponse "없거나 꺼진 부모 상태, 순환하는 부모 상태"
+// @Failure 404 {object} domain.ErrorResponse "없는 상태"
+// @Failure 409 {object} domain.ErrorResponse "시스템 상태, 의뢰 종류의 작업 시작 상태를 하위 상태로 옮김"
+// @Router /order-state/{order_state_id} [put]
+func (c *OrderStateController) updateOrderState(ctx echo.Context, userId uuid.UUID) error {
+	var req UpdateOrderStateRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "update order state, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	in := domain.UpdateOrderState{
+		UserId:      userId,
+		StateId:     req.StateId,
+		Content:     req.Content,
+		LongContent: req.LongContent,
+		Emoji:       req.Emoji,
+		ParentId:    req.ParentId,
+		GroupId:     req.GroupId,
+		SortOrder:   req.SortOrder,
+		Active:      req.Active,
+	}
+	err = c.useCase.UpdateOrderState(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid parent state"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrSystemOrderState, domain.ErrOrderStateInUse:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", in).
+			Error(tag, "updateOrderState, unhandled error useCase.UpdateOrderState")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type SortOrderStateRequest struct {
+	// StateIds 이 순서대로 정렬 순서가 0 부터 매겨짐, 보통 같은 부모의 상태들
+	StateIds []uint8 `json:"stateIds" validate:"required,min=1,max=255" example:"4,3,5"`
+} // @name SortOrderStateRequest
+
+// @Tags (Order State) 의뢰 상태 관리
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 의뢰 상태 순서 변경
+// @Description 보낸 순서대로 의뢰 상태의 정렬 순서를 바꾸는 기능
+// @Accept json
+// @Param requestBody body SortOrderStateRequest true "정렬 데이터 구조"
+// @Success 204 "변경 완료"
+// @Failure 400 {object} domain.ErrorResponse "없거나 중복된 상태"
+// @Router /order-state/sort [put]
+func (c *OrderStateController) sortOrderState(ctx echo.Context, userId uuid.UUID) error {
+	var req SortOrderStateRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "sort order state, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.SortOrderState(ctx.Request().Context(), domain.SortOrderState{
+		UserId:   userId,
+		StateIds: req.StateIds,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "sortOrderState, unhandled error useCase.SortOrderState")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type DeleteOrderStateRequest struct {
+	StateId uint8 `param:"orderStateId" validate:"required" example:"13"`
+} // @name DeleteOrderStateRequest
+
+// @Tags (Order State) 의뢰 상태 관리
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 의뢰 상태 삭제
+// @Description 의뢰 상태를 지우는 기능, 시스템 상태와 의뢰, 상태 기록, 하위 상태, 의뢰 종류에서 쓰고 있는 상태는 지울 수 없고 끌 수만 있음
+// @Param order_state_id path int true "의뢰 상태 아이디"
+// @Success 204 "삭제 완료"
+// @Failure 404 {object} domain.ErrorResponse "없는 상태"
+// @Failure 409 {object} domain.ErrorResponse "시스템 상태, 쓰고 있는 상태"
+// @Router /order-state/{order_state_id} [delete]
+func (c *OrderStateController) deleteOrderState(ctx echo.Context, userId uuid.UUID) error {
+	var req DeleteOrderStateRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "delete order state, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.DeleteOrderState(ctx.Request().Context(), domain.DeleteOrderState{
+		UserId:  userId,
+		StateId: req.StateId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrSystemOrderState, domain.ErrOrderStateInUse:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "deleteOrderState, unhandled error useCase.DeleteOrderState")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type SaveOrderStateTranslationRequest struct {
+	StateId     uint8  `json:"-" param:"orderStateId" validate:"required" example:"3"`
+	Lang        string `json:"-" param:"lang" validate:"required" example:"en"`
+	Content     string `json:"content" validate:"required,max=150" example:"Editing"`
+	LongContent string `json:"longContent" validate:"required,max=300" example:"Cutting and stitching your video..."`
+} // @name SaveOrderStateTranslationRequest
+
+// @Tags (Order State) 의뢰 상태 관리
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 의뢰 상태 번역 저장
+// @Description 의뢰 상태의 이름, 설명을 다른 언어로 넣거나 바꾸는 기능, 기본 언어(ko)는 상태 수정으로 바꿈
+// @Accept json
+// @Param order_state_id path int true "의뢰 상태 아이디"
+// @Param lang path string true "언어" Enums(en)
+// @Param requestBody body SaveOrderStateTranslationRequest true "번역 데이터 구조"
+// @Success 204 "저장 완료"
+// @Failure 400 {object} domain.ErrorResponse "지원하지 않는 언어, 기본 언어"
+// @Failure 404 {object} domain.ErrorResponse "없는 상태"
+// @Router /order-state/{order_state_id}/translation/{lang} [put]
+func (c *OrderStateController) saveOrderStateTranslation(ctx echo.Context, userId uuid.UUID) error {
+	var req SaveOrderStateTranslationRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "save order state translation, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.SaveOrderStateTranslation(ctx.Request().Context(), domain.SaveOrderStateTranslation{
+		UserId:      userId,
+		StateId:     req.StateId,
+		Lang:        req.Lang,
+		Content:     req.Content,
+		LongContent: req.LongContent,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "unsupported language"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "saveOrderStateTranslation, unhandled error useCase.SaveOrderStateTranslation")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type DeleteOrderStateTranslationRequest struct {
+	StateId uint8  `param:"orderStateId" validate:"required" example:"3"`
+	Lang    string `param:"lang" validate:"required" example:"en"`
+} // @name DeleteOrderStateTranslationRequest
+
+// @Tags (Order State) 의뢰 상태 관리
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 의뢰 상태 번역 삭제
+// @Description 의뢰 상태의 번역을 지우는 기능, 지운 뒤에는 그 언어로도 기본 내용을 보여줌
+// @Param order_state_id path int true "의뢰 상태 아이디"
+// @Param lang path string true "언어" Enums(en)
+// @Success 204 "삭제 완료"
+// @Failure 400 {object} domain.ErrorResponse "지원하지 않는 언어, 기본 언어"
+// @Router /order-state/{order_state_id}/translation/{lang} [delete]
+func (c *OrderStateController) deleteOrderStateTranslation(ctx echo.Context, userId uuid.UUID) error {
+	var req DeleteOrderStateTranslationRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "delete order state translation, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.DeleteOrderStateTranslation(ctx.Request().Context(), domain.DeleteOrderStateTranslation{
+		UserId:  userId,
+		StateId: req.StateId,
+		Lang:    req.Lang,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "unsupported language"})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "deleteOrderStateTranslation, unhandled error useCase.DeleteOrderStateTranslation")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
@@ -2,82 +2,14 @@ package repository
 
 import (
 	"context"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
 	"github.com/stockfolioofficial/back-editfolio/domain"
-	"github.com/stockfolioofficial/back-editfolio/util/pointer"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
 	"gorm.io/gorm"
 )
 
 func NewOrderStateRepository(db *gorm.DB) domain.OrderStateRepository {
-	db.AutoMigrate(&domain.OrderState{})
-	bookedOrderState := []domain.OrderState{
-		{
-			Id:          1,
-			Code:        domain.OrderStateCodeDefault,
-			Content:     "편집자 배정 중",
-			LongContent: "영상에 알맞는 편집자를\n 배정 중입니다.",
-			Emoji:       "🤔",
-		},
-		{
-			Id:          2,
-			Code:        domain.OrderStateCodeTake,
-			Content:     "영상 검토 중",
-			LongContent: "배정된 편집자가 영상을\n 열심히 확인하고 있어요",
-			Emoji:       "👀",
-			GroupId:     pointer.Uint8(1),
-		},
-		{
-			Id:          3,
-			Code:        domain.OrderStateCodeNone,
-			Content:     "편집 중",
-			LongContent: "영상을 이쁘게 자르고 붙이는 중...",
-			Emoji:       "😍",
-			ParentId:    pointer.Uint8(2),
-			GroupId:     pointer.Uint8(1),
-		},
-		{
-			Id:          4,
-			Code:        domain.OrderStateCodeNone,
-			Content:     "이펙트 추가 중",
-			LongContent: "아주 환상적인 이펙트를 입히는 중입니다.",
-			Emoji:       "🎇",
-			ParentId:    pointer.Uint8(2),
-			GroupId:     pointer.Uint8(1),
-		},
-		{
-			Id:          5,
-			Code:        domain.OrderStateCodeNone,
-			Content:     "완료",
-			LongContent: "영상편집이 완료되었습니다",
-			Emoji:       "😘",
-			ParentId:    pointer.Uint8(2),
-			GroupId:     pointer.Uint8(1),
-		},
-		{
-			Id:          6,
-			Code:        domain.OrderStateCodeDone,
-			LongContent: "영상편집이 완료되었습니다",
-			Emoji:       "😘",
-			Content:     "최종 완료",
-		},
-		{
-			Id:          7,
-			Code:        domain.OrderStateCodeRequestEdit,
-			Content:     "수정 중",
-			LongContent: "요청하신 수정사항을 작업중입니다.",
-			Emoji:       "🛠",
-			GroupId:     pointer.Uint8(2),
-		},
-		{
-			Id:          8,
-			Code:        domain.OrderStateCodeEditDone,
-			Content:     "수정 완료",
-			LongContent: "영상편집이 완료되었습니다",
-			Emoji:       "😘",
-			ParentId:    pointer.Uint8(7),
-			GroupId:     pointer.Uint8(2),
-		},
-	}
-	db.Create(bookedOrderState)
+	db.AutoMigrate(&domain.OrderState{}, &domain.OrderStateTranslation{})
 	return &repo{db: db}
 }
 
@@ -90,6 +22,7 @@ func (r *repo) GetByCode(ctx context.Context, code domain.OrderStateCode) (res *
 	err = r.db.WithContext(ctx).First(&entity, "`code` = ?", code).Error
 	if err == nil {
 		res = &entity
+		err = r.localize(ctx, res)
 	} else if err == gorm.ErrRecordNotFound {
 		err = nil
 	}
@@ -99,19 +32,27 @@ func (r *repo) GetByCode(ctx context.Context, code domain.OrderStateCode) (res *
 
 func (r *repo) FetchByParentId(ctx context.Context, parentId uint8) (list []domain.OrderState, err error) {
 	err = r.db.WithContext(ctx).
+		Order("`sort_order` asc").
 		Order("`id` asc").
 		Where("`parent_id` = ?", parentId).
 		Find(&list).
 		Error
+	if err == nil {
+		err = r.localizeList(ctx, list)
+	}
 	return
 }
 
 func (r *repo) FetchByGroupId(ctx context.Context, groupId uint8) (list []domain.OrderState, err error) {
 	err = r.db.WithContext(ctx).
+		Order("`sort_order` asc").
 		Order("`id` asc").
 		Where("`group_id` = ?", groupId).
 		Find(&list).
 		Error
+	if err == nil {
+		err = r.localizeList(ctx, list)
+	}
 	return
 }
 
@@ -121,6 +62,7 @@ func (r *repo) GetById(ctx context.Context, id uint8) (res *domain.OrderState, e
 	err = r.db.WithContext(ctx).First(&entity, id).Error
 	if err == nil {
 		res = &entity
+		err = r.localize(ctx, res)
 	} else if err == gorm.ErrRecordNotFound {
 		err = nil
 	}
@@ -130,16 +72,121 @@ func (r *repo) GetById(ctx context.Context, id uint8) (res *domain.OrderState, e
 
 func (r *repo) FetchFull(ctx context.Context) (list []domain.OrderState, err error) {
 	err = r.db.WithContext(ctx).
+		Order("`sort_order` asc").
 		Order("`id` asc").
 		Find(&list).Error
+	if err == nil {
+		err = r.localizeList(ctx, list)
+	}
 	return
 }
 
 func (r *repo) FetchByIds(ctx context.Context, ids []uint8) (list []domain.OrderState, err error) {
 	err = r.db.WithContext(ctx).
+		Order("`sort_order` asc").
 		Order("`id` asc").
 		Find(&list, ids).Error
+	if err == nil {
+		err = r.localizeList(ctx, list)
+	}
+	return
+}
+
+// Save Active 는 기본값이 있는 컬럼이라 Upsert 로는 false 가 저장되지 않아 Save 사용
+func (r *repo) Save(ctx context.Context, state *domain.OrderState) error {
+	return r.db.WithContext(ctx).Save(state).Error
+}
+
+func (r *repo) Delete(ctx context.Context, id uint8) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Delete(&domain.OrderStateTranslation{}, "`state_id` = ?", id).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(&domain.OrderState{}, id).Error
+	})
+}
+
+func (r *repo) IsInUse(ctx context.Context, id uint8) (inUse bool, err error) {
+	db := r.db.WithContext(ctx)
+	err = db.Raw("SELECT EXISTS (?) OR EXISTS (?) OR EXISTS (?) OR EXISTS (?)",
+		db.Model(&domain.Order{}).Select("1").Where("`state` = ?", id),
+		db.Model(&domain.OrderStateHistory{}).Select("1").Where("`state_id` = ?", id),
+		db.Model(&domain.OrderState{}).Select("1").Where("`parent_id` = ?", id),
+		db.Model(&domain.OrderType{}).Select("1").Where("`root_state_id` = ?", id),
+	).Scan(&inUse).Error
+	return
+}
+
+func (r *repo) UpdateSortOrder(ctx context.Context, ids []uint8) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			err := tx.Model(&domain.OrderState{}).
+				Where("`id` = ?", id).
+				Update("sort_order", i).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *repo) FetchTranslations(ctx context.Context) (list []domain.OrderStateTranslation, err error) {
+	err = r.db.WithContext(ctx).
+		Order("`state_id` asc").
+		Order("`lang` asc").
+		Find(&list).Error
 	return
 }
 
+func (r *repo) SaveTranslation(ctx context.Context, translation *domain.OrderStateTranslation) error {
+	return gormx.Upsert(ctx, r.db, translation)
+}
+
+func (r *repo) DeleteTranslation(ctx context.Context, stateId uint8, lang string) error {
+	return r.db.WithContext(ctx).
+		Delete(&domain.OrderStateTranslation{}, "`state_id` = ? AND `lang` = ?", stateId, lang).Error
+}
 
+// localize 요청 언어의 번역이 있으면 이름, 설명을 바꿈, 기본 언어면 그대로
+func (r *repo) localize(ctx context.Context, states ...*domain.OrderState) (err error) {
+	lang := i18n.FromContext(ctx)
+	if lang == i18n.Default || len(states) == 0 {
+		return
+	}
+
+	ids := make([]uint8, len(states))
+	for i := range states {
+		ids[i] = states[i].Id
+	}
+
+	var translations []domain.OrderStateTranslation
+	err = r.db.WithContext(ctx).
+		Find(&translations, "`state_id` IN ? AND `lang` = ?", ids, string(lang)).Error
+	if err != nil {
+		return
+	}
+
+	translationMap := make(map[uint8]*domain.OrderStateTranslation, len(translations))
+	for i := range translations {
+		translationMap[translations[i].StateId] = &translations[i]
+	}
+
+	for _, state := range states {
+		if t, ok := translationMap[state.Id]; ok {
+			state.Content = t.Content
+			state.LongContent = t.LongContent
+		}
+	}
+	return
+}
+
+func (r *repo) localizeList(ctx context.Context, list []domain.OrderState) error {
+	states := make([]*domain.OrderState, len(list))
+	for i := range list {
+		states[i] = &list[i]
+	}
+	return r.localize(ctx, states...)
+}
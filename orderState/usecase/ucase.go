@@ -8,16 +8,22 @@ import (
 
 func NewOrderStateUseCase(
 	orderStateRepo domain.OrderStateRepository,
+	orderTypeRepo domain.OrderTypeRepository,
+	userRepo domain.UserRepository,
 	timeout time.Duration,
 ) domain.OrderStateUseCase {
 	return &ucase{
 		orderStateRepo: orderStateRepo,
+		orderTypeRepo:  orderTypeRepo,
+		userRepo:       userRepo,
 		timeout:        timeout,
 	}
 }
 
 type ucase struct {
 	orderStateRepo domain.OrderStateRepository
+	orderTypeRepo  domain.OrderTypeRepository
+	userRepo       domain.UserRepository
 	timeout time.Duration
 }
 
@@ -0,0 +1,337 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/core/i18n"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+func (u *ucase) FetchDetail(ctx context.Context, userId uuid.UUID) (res []domain.OrderStateDetailInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	err = domain.CheckUserRole(c, u.userRepo, userId, domain.User.IsSuperAdmin)
+	if err != nil {
+		return
+	}
+
+	// 관리자는 번역이 아닌 기본 내용을 고침
+	c = i18n.WithLang(c, i18n.Default)
+	var (
+		list         []domain.OrderState
+		translations []domain.OrderStateTranslation
+	)
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		list, err = u.orderStateRepo.FetchFull(gc)
+		return
+	})
+	g.Go(func() (err error) {
+		translations, err = u.orderStateRepo.FetchTranslations(gc)
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	translationMap := make(map[uint8][]domain.OrderStateTranslationInfo)
+	for _, t := range translations {
+		translationMap[t.StateId] = append(translationMap[t.StateId], domain.OrderStateTranslationInfo{
+			Lang:        t.Lang,
+			Content:     t.Content,
+			LongContent: t.LongContent,
+		})
+	}
+
+	res = make([]domain.OrderStateDetailInfo, len(list))
+	for i := range list {
+		src := list[i]
+		res[i] = domain.OrderStateDetailInfo{
+			Id:          src.Id,
+			Code:        src.Code,
+			Content:     src.Content,
+			LongContent: src.LongContent,
+			Emoji:       src.Emoji,
+			ParentId:    src.ParentId,
+			GroupId:     src.GroupId,
+			SortOrder:   src.SortOrder,
+			Active:      src.Active,
+
+			Translations: translationMap[src.Id],
+		}
+	}
+	return
+}
+
+func (u *ucase) AddOrderState(ctx context.Context, in domain.AddOrderState) (newId uint8, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() error {
+		return u.checkParent(gc, 0, in.ParentId)
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	state := domain.CreateOrderState(domain.CreateOrderStateOption{
+		Content:     in.Content,
+		LongContent: in.LongContent,
+		Emoji:       in.Emoji,
+		ParentId:    in.ParentId,
+		GroupId:     in.GroupId,
+		SortOrder:   in.SortOrder,
+	})
+	err = u.orderStateRepo.Save(c, &state)
+	if err != nil {
+		return
+	}
+
+	newId = state.Id
+	return
+}
+
+func (u *ucase) UpdateOrderState(ctx context.Context, in domain.UpdateOrderState) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	// 번역된 내용을 읽어서 그대로 저장하지 않도록 기본 언어로 읽음
+	c = i18n.WithLang(c, i18n.Default)
+
+	var state *domain.OrderState
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() (err error) {
+		state, err = u.orderStateRepo.GetById(gc, in.StateId)
+		if err == nil && state == nil {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	reparent := !equalUint8(state.ParentId, in.ParentId)
+	if state.IsSystem() && (!in.Active || reparent || !equalUint8(state.GroupId, in.GroupId)) {
+		err = domain.ErrSystemOrderState
+		return
+	}
+
+	if reparent {
+		err = u.checkParent(c, state.Id, in.ParentId)
+		if err != nil {
+			return
+		}
+
+		// 의뢰 종류의 작업 시작 상태는 최상위 상태여야 함
+		if in.ParentId != nil {
+			var isRoot bool
+			isRoot, err = u.isOrderTypeRoot(c, state.Id)
+			if err != nil {
+				return
+			}
+
+			if isRoot {
+				err = domain.ErrOrderStateInUse
+				return
+			}
+		}
+	}
+
+	state.Update(domain.CreateOrderStateOption{
+		Content:     in.Content,
+		LongContent: in.LongContent,
+		Emoji:       in.Emoji,
+		ParentId:    in.ParentId,
+		GroupId:     in.GroupId,
+		SortOrder:   in.SortOrder,
+	}, in.Active)
+	return u.orderStateRepo.Save(c, state)
+}
+
+func (u *ucase) SortOrderState(ctx context.Context, in domain.SortOrderState) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() (err error) {
+		list, err := u.orderStateRepo.FetchByIds(gc, in.StateIds)
+		if err != nil {
+			return
+		}
+
+		// 중복이나 없는 상태가 있으면 개수가 맞지 않음
+		if len(list) != len(in.StateIds) {
+			err = domain.ErrWeirdData
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	return u.orderStateRepo.UpdateSortOrder(c, in.StateIds)
+}
+
+func (u *ucase) DeleteOrderState(ctx context.Context, in domain.DeleteOrderState) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var (
+		state *domain.OrderState
+		inUse bool
+	)
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() (err error) {
+		state, err = u.orderStateRepo.GetById(gc, in.StateId)
+		if err == nil && state == nil {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		inUse, err = u.orderStateRepo.IsInUse(gc, in.StateId)
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	if state.IsSystem() {
+		err = domain.ErrSystemOrderState
+		return
+	}
+
+	if inUse {
+		err = domain.ErrOrderStateInUse
+		return
+	}
+
+	return u.orderStateRepo.Delete(c, state.Id)
+}
+
+func (u *ucase) SaveOrderStateTranslation(ctx context.Context, in domain.SaveOrderStateTranslation) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	lang, ok := i18n.Parse(in.Lang)
+	if !ok || lang == i18n.Default {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() (err error) {
+		state, err := u.orderStateRepo.GetById(gc, in.StateId)
+		if err == nil && state == nil {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	return u.orderStateRepo.SaveTranslation(c, &domain.OrderStateTranslation{
+		StateId:     in.StateId,
+		Lang:        string(lang),
+		Content:     in.Content,
+		LongContent: in.LongContent,
+	})
+}
+
+func (u *ucase) DeleteOrderStateTranslation(ctx context.Context, in domain.DeleteOrderStateTranslation) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	lang, ok := i18n.Parse(in.Lang)
+	if !ok || lang == i18n.Default {
+		err = domain.ErrWeirdData
+		return
+	}
+
+	err = domain.CheckUserRole(c, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	if err != nil {
+		return
+	}
+
+	return u.orderStateRepo.DeleteTranslation(c, in.StateId, string(lang))
+}
+
+// checkParent 부모는 켜진 상태여야 하고, 부모를 따라 올라가다 자기 자신이 나오면 순환이라 안 됨,
+// 새 상태는 stateId 가 0
+func (u *ucase) checkParent(ctx context.Context, stateId uint8, parentId *uint8) (err error) {
+	if parentId == nil {
+		return
+	}
+
+	id := *parentId
+	// 상태 아이디가 uint8 이라 순환이 없으면 256 번 안에 최상위에 닿음
+	for depth := 0; depth < 256; depth++ {
+		if id == stateId {
+			return domain.ErrWeirdData
+		}
+
+		var parent *domain.OrderState
+		parent, err = u.orderStateRepo.GetById(ctx, id)
+		if err != nil {
+			return
+		}
+
+		if parent == nil || (depth == 0 && !parent.Active) {
+			return domain.ErrWeirdData
+		}
+
+		if parent.ParentId == nil {
+			return
+		}
+		id = *parent.ParentId
+	}
+	return domain.ErrWeirdData
+}
+
+func (u *ucase) isOrderTypeRoot(ctx context.Context, stateId uint8) (isRoot bool, err error) {
+	types, err := u.orderTypeRepo.FetchAll(ctx, false)
+	if err != nil {
+		return
+	}
+
+	for i := range types {
+		if types[i].RootStateId == stateId {
+			return true, nil
+		}
+	}
+	return
+}
+
+func equalUint8(a, b *uint8) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -10,12 +10,14 @@ import (
 
 func (c *OrderTicketController) internalCreateTicket(ctx echo.Context) error {
 	var req struct {
-		ExOrderId  string `json:"exOrderId" validate:"required"`
-		Username   string `json:"username" validate:"required,email"`
-		Value      uint16 `json:"value" validate:"required,max=30000"`
-		Unit       string `json:"unit" validate:"required,eq=M|eq=D"`
-		OrderCount uint8  `json:"orderCount" validate:"required,max=30"`
-		EditCount  uint8  `json:"editCount" validate:"required,max=60"`
+		ExOrderId            string `json:"exOrderId" validate:"required"`
+		Username             string `json:"username" validate:"required,email"`
+		Value                uint16 `json:"value" validate:"required,max=30000"`
+		Unit                 string `json:"unit" validate:"required,eq=M|eq=D"`
+		OrderCount           uint8  `json:"orderCount" validate:"required,max=30"`
+		EditCount            uint8  `json:"editCount" validate:"required,max=60"`
+		ConcurrentOrderCount uint8  `json:"concurrentOrderCount" validate:"max=10"`
+		MaxPriority          string `json:"maxPriority" validate:"omitempty,oneof=NORMAL HIGH RUSH"`
 	}
 
 	err := ctx.Bind(&req)
@@ -25,12 +27,14 @@ func (c *OrderTicketController) internalCreateTicket(ctx echo.Context) error {
 	}
 
 	newId, err := c.useCase.CreateSubscribeTicket(ctx.Request().Context(), domain.CreateSubscribeTicket{
-		ExOrderId:  req.ExOrderId,
-		Username:   req.Username,
-		Value:      req.Value,
-		Unit:       domain.SubscribeUnit(req.Unit),
-		OrderCount: req.OrderCount,
-		EditCount:  req.EditCount,
+		ExOrderId:            req.ExOrderId,
+		Username:             req.Username,
+		Value:                req.Value,
+		Unit:                 domain.SubscribeUnit(req.Unit),
+		OrderCount:           req.OrderCount,
+		EditCount:            req.EditCount,
+		ConcurrentOrderCount: req.ConcurrentOrderCount,
+		MaxPriority:          domain.OrderPriority(req.MaxPriority),
 	})
 
 	switch err {
@@ -7,6 +7,7 @@ import (
 	"github.com/stockfolioofficial/back-editfolio/domain"
 	"github.com/stockfolioofficial/back-editfolio/util/gormx"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
@@ -26,7 +27,11 @@ func (r *repo) Transaction(ctx context.Context, fn func(orderTicketRepo domain.O
 }
 
 func (r *repo) Save(ctx context.Context, orderTicket *domain.OrderTicket) error {
-	return gormx.Upsert(ctx, r.db, orderTicket)
+	saved, err := gormx.SaveWithVersion(ctx, r.db, orderTicket, &orderTicket.Version)
+	if err == nil && !saved {
+		err = domain.ErrVersionConflict
+	}
+	return err
 }
 
 func (r *repo) GetById(ctx context.Context, id uuid.UUID) (res *domain.OrderTicket, err error) {
@@ -86,6 +91,36 @@ func (r *repo) GetByOwnerIdBetweenStartAndEnd(ctx context.Context, id uuid.UUID,
 	return
 }
 
+func (r *repo) LockByOwnerIdBetweenStartAndEnd(ctx context.Context, id uuid.UUID, at time.Time) (res *domain.OrderTicket, err error) {
+	var entity domain.OrderTicket
+	err = r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("`owner_id` = ?", id).
+		Where("? between `start_at` AND `end_at`", at).
+		First(&entity).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) LockById(ctx context.Context, id uuid.UUID) (res *domain.OrderTicket, err error) {
+	var entity domain.OrderTicket
+	err = r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		First(&entity, "`id` = ?", id).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
 func (r *repo) Get() *gorm.DB {
 	return r.db
 }
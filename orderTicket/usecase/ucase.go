@@ -92,12 +92,14 @@ func (u *ucase) CreateSubscribeTicket(ctx context.Context, in domain.CreateSubsc
 	}
 
 	newTicket := domain.CreateOrderTicket(domain.CreateOrderTicketOption{
-		ExOrderId:       in.ExOrderId,
-		OwnerId:         userId,
-		TotalOrderCount: in.OrderCount,
-		EditCount:       in.EditCount,
-		StartAt:         &startAt,
-		EndAt:           &endAt,
+		ExOrderId:            in.ExOrderId,
+		OwnerId:              userId,
+		TotalOrderCount:      in.OrderCount,
+		EditCount:            in.EditCount,
+		ConcurrentOrderCount: in.ConcurrentOrderCount,
+		MaxPriority:          in.MaxPriority,
+		StartAt:              &startAt,
+		EndAt:                &endAt,
 	})
 
 	err = u.orderTicketRepo.Save(c, &newTicket)
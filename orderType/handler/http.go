@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+const (
+	tag = "[ORDER_TYPE] "
+)
+
+func NewOrderTypeController(useCase domain.OrderTypeUseCase) *OrderTypeController {
+	return &OrderTypeController{useCase: useCase}
+}
+
+type OrderTypeController struct {
+	useCase domain.OrderTypeUseCase
+}
+
+func (c *OrderTypeController) Bind(e *echo.Echo) {
+	e.GET("/order-type", echox.UserID(c.fetchOrderType),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole, domain.AdminUserRole, domain.SuperAdminUserRole))
+
+	//SUPER_ADMIN
+	e.POST("/order-type", echox.UserID(c.addOrderType),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+	e.PUT("/order-type/:typeId", echox.UserID(c.updateOrderType),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole))
+}
+
+type OrderTypeResponse struct {
+	TypeId      uint8  `json:"typeId" validate:"required" example:"1"`
+	Code        string `json:"code" validate:"required" example:"SHORTS"`
+	Name        string `json:"name" validate:"required" example:"숏폼"`
+	Description string `json:"description" validate:"required" example:"1분 이내 세로 영상"`
+
+	// DefaultEditCount 수정 가능 횟수, 0 이면 구독 플랜의 수정 횟수 사용
+	DefaultEditCount uint8 `json:"defaultEditCount" validate:"required" example:"2"`
+
+	// TurnaroundDays 기본 작업 기간(일)
+	TurnaroundDays int `json:"turnaroundDays" validate:"required" example:"3"`
+
+	// CreditCost 의뢰 한 건에 차감되는 의뢰 횟수
+	CreditCost uint8 `json:"creditCost" validate:"required" example:"1"`
+
+	// RootStateId 편집자가 맡았을 때의 상태 아이디
+	RootStateId uint8 `json:"rootStateId" validate:"required" example:"2"`
+	Active      bool  `json:"active" validate:"required" example:"true"`
+} // @name OrderTypeResponse
+
+// @Tags (Order Type) 의뢰 종류
+// @Security Auth-Jwt-Bearer
+// @Summary 의뢰 종류 목록
+// @Description 의뢰할 때 고를 수 있는 종류 목록, 고객은 사용 중인 종류만 조회됨
+// @Produce json
+// @Success 200 {array} OrderTypeResponse true "의뢰 종류 목록"
+// @Router /order-type [get]
+func (c *OrderTypeController) fetchOrderType(ctx echo.Context, userId uuid.UUID) error {
+	list, err := c.useCase.Fetch(ctx.Request().Context(), userId)
+
+	switch err {
+	case nil:
+		res := make([]OrderTypeResponse, len(list))
+		for i := range list {
+			res[i] = OrderTypeResponse{
+				TypeId:           list[i].Id,
+				Code:             string(list[i].Code),
+				Name:             list[i].Name,
+				Description:      list[i].Description,
+				DefaultEditCount: list[i].DefaultEditCount,
+				TurnaroundDays:   list[i].TurnaroundDays,
+				CreditCost:       list[i].CreditCost,
+				RootStateId:      list[i].RootStateId,
+				Active:           list[i].Active,
+			}
+		}
+		return ctx.JSON(http.StatusOK, res)
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "fetchOrderType, unhandled error useCase.Fetch")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type AddOrderTypeRequest struct {
+	Code        string `json:"code" validate:"required,max=20" example:"SHORTS"`
+	Name        string `json:"name" validate:"required,max=60" example:"숏폼"`
+	Description string `json:"description" validate:"max=300" example:"1분 이내 세로 영상"`
+
+	// DefaultEditCount 수정 가능 횟수, 0 이면 구독 플랜의 수정 횟수 사용
+	DefaultEditCount uint8 `json:"defaultEditCount" example:"2"`
+
+	// TurnaroundDays 기본 작업 기간(일), 0 이면 우선순위별 마감일 사용
+	TurnaroundDays int `json:"turnaroundDays" validate:"min=0" example:"3"`
+
+	// CreditCost 의뢰 한 건에 차감되는 의뢰 횟수
+	CreditCost uint8 `json:"creditCost" validate:"required" example:"1"`
+
+	// RootStateId 편집자가 맡았을 때의 상태 아이디, 하위 상태가 있는 최상위 상태여야 함
+	RootStateId uint8 `json:"rootStateId" validate:"required" example:"2"`
+} // @name AddOrderTypeRequest
+
+type AddOrderTypeResponse struct {
+	TypeId uint8 `json:"typeId" validate:"required" example:"4"`
+} // @name AddOrderTypeResponse
+
+// @Tags (Order Type) 의뢰 종류
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 의뢰 종류 추가
+// @Description 새 의뢰 종류를 추가하는 기능
+// @Accept json
+// @Produce json
+// @Param requestBody body AddOrderTypeRequest true "의뢰 종류 데이터 구조"
+// @Success 201 {object} AddOrderTypeResponse true "추가 완료"
+// @Router /order-type [post]
+func (c *OrderTypeController) addOrderType(ctx echo.Context, userId uuid.UUID) error {
+	var req AddOrderTypeRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "add order type, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	newId, err := c.useCase.AddOrderType(ctx.Request().Context(), domain.AddOrderType{
+		UserId:           userId,
+		Code:             domain.OrderTypeCode(req.Code),
+		Name:             req.Name,
+		Description:      req.Description,
+		DefaultEditCount: req.DefaultEditCount,
+		TurnaroundDays:   req.TurnaroundDays,
+		CreditCost:       req.CreditCost,
+		RootStateId:      req.RootStateId,
+	})
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusCreated, AddOrderTypeResponse{TypeId: newId})
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid root state"})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "addOrderType, unhandled error useCase.AddOrderType")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type UpdateOrderTypeRequest struct {
+	TypeId      uint8  `json:"-" param:"typeId" validate:"required" example:"1"`
+	Name        string `json:"name" validate:"required,max=60" example:"숏폼"`
+	Description string `json:"description" validate:"max=300" example:"1분 이내 세로 영상"`
+
+	// DefaultEditCount 수정 가능 횟수, 0 이면 구독 플랜의 수정 횟수 사용
+	DefaultEditCount uint8 `json:"defaultEditCount" example:"2"`
+
+	// TurnaroundDays 기본 작업 기간(일), 0 이면 우선순위별 마감일 사용
+	TurnaroundDays int `json:"turnaroundDays" validate:"min=0" example:"3"`
+
+	// CreditCost 의뢰 한 건에 차감되는 의뢰 횟수
+	CreditCost uint8 `json:"creditCost" validate:"required" example:"1"`
+
+	// RootStateId 편집자가 맡았을 때의 상태 아이디, 하위 상태가 있는 최상위 상태여야 함
+	RootStateId uint8 `json:"rootStateId" validate:"required" example:"2"`
+
+	// Active false 면 고객이 더 이상 고를 수 없음, 진행 중인 의뢰에는 영향 없음
+	Active bool `json:"active" example:"true"`
+} // @name UpdateOrderTypeRequest
+
+// @Tags (Order Type) 의뢰 종류
+// @Security Auth-Jwt-Bearer
+// @Summary [최고 관리자] 의뢰 종류 수정
+// @Description 의뢰 종류의 수정 횟수, 작업 기간, 차감 횟수, 작업 상태를 바꾸는 기능, 이미 접수된 의뢰에는 적용되지 않음
+// @Accept json
+// @Param type_id path integer true "의뢰 종류 아이디"
+// @Param requestBody body UpdateOrderTypeRequest true "의뢰 종류 데이터 구조"
+// @Success 204 "수정 완료"
+// @Router /order-type/{type_id} [put]
+func (c *OrderTypeController) updateOrderType(ctx echo.Context, userId uuid.UUID) error {
+	var req UpdateOrderTypeRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "update order type, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	err = c.useCase.UpdateOrderType(ctx.Request().Context(), domain.UpdateOrderType{
+		UserId:           userId,
+		TypeId:           req.TypeId,
+		Name:             req.Name,
+		Description:      req.Description,
+		DefaultEditCount: req.DefaultEditCount,
+		TurnaroundDays:   req.TurnaroundDays,
+		CreditCost:       req.CreditCost,
+		RootStateId:      req.RootStateId,
+		Active:           req.Active,
+	})
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrWeirdData:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid root state"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "updateOrderType, unhandled error useCase.UpdateOrderType")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
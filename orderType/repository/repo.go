@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"gorm.io/gorm"
+)
+
+func NewOrderTypeRepository(db *gorm.DB) domain.OrderTypeRepository {
+	db.AutoMigrate(&domain.OrderType{})
+	return &repo{db: db}
+}
+
+type repo struct {
+	db *gorm.DB
+}
+
+func (r *repo) Save(ctx context.Context, orderType *domain.OrderType) error {
+	return gormx.Upsert(ctx, r.db, orderType)
+}
+
+func (r *repo) GetById(ctx context.Context, id uint8) (res *domain.OrderType, err error) {
+	var entity domain.OrderType
+	err = r.db.WithContext(ctx).First(&entity, id).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) GetByCode(ctx context.Context, code domain.OrderTypeCode) (res *domain.OrderType, err error) {
+	var entity domain.OrderType
+	err = r.db.WithContext(ctx).First(&entity, "`code` = ?", code).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) FetchAll(ctx context.Context, onlyActive bool) (list []domain.OrderType, err error) {
+	db := r.db.WithContext(ctx).
+		Order("`id` asc")
+	if onlyActive {
+		db = db.Where("`active` = ?", true)
+	}
+
+	err = db.Find(&list).Error
+	return
+}
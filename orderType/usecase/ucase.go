@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+func NewOrderTypeUseCase(
+	orderTypeRepo domain.OrderTypeRepository,
+	orderStateRepo domain.OrderStateRepository,
+	userRepo domain.UserRepository,
+	timeout time.Duration,
+) domain.OrderTypeUseCase {
+	return &ucase{
+		orderTypeRepo:  orderTypeRepo,
+		orderStateRepo: orderStateRepo,
+		userRepo:       userRepo,
+		timeout:        timeout,
+	}
+}
+
+type ucase struct {
+	orderTypeRepo  domain.OrderTypeRepository
+	orderStateRepo domain.OrderStateRepository
+	userRepo       domain.UserRepository
+	timeout        time.Duration
+}
+
+func (u *ucase) Fetch(ctx context.Context, userId uuid.UUID) (res []domain.OrderTypeInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	user, err := u.userRepo.GetById(c, userId)
+	if err != nil {
+		return
+	}
+
+	if !domain.CheckUserAlive(user, domain.User.IsCustomer, domain.User.IsAdmin, domain.User.IsSuperAdmin) {
+		err = domain.ErrNoPermission
+		return
+	}
+
+	list, err := u.orderTypeRepo.FetchAll(c, user.IsCustomer())
+	if err != nil {
+		return
+	}
+
+	res = make([]domain.OrderTypeInfo, len(list))
+	for i := range list {
+		res[i] = domain.OrderTypeInfo{
+			Id:               list[i].Id,
+			Code:             list[i].Code,
+			Name:             list[i].Name,
+			Description:      list[i].Description,
+			DefaultEditCount: list[i].DefaultEditCount,
+			TurnaroundDays:   list[i].TurnaroundDays,
+			CreditCost:       list[i].CreditCost,
+			RootStateId:      list[i].RootStateId,
+			Active:           list[i].Active,
+		}
+	}
+	return
+}
+
+func (u *ucase) AddOrderType(ctx context.Context, in domain.AddOrderType) (newId uint8, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() error {
+		return u.checkRootState(gc, in.RootStateId)
+	})
+	g.Go(func() (err error) {
+		exists, err := u.orderTypeRepo.GetByCode(gc, in.Code)
+		if err == nil && exists != nil {
+			err = domain.ErrItemAlreadyExist
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	orderType := domain.CreateOrderType(domain.CreateOrderTypeOption{
+		Code:             in.Code,
+		Name:             in.Name,
+		Description:      in.Description,
+		DefaultEditCount: in.DefaultEditCount,
+		TurnaroundDays:   in.TurnaroundDays,
+		CreditCost:       in.CreditCost,
+		RootStateId:      in.RootStateId,
+	})
+	err = u.orderTypeRepo.Save(c, &orderType)
+	if err != nil {
+		return
+	}
+
+	newId = orderType.Id
+	return
+}
+
+func (u *ucase) UpdateOrderType(ctx context.Context, in domain.UpdateOrderType) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	var orderType *domain.OrderType
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() error {
+		return domain.CheckUserRole(gc, u.userRepo, in.UserId, domain.User.IsSuperAdmin)
+	})
+	g.Go(func() error {
+		return u.checkRootState(gc, in.RootStateId)
+	})
+	g.Go(func() (err error) {
+		orderType, err = u.orderTypeRepo.GetById(gc, in.TypeId)
+		if err == nil && orderType == nil {
+			err = domain.ErrItemNotFound
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	orderType.Update(domain.CreateOrderTypeOption{
+		Name:             in.Name,
+		Description:      in.Description,
+		DefaultEditCount: in.DefaultEditCount,
+		TurnaroundDays:   in.TurnaroundDays,
+		CreditCost:       in.CreditCost,
+		RootStateId:      in.RootStateId,
+	}, in.Active)
+	return u.orderTypeRepo.Save(c, orderType)
+}
+
+// checkRootState 최상위 상태이고 하위 작업 상태가 있어야 종류별 작업 흐름으로 쓸 수 있음
+func (u *ucase) checkRootState(ctx context.Context, stateId uint8) (err error) {
+	state, err := u.orderStateRepo.GetById(ctx, stateId)
+	if err != nil {
+		return
+	}
+
+	if state == nil || state.ParentId != nil {
+		return domain.ErrWeirdData
+	}
+
+	children, err := u.orderStateRepo.FetchByParentId(ctx, stateId)
+	if err != nil {
+		return
+	}
+
+	if len(children) == 0 {
+		err = domain.ErrWeirdData
+	}
+	return
+}
@@ -0,0 +1,47 @@
+package handler
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stockfolioofficial/back-editfolio/core/debug"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/echox"
+)
+
+const (
+	tag = "[ORDER-UPLOAD] "
+)
+
+func NewOrderUploadController(useCase domain.OrderUploadUseCase) *OrderUploadController {
+	return &OrderUploadController{useCase: useCase}
+}
+
+type OrderUploadController struct {
+	useCase domain.OrderUploadUseCase
+}
+
+func (c *OrderUploadController) Bind(e *echo.Echo) {
+	//CUSTOMER
+	// 원본 영상 업로드 시작
+	e.POST("/order/:orderId/upload", echox.UserID(c.startOrderUpload),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	// 업로드 진행 상황, 이어 올리기 전에 받은 청크 확인
+	e.GET("/upload/:uploadId", echox.UserID(c.getMyOrderUpload),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	// 청크 업로드
+	e.PUT("/upload/:uploadId/chunk/:index", echox.UserID(c.uploadOrderChunk),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+	// 업로드 완료
+	e.POST("/upload/:uploadId/complete", echox.UserID(c.completeOrderUpload),
+		debug.JwtBypassOnDebugWithRole(domain.CustomerUserRole))
+
+	//ADMIN
+	e.GET("/order/:orderId/upload", c.fetchOrderUpload,
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole))
+
+	//CUSTOMER, ADMIN, 의뢰 참여자만
+	e.GET("/upload/:uploadId/download-url", echox.UserID(c.getDownloadURL),
+		debug.JwtBypassOnDebugWithRole(domain.SuperAdminUserRole, domain.AdminUserRole, domain.CustomerUserRole))
+
+	//PUBLIC, 서명으로 인증
+	e.GET("/storage/file", c.downloadSignedFile)
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 원본 영상 목록
+// @Description 의뢰에 올라온 원본 영상 업로드 목록을 가져오는 기능, 역할(role)이 'ADMIN', 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Success 200 {object} OrderUploadInfoListResponse true "업로드 목록"
+// @Success 204 "업로드 없음"
+// @Router /order/{order_id}/upload [get]
+func (c *OrderUploadController) fetchOrderUpload(ctx echo.Context) error {
+	var req struct {
+		OrderId uuid.UUID `json:"-" param:"orderId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "fetch order upload, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	list, err := c.useCase.FetchByOrderId(ctx.Request().Context(), req.OrderId)
+	if err != nil {
+		log.WithError(err).Error(tag, "fetchOrderUpload, unhandled error useCase.FetchByOrderId")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+
+	if len(list) == 0 {
+		return ctx.NoContent(http.StatusNoContent)
+	}
+
+	return ctx.JSON(http.StatusOK, useCaseToOrderUploadInfoListResponse(list))
+}
+
+type DownloadURLResponse struct {
+	// Url 일정 시간 동안 인증 없이 내려받을 수 있는 서명 URL
+	Url string `json:"url" validate:"required" example:"https://api.editfolio.com/storage/file?exp=1635313458&key=order%2F...&sig=..."`
+} // @name DownloadURLResponse
+
+// @Tags (Order) 어드민 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [어드민] 원본 영상 다운로드 URL
+// @Description 업로드가 완료된 원본 영상의 서명된 다운로드 URL 을 발급하는 기능, 의뢰한 고객, 담당 편집자이거나 역할(role)이 'SUPER_ADMIN' 이여야함
+// @Accept json
+// @Produce json
+// @Param upload_id path string true "업로드 식별 아이디(UUID)"
+// @Success 200 {object} DownloadURLResponse true "다운로드 URL"
+// @Router /upload/{upload_id}/download-url [get]
+func (c *OrderUploadController) getDownloadURL(ctx echo.Context, userId uuid.UUID) error {
+	var req struct {
+		UploadId uuid.UUID `json:"-" param:"uploadId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "get download url, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	link, err := c.useCase.GetDownloadURL(ctx.Request().Context(), userId, req.UploadId)
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusOK, DownloadURLResponse{Url: link})
+	case domain.ErrIncompleteUpload:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "not completed upload"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusForbidden, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "getDownloadURL, unhandled error useCase.GetDownloadURL")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type SignedFileRequest struct {
+	Key       string `query:"key" validate:"required"`
+	Expires   int64  `query:"exp" validate:"required"`
+	Signature string `query:"sig" validate:"required"`
+} // @name SignedFileRequest
+
+// @Tags (Order) 어드민 기능
+// @Summary 서명 URL 파일 다운로드
+// @Description 다운로드 URL 로 발급된 파일을 내려받는 기능, 로컬 저장소를 사용할 때만 쓰임
+// @Produce octet-stream
+// @Param key query string true "파일 키"
+// @Param exp query int true "만료 시각 (unix)"
+// @Param sig query string true "서명"
+// @Success 200 "파일"
+// @Router /storage/file [get]
+func (c *OrderUploadController) downloadSignedFile(ctx echo.Context) error {
+	var req SignedFileRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "download signed file, request query bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	file, err := c.useCase.OpenSignedFile(ctx.Request().Context(), req.Key, req.Expires, req.Signature)
+
+	switch err {
+	case nil:
+		defer file.Close()
+		return ctx.Stream(http.StatusOK, echo.MIMEOctetStream, file)
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusForbidden, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "downloadSignedFile, unhandled error useCase.OpenSignedFile")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
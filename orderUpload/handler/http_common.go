@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+type OrderUploadInfoResponse struct {
+	UploadId    uuid.UUID `json:"uploadId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	OrderId     uuid.UUID `json:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	FileName    string    `json:"fileName" validate:"required" example:"원본.mp4"`
+	ContentType string    `json:"contentType" validate:"required" example:"video/mp4"`
+	Size        int64     `json:"size" validate:"required" example:"104857600"`
+
+	// ChunkSize 청크 크기 (byte), 마지막 청크만 이보다 작을 수 있음
+	ChunkSize  int64  `json:"chunkSize" validate:"required" example:"8388608"`
+	ChunkCount uint32 `json:"chunkCount" validate:"required" example:"13"`
+
+	// ReceivedChunks 서버가 받은 청크 인덱스 목록, 빠진 인덱스만 다시 올리면 됨
+	ReceivedChunks []uint32 `json:"receivedChunks" validate:"required" example:"0,1,2"`
+
+	Checksum string `json:"checksum" validate:"required" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+
+	// State 업로드 상태
+	// * UPLOADING - 업로드 중
+	// * COMPLETED - 업로드 완료
+	State domain.OrderUploadState `json:"state" validate:"required" example:"UPLOADING" enums:"UPLOADING,COMPLETED"`
+
+	CreatedAt   time.Time  `json:"createdAt" validate:"required" example:"2021-10-27T04:44:18+00:00"`
+	CompletedAt *time.Time `json:"completedAt" example:"2021-10-27T05:44:18+00:00"`
+} // @name OrderUploadInfoResponse
+
+type OrderUploadInfoListResponse []OrderUploadInfoResponse
+
+func useCaseToOrderUploadInfoResponse(src domain.OrderUploadInfo) OrderUploadInfoResponse {
+	return OrderUploadInfoResponse{
+		UploadId:       src.UploadId,
+		OrderId:        src.OrderId,
+		FileName:       src.FileName,
+		ContentType:    src.ContentType,
+		Size:           src.Size,
+		ChunkSize:      src.ChunkSize,
+		ChunkCount:     src.ChunkCount,
+		ReceivedChunks: src.ReceivedChunks,
+		Checksum:       src.Checksum,
+		State:          src.State,
+		CreatedAt:      src.CreatedAt,
+		CompletedAt:    src.CompletedAt,
+	}
+}
+
+func useCaseToOrderUploadInfoListResponse(list []domain.OrderUploadInfo) (res OrderUploadInfoListResponse) {
+	res = make(OrderUploadInfoListResponse, len(list))
+	for i := range list {
+		res[i] = useCaseToOrderUploadInfoResponse(list[i])
+	}
+
+	return
+}
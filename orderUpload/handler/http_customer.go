@@ -0,0 +1,223 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+const (
+	headerChunkSha256 = "X-Chunk-Sha256"
+)
+
+type StartOrderUploadRequest struct {
+	OrderId     uuid.UUID `json:"-" param:"orderId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	FileName    string    `json:"fileName" validate:"required,max=300" example:"원본.mp4"`
+	ContentType string    `json:"contentType" validate:"required,max=100" example:"video/mp4"`
+	Size        int64     `json:"size" validate:"required,min=1" example:"104857600"`
+
+	// Checksum 전체 파일의 sha256 (hex)
+	Checksum string `json:"checksum" validate:"required,len=64,hexadecimal" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+} // @name StartOrderUploadRequest
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 원본 영상 업로드 시작
+// @Description 의뢰에 원본 영상을 올리기 위한 업로드를 만드는 기능, 응답의 chunkSize 단위로 나눠 올려야함, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Produce json
+// @Param order_id path string true "의뢰 식별 아이디(UUID)"
+// @Param requestBody body StartOrderUploadRequest true "업로드 시작 데이터 구조"
+// @Success 201 {object} OrderUploadInfoResponse true "업로드 생성 완료"
+// @Router /order/{order_id}/upload [post]
+func (c *OrderUploadController) startOrderUpload(ctx echo.Context, userId uuid.UUID) error {
+	var req StartOrderUploadRequest
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "start order upload, request body bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	var in = domain.StartOrderUpload{
+		UserId:      userId,
+		OrderId:     req.OrderId,
+		FileName:    req.FileName,
+		ContentType: req.ContentType,
+		Size:        req.Size,
+		Checksum:    req.Checksum,
+	}
+	info, err := c.useCase.StartOrderUpload(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusCreated, useCaseToOrderUploadInfoResponse(info))
+	case domain.ErrNotAllowedUpload:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "not allowed file size or type"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", in).
+			Error(tag, "startOrderUpload, unhandled error useCase.StartOrderUpload")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 원본 영상 업로드 진행 상황
+// @Description 서버가 받은 청크 목록을 가져오는 기능, 끊긴 업로드를 이어 올릴 때 사용, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Produce json
+// @Param upload_id path string true "업로드 식별 아이디(UUID)"
+// @Success 200 {object} OrderUploadInfoResponse true "업로드 정보"
+// @Router /upload/{upload_id} [get]
+func (c *OrderUploadController) getMyOrderUpload(ctx echo.Context, userId uuid.UUID) error {
+	var req struct {
+		UploadId uuid.UUID `json:"-" param:"uploadId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "get my order upload, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	info, err := c.useCase.GetMyOrderUpload(ctx.Request().Context(), userId, req.UploadId)
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusOK, useCaseToOrderUploadInfoResponse(info))
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).Error(tag, "getMyOrderUpload, unhandled error useCase.GetMyOrderUpload")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+type UploadOrderChunkRequest struct {
+	UploadId uuid.UUID `param:"uploadId" validate:"required" example:"550e8400-e29b-41d4-a716-446655440000"`
+	Index    uint32    `param:"index" example:"0"`
+} // @name UploadOrderChunkRequest
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 청크 업로드
+// @Description 청크 하나를 body 그대로 올리는 기능, 같은 인덱스를 다시 올리면 덮어씀, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept octet-stream
+// @Param upload_id path string true "업로드 식별 아이디(UUID)"
+// @Param index path int true "청크 인덱스 (0부터 시작)"
+// @Param X-Chunk-Sha256 header string false "청크의 sha256 (hex), 주면 서버에서 검증함"
+// @Success 204 "청크 업로드 완료"
+// @Router /upload/{upload_id}/chunk/{index} [put]
+func (c *OrderUploadController) uploadOrderChunk(ctx echo.Context, userId uuid.UUID) error {
+	var req UploadOrderChunkRequest
+	// body 는 청크 데이터이므로 path 만 바인딩
+	err := (&echo.DefaultBinder{}).BindPathParams(ctx, &req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "upload order chunk, request path bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	request := ctx.Request()
+	if request.ContentLength <= 0 {
+		return ctx.JSON(http.StatusLengthRequired, domain.ErrorResponse{Message: "content length required"})
+	}
+
+	var checksum *string
+	if value := request.Header.Get(headerChunkSha256); value != "" {
+		checksum = &value
+	}
+
+	var in = domain.UploadOrderChunk{
+		UserId:   userId,
+		UploadId: req.UploadId,
+		Index:    req.Index,
+		Size:     request.ContentLength,
+		Checksum: checksum,
+		Body:     request.Body,
+	}
+	err = c.useCase.UploadOrderChunk(request.Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.NoContent(http.StatusNoContent)
+	case domain.ErrNotAllowedUpload:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "invalid chunk index or size"})
+	case domain.ErrChecksumMismatch:
+		return ctx.JSON(http.StatusUnprocessableEntity, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "already completed upload"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("uploadId", in.UploadId).
+			WithField("index", in.Index).
+			Error(tag, "uploadOrderChunk, unhandled error useCase.UploadOrderChunk")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
+
+// @Tags (Order) 고객 기능
+// @Security Auth-Jwt-Bearer
+// @Summary [고객] 원본 영상 업로드 완료
+// @Description 모든 청크를 합치고 전체 checksum 을 검증하는 기능, 검증에 실패하면 받은 청크를 모두 지우므로 처음부터 다시 올려야함, 역할(role)이 'CUSTOMER' 이여야함
+// @Accept json
+// @Produce json
+// @Param upload_id path string true "업로드 식별 아이디(UUID)"
+// @Success 200 {object} OrderUploadInfoResponse true "업로드 완료"
+// @Router /upload/{upload_id}/complete [post]
+func (c *OrderUploadController) completeOrderUpload(ctx echo.Context, userId uuid.UUID) error {
+	var req struct {
+		UploadId uuid.UUID `json:"-" param:"uploadId"`
+	}
+	err := ctx.Bind(&req)
+	if err != nil {
+		log.WithError(err).Trace(tag, "complete order upload, request data bind error")
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{
+			Message: err.Error(),
+		})
+	}
+
+	var in = domain.CompleteOrderUpload{
+		UserId:   userId,
+		UploadId: req.UploadId,
+	}
+	info, err := c.useCase.CompleteOrderUpload(ctx.Request().Context(), in)
+
+	switch err {
+	case nil:
+		return ctx.JSON(http.StatusOK, useCaseToOrderUploadInfoResponse(info))
+	case domain.ErrIncompleteUpload:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: "missing chunks"})
+	case domain.ErrChecksumMismatch:
+		return ctx.JSON(http.StatusUnprocessableEntity, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrItemAlreadyExist:
+		return ctx.JSON(http.StatusConflict, domain.ErrorResponse{Message: "already completed upload"})
+	case domain.ErrItemNotFound:
+		return ctx.JSON(http.StatusNotFound, domain.ErrorResponse{Message: err.Error()})
+	case domain.ErrNoPermission:
+		return ctx.JSON(http.StatusUnauthorized, domain.NoPermissionResponse)
+	default:
+		log.WithError(err).
+			WithField("in", in).
+			Error(tag, "completeOrderUpload, unhandled error useCase.CompleteOrderUpload")
+		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
+	}
+}
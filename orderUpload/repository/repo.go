@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"github.com/stockfolioofficial/back-editfolio/util/gormx"
+	"gorm.io/gorm"
+)
+
+func NewOrderUploadRepository(db *gorm.DB) domain.OrderUploadRepository {
+	db.AutoMigrate(&domain.OrderUpload{}, &domain.OrderUploadChunk{})
+	return &repo{db: db}
+}
+
+type repo struct {
+	db *gorm.DB
+}
+
+func (r *repo) Save(ctx context.Context, upload *domain.OrderUpload) error {
+	return gormx.Upsert(ctx, r.db, upload)
+}
+
+func (r *repo) SaveChunk(ctx context.Context, chunk *domain.OrderUploadChunk) error {
+	return gormx.Upsert(ctx, r.db, chunk)
+}
+
+func (r *repo) DeleteChunks(ctx context.Context, uploadId uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("`upload_id` = ?", uploadId).
+		Delete(&domain.OrderUploadChunk{}).
+		Error
+}
+
+func (r *repo) Transaction(ctx context.Context, fn func(uploadRepo domain.OrderUploadTxRepository) error, options ...*sql.TxOptions) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(&repo{db: tx})
+	}, options...)
+}
+
+func (r *repo) With(tx gormx.Tx) domain.OrderUploadTxRepository {
+	return &repo{db: tx.Get()}
+}
+
+func (r *repo) Get() *gorm.DB {
+	return r.db
+}
+
+func (r *repo) GetById(ctx context.Context, id uuid.UUID) (res *domain.OrderUpload, err error) {
+	var entity domain.OrderUpload
+	err = r.db.WithContext(ctx).
+		Preload("Chunks", func(db *gorm.DB) *gorm.DB {
+			return db.Order("`chunk_index` asc")
+		}).
+		First(&entity, "`id` = ?", id).Error
+	if err == nil {
+		res = &entity
+	} else if err == gorm.ErrRecordNotFound {
+		err = nil
+	}
+
+	return
+}
+
+func (r *repo) FetchByOrderId(ctx context.Context, orderId uuid.UUID) (list []domain.OrderUpload, err error) {
+	err = r.db.WithContext(ctx).
+		Preload("Chunks", func(db *gorm.DB) *gorm.DB {
+			return db.Order("`chunk_index` asc")
+		}).
+		Order("`created_at` asc").
+		Where("`order_id` = ?", orderId).
+		Find(&list).Error
+	return
+}
@@ -0,0 +1,347 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"mime"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	tag = "[ORDER-UPLOAD] "
+
+	chunkContentType = "application/octet-stream"
+)
+
+func NewOrderUploadUseCase(
+	orderUploadRepo domain.OrderUploadRepository,
+	orderRepo domain.OrderRepository,
+	userRepo domain.UserRepository,
+	orderUseCase domain.OrderUseCase,
+	storage domain.StorageAdapter,
+	timeout time.Duration,
+) domain.OrderUploadUseCase {
+	return &ucase{
+		orderUploadRepo: orderUploadRepo,
+		orderRepo:       orderRepo,
+		userRepo:        userRepo,
+		orderUseCase:    orderUseCase,
+		storage:         storage,
+		timeout:         timeout,
+	}
+}
+
+type ucase struct {
+	orderUploadRepo domain.OrderUploadRepository
+	orderRepo       domain.OrderRepository
+	userRepo        domain.UserRepository
+	orderUseCase    domain.OrderUseCase
+	storage         domain.StorageAdapter
+	timeout         time.Duration
+}
+
+func (u *ucase) StartOrderUpload(ctx context.Context, in domain.StartOrderUpload) (res domain.OrderUploadInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	checksum := strings.ToLower(in.Checksum)
+	if in.Size <= 0 || in.Size > config.Upload.MaxSize ||
+		!isAllowedContentType(in.ContentType) ||
+		!isSha256Hex(checksum) {
+		err = domain.ErrNotAllowedUpload
+		return
+	}
+
+	g, gc := errgroup.WithContext(c)
+	g.Go(func() (err error) {
+		user, err := u.userRepo.GetById(gc, in.UserId)
+		if err != nil {
+			return
+		}
+
+		if !domain.CheckUserAlive(user, domain.User.IsCustomer) {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	g.Go(func() (err error) {
+		order, err := u.orderRepo.GetById(gc, in.OrderId)
+		if err != nil {
+			return
+		}
+
+		if order == nil || order.IsDone() {
+			err = domain.ErrItemNotFound
+			return
+		}
+
+		if order.Orderer != in.UserId {
+			err = domain.ErrNoPermission
+		}
+		return
+	})
+	err = g.Wait()
+	if err != nil {
+		return
+	}
+
+	upload := domain.CreateOrderUpload(domain.CreateOrderUploadOption{
+		OrderId:     in.OrderId,
+		UploaderId:  in.UserId,
+		FileName:    in.FileName,
+		ContentType: in.ContentType,
+		Size:        in.Size,
+		ChunkSize:   config.Upload.ChunkSize,
+		Checksum:    checksum,
+	})
+
+	err = u.orderUploadRepo.Save(c, &upload)
+	if err != nil {
+		return
+	}
+
+	res = domainToOrderUploadInfo(upload)
+	return
+}
+
+func (u *ucase) UploadOrderChunk(ctx context.Context, in domain.UploadOrderChunk) (err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	upload, err := u.getMyUpload(c, in.UserId, in.UploadId)
+	if err != nil {
+		return
+	}
+
+	if upload.IsCompleted() {
+		err = domain.ErrItemAlreadyExist
+		return
+	}
+
+	if in.Index >= upload.ChunkCount() || in.Size != upload.ExpectedChunkSize(in.Index) {
+		err = domain.ErrNotAllowedUpload
+		return
+	}
+
+	// 같은 인덱스를 다시 올리면 덮어씀, 끊긴 청크는 그대로 재전송하면 됨
+	key := upload.ChunkKey(in.Index)
+	hash := sha256.New()
+	body := io.TeeReader(io.LimitReader(in.Body, in.Size), hash)
+	err = u.storage.Put(c, key, body, in.Size, chunkContentType)
+	if err != nil {
+		return
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if in.Checksum != nil && !strings.EqualFold(*in.Checksum, checksum) {
+		if dErr := u.storage.Delete(c, key); dErr != nil {
+			log.WithError(dErr).Error(tag, "uploadOrderChunk, storage.Delete mismatched chunk")
+		}
+
+		err = domain.ErrChecksumMismatch
+		return
+	}
+
+	return u.orderUploadRepo.SaveChunk(c, &domain.OrderUploadChunk{
+		UploadId:   upload.Id,
+		ChunkIndex: in.Index,
+		Size:       in.Size,
+		Checksum:   checksum,
+		CreatedAt:  time.Now(),
+	})
+}
+
+func (u *ucase) CompleteOrderUpload(ctx context.Context, in domain.CompleteOrderUpload) (res domain.OrderUploadInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	upload, err := u.getMyUpload(c, in.UserId, in.UploadId)
+	if err != nil {
+		return
+	}
+
+	if upload.IsCompleted() {
+		err = domain.ErrItemAlreadyExist
+		return
+	}
+
+	if !upload.IsReceivedAll() {
+		err = domain.ErrIncompleteUpload
+		return
+	}
+
+	// 병합은 파일 크기에 비례해 오래 걸리므로 useCase timeout 을 적용하지 않음
+	key := upload.FileKey()
+	checksum, err := u.mergeChunks(ctx, upload, key)
+	if err != nil {
+		return
+	}
+
+	chunks := upload.Chunks
+	if checksum != upload.Checksum {
+		// 어느 청크가 깨졌는지 알 수 없으므로 처음부터 다시 올리도록 모두 지움
+		u.deleteObject(c, key)
+		err = u.orderUploadRepo.DeleteChunks(c, upload.Id)
+		if err != nil {
+			return
+		}
+		u.deleteChunkObjects(c, upload, chunks)
+
+		err = domain.ErrChecksumMismatch
+		return
+	}
+
+	upload.Complete(key)
+	upload.Chunks = nil
+	err = u.orderUploadRepo.Transaction(c, func(ur domain.OrderUploadTxRepository) (err error) {
+		err = ur.Save(c, upload)
+		if err != nil {
+			return
+		}
+
+		return ur.DeleteChunks(c, upload.Id)
+	})
+	if err != nil {
+		return
+	}
+	u.deleteChunkObjects(c, upload, chunks)
+
+	// 업로드 자체는 끝났으므로 의뢰 상태 반영 실패는 기록만 함
+	cErr := u.orderUseCase.OrderUploadCompleted(c, domain.OrderUploadCompleted{
+		OrderId:  upload.OrderId,
+		UploadId: upload.Id,
+	})
+	if cErr != nil {
+		log.WithError(cErr).
+			WithField("uploadId", upload.Id).
+			Error(tag, "completeOrderUpload, orderUseCase.OrderUploadCompleted")
+	}
+
+	res = domainToOrderUploadInfo(*upload)
+	return
+}
+
+// mergeChunks 청크를 순서대로 이어 붙여 key 에 저장하고 전체 sha256 을 반환,
+// 조각 업로드를 지원하는 저장소는 청크 하나를 조각 하나로 올림
+func (u *ucase) mergeChunks(ctx context.Context, upload *domain.OrderUpload, key string) (checksum string, err error) {
+	hash := sha256.New()
+	if multipart, ok := u.storage.(domain.StorageMultipartAdapter); ok {
+		err = multipart.PutMultipart(ctx, key, int(upload.ChunkCount()), upload.ContentType,
+			func(i int) (part io.ReadCloser, size int64, err error) {
+				chunk, err := u.storage.Get(ctx, upload.ChunkKey(uint32(i)))
+				if err != nil {
+					return
+				}
+
+				part = struct {
+					io.Reader
+					io.Closer
+				}{io.TeeReader(chunk, hash), chunk}
+				size = upload.ExpectedChunkSize(uint32(i))
+				return
+			})
+		if err != nil {
+			return
+		}
+
+		checksum = hex.EncodeToString(hash.Sum(nil))
+		return
+	}
+
+	pr, pw := io.Pipe()
+	go func() {
+		var err error
+		for i := uint32(0); i < upload.ChunkCount() && err == nil; i++ {
+			var chunk io.ReadCloser
+			chunk, err = u.storage.Get(ctx, upload.ChunkKey(i))
+			if err != nil {
+				break
+			}
+
+			_, err = io.Copy(pw, chunk)
+			chunk.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+
+	err = u.storage.Put(ctx, key, io.TeeReader(pr, hash), upload.Size, upload.ContentType)
+	pr.CloseWithError(err)
+	if err != nil {
+		return
+	}
+
+	checksum = hex.EncodeToString(hash.Sum(nil))
+	return
+}
+
+func (u *ucase) deleteChunkObjects(ctx context.Context, upload *domain.OrderUpload, chunks []domain.OrderUploadChunk) {
+	for i := range chunks {
+		u.deleteObject(ctx, upload.ChunkKey(chunks[i].ChunkIndex))
+	}
+}
+
+func (u *ucase) deleteObject(ctx context.Context, key string) {
+	err := u.storage.Delete(ctx, key)
+	if err != nil {
+		log.WithError(err).
+			WithField("key", key).
+			Error(tag, "storage.Delete")
+	}
+}
+
+// getMyUpload 업로드한 고객 본인의 업로드만 가져옴
+func (u *ucase) getMyUpload(ctx context.Context, userId, uploadId uuid.UUID) (upload *domain.OrderUpload, err error) {
+	upload, err = u.orderUploadRepo.GetById(ctx, uploadId)
+	if err != nil {
+		return
+	}
+
+	if upload == nil {
+		err = domain.ErrItemNotFound
+		return
+	}
+
+	if upload.UploaderId != userId {
+		upload = nil
+		err = domain.ErrNoPermission
+	}
+	return
+}
+
+// isAllowedContentType config.Upload.AllowedTypes 와 비교, "video/*" 같은 와일드카드 허용
+func isAllowedContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, allowed := range config.Upload.AllowedTypes {
+		allowed = strings.ToLower(allowed)
+		if allowed == "*/*" || allowed == mediaType {
+			return true
+		}
+
+		if strings.HasSuffix(allowed, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(allowed, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSha256Hex(s string) bool {
+	if len(s) != sha256.Size*2 {
+		return false
+	}
+
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+func (u *ucase) GetMyOrderUpload(ctx context.Context, userId, uploadId uuid.UUID) (res domain.OrderUploadInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	upload, err := u.getMyUpload(c, userId, uploadId)
+	if err != nil {
+		return
+	}
+
+	res = domainToOrderUploadInfo(*upload)
+	return
+}
+
+func (u *ucase) FetchByOrderId(ctx context.Context, orderId uuid.UUID) (res []domain.OrderUploadInfo, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	list, err := u.orderUploadRepo.FetchByOrderId(c, orderId)
+	if err != nil {
+		return
+	}
+
+	res = make([]domain.OrderUploadInfo, len(list))
+	for i := range list {
+		res[i] = domainToOrderUploadInfo(list[i])
+	}
+	return
+}
+
+func (u *ucase) GetDownloadURL(ctx context.Context, userId, uploadId uuid.UUID) (link string, err error) {
+	c, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	upload, err := u.orderUploadRepo.GetById(c, uploadId)
+	if err != nil {
+		return
+	}
+
+	if upload == nil {
+		err = domain.ErrItemNotFound
+		return
+	}
+
+	err = domain.CheckOrderParticipant(c, u.userRepo, u.orderRepo, userId, upload.OrderId)
+	if err != nil {
+		return
+	}
+
+	if !upload.IsCompleted() || upload.StorageKey == nil {
+		err = domain.ErrIncompleteUpload
+		return
+	}
+
+	return u.storage.SignedURL(c, *upload.StorageKey, time.Duration(config.Storage.SignedUrlTTL)*time.Second)
+}
+
+// OpenSignedFile 서명 URL 로 파일을 내려받음, 내려받는 시간이 길 수 있어 timeout 을 적용하지 않음
+func (u *ucase) OpenSignedFile(ctx context.Context, key string, expires int64, signature string) (io.ReadCloser, error) {
+	verifier, ok := u.storage.(domain.StorageSignatureVerifier)
+	if !ok || !verifier.VerifySignature(key, expires, signature) {
+		return nil, domain.ErrNoPermission
+	}
+
+	return u.storage.Get(ctx, key)
+}
+
+func domainToOrderUploadInfo(src domain.OrderUpload) domain.OrderUploadInfo {
+	received := make([]uint32, len(src.Chunks))
+	for i := range src.Chunks {
+		received[i] = src.Chunks[i].ChunkIndex
+	}
+
+	return domain.OrderUploadInfo{
+		UploadId:       src.Id,
+		OrderId:        src.OrderId,
+		FileName:       src.FileName,
+		ContentType:    src.ContentType,
+		Size:           src.Size,
+		ChunkSize:      src.ChunkSize,
+		ChunkCount:     src.ChunkCount(),
+		ReceivedChunks: received,
+		Checksum:       src.Checksum,
+		State:          src.State,
+		CreatedAt:      src.CreatedAt,
+		CompletedAt:    src.CompletedAt,
+	}
+}
@@ -0,0 +1,124 @@
+package adapter
+
+import (
+	"context"
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+// localStorage 서버 로컬 디스크에 저장, 서명 URL 은 /storage/file 엔드포인트로 제공
+type localStorage struct {
+	root      string
+	publicUrl string
+	secret    []byte
+}
+
+func newLocalStorage(conf config.StorageConfig) *localStorage {
+	return &localStorage{
+		root:      conf.Local.Root,
+		publicUrl: strings.TrimRight(conf.Local.PublicUrl, "/"),
+		secret:    []byte(conf.SignSecret),
+	}
+}
+
+func (l *localStorage) filePath(key string) (string, error) {
+	cleaned := path.Clean("/" + key)
+	if cleaned == "/" || cleaned != "/"+key {
+		return "", fmt.Errorf("invalid storage key: %q", key)
+	}
+
+	return filepath.Join(l.root, filepath.FromSlash(cleaned)), nil
+}
+
+func (l *localStorage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) (err error) {
+	dst, err := l.filePath(key)
+	if err != nil {
+		return
+	}
+
+	err = os.MkdirAll(filepath.Dir(dst), 0o755)
+	if err != nil {
+		return
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".put-*")
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			os.Remove(tmp.Name())
+		}
+	}()
+
+	written, err := io.Copy(tmp, body)
+	closeErr := tmp.Close()
+	if err != nil {
+		return
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	if written != size {
+		return fmt.Errorf("storage put size mismatch, expected=%d, written=%d", size, written)
+	}
+
+	return os.Rename(tmp.Name(), dst)
+}
+
+func (l *localStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	src, err := l.filePath(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(src)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, domain.ErrItemNotFound
+	}
+	return file, err
+}
+
+func (l *localStorage) Delete(ctx context.Context, key string) error {
+	src, err := l.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(src)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (l *localStorage) SignedURL(ctx context.Context, key string, expire time.Duration) (string, error) {
+	expires := time.Now().Add(expire).Unix()
+
+	query := make(url.Values)
+	query.Set("key", key)
+	query.Set("exp", strconv.FormatInt(expires, 10))
+	query.Set("sig", signKey(l.secret, key, expires))
+	return l.publicUrl + "/storage/file?" + query.Encode(), nil
+}
+
+func (l *localStorage) VerifySignature(key string, expires int64, signature string) bool {
+	if time.Now().Unix() > expires {
+		return false
+	}
+
+	return hmac.Equal([]byte(signKey(l.secret, key, expires)), []byte(signature))
+}
@@ -0,0 +1,132 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+const (
+	s3MaxPresignTTL = 7 * 24 * time.Hour
+)
+
+// s3Storage S3 호환 저장소 (AWS S3, MinIO 등), 서명과 요청은 minio-go 가 처리
+type s3Storage struct {
+	core   minio.Core
+	bucket string
+}
+
+// newS3Storage transport 가 nil 이면 minio-go 기본 transport 사용
+func newS3Storage(conf config.StorageConfig, transport http.RoundTripper) (*s3Storage, error) {
+	endpoint, err := url.Parse(conf.S3.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint: %q", conf.S3.Endpoint)
+	}
+
+	lookup := minio.BucketLookupDNS
+	if conf.S3.PathStyle {
+		lookup = minio.BucketLookupPath
+	}
+
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(conf.S3.AccessKey, conf.S3.SecretKey, ""),
+		Secure:       endpoint.Scheme == "https",
+		Transport:    transport,
+		Region:       conf.S3.Region,
+		BucketLookup: lookup,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Storage{
+		core:   minio.Core{Client: client},
+		bucket: conf.S3.Bucket,
+	}, nil
+}
+
+func (s *s3Storage) Put(ctx context.Context, key string, body io.Reader, size int64, contentType string) error {
+	_, err := s.core.Client.PutObject(ctx, s.bucket, key, body, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	return err
+}
+
+// PutMultipart 조각 하나가 S3 의 part 하나, 마지막을 뺀 조각은 5MiB 이상이어야 함
+func (s *s3Storage) PutMultipart(ctx context.Context, key string, count int, contentType string, part func(i int) (io.ReadCloser, int64, error)) (err error) {
+	uploadId, err := s.core.NewMultipartUpload(ctx, s.bucket, key, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return
+	}
+	defer func() {
+		// 실패한 업로드의 조각이 남아 저장 비용이 나오지 않도록 지움, 실패는 무시
+		if err != nil {
+			s.core.AbortMultipartUpload(context.Background(), s.bucket, key, uploadId)
+		}
+	}()
+
+	parts := make([]minio.CompletePart, count)
+	for i := 0; i < count; i++ {
+		var (
+			body     io.ReadCloser
+			size     int64
+			uploaded minio.ObjectPart
+		)
+		body, size, err = part(i)
+		if err != nil {
+			return
+		}
+
+		uploaded, err = s.core.PutObjectPart(ctx, s.bucket, key, uploadId, i+1, body, size, minio.PutObjectPartOptions{})
+		body.Close()
+		if err != nil {
+			return
+		}
+
+		parts[i] = minio.CompletePart{PartNumber: i + 1, ETag: uploaded.ETag}
+	}
+
+	_, err = s.core.CompleteMultipartUpload(ctx, s.bucket, key, uploadId, parts, minio.PutObjectOptions{})
+	return
+}
+
+func (s *s3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	reader, _, _, err := s.core.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+			return nil, domain.ErrItemNotFound
+		}
+		return nil, err
+	}
+	return reader, nil
+}
+
+func (s *s3Storage) Delete(ctx context.Context, key string) error {
+	err := s.core.Client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+	if err != nil && minio.ToErrorResponse(err).StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+func (s *s3Storage) SignedURL(ctx context.Context, key string, expire time.Duration) (string, error) {
+	if expire > s3MaxPresignTTL {
+		expire = s3MaxPresignTTL
+	}
+
+	signed, err := s.core.Client.PresignedGetObject(ctx, s.bucket, key, expire, nil)
+	if err != nil {
+		return "", err
+	}
+	return signed.String(), nil
+}
@@ -0,0 +1,347 @@
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+const (
+	testBucket = "editfolio"
+)
+
+// s3Stub path style 로 동작하는 메모리 S3, 서명 계산은 minio-go 가 하므로 서명이 붙었는지만 확인
+// http 로 붙으면 minio-go 가 streaming 서명으로 본문을 감싸므로 TLS 로 띄움
+type s3Stub struct {
+	// client 스텁 인증서를 믿는 클라이언트, 서명 URL 을 직접 받아볼 때 씀
+	client *http.Client
+
+	mu      sync.Mutex
+	objects map[string][]byte
+	uploads map[string]map[int][]byte
+	nextId  int
+	aborted int
+}
+
+func newS3Stub(t *testing.T) (*s3Stub, *s3Storage) {
+	stub := &s3Stub{
+		objects: make(map[string][]byte),
+		uploads: make(map[string]map[int][]byte),
+	}
+	server := httptest.NewTLSServer(stub)
+	t.Cleanup(server.Close)
+	stub.client = server.Client()
+
+	var conf config.StorageConfig
+	conf.S3.Endpoint = server.URL
+	conf.S3.Region = "ap-northeast-2"
+	conf.S3.Bucket = testBucket
+	conf.S3.AccessKey = "access"
+	conf.S3.SecretKey = "secret"
+	conf.S3.PathStyle = true
+
+	storage, err := newS3Storage(conf, server.Client().Transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stub, storage
+}
+
+func (s *s3Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.signed(r) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	prefix := "/" + testBucket + "/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, prefix)
+	query := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		s.nextId++
+		id := strconv.Itoa(s.nextId)
+		s.uploads[id] = make(map[int][]byte)
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><UploadId>%s</UploadId></InitiateMultipartUploadResult>",
+			testBucket, key, id)
+	case r.Method == http.MethodPut && query.Has("uploadId"):
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		number, _ := strconv.Atoi(query.Get("partNumber"))
+		parts[number] = body
+		w.Header().Set("ETag", strconv.Quote("etag-"+query.Get("partNumber")))
+	case r.Method == http.MethodPost && query.Has("uploadId"):
+		parts, ok := s.uploads[query.Get("uploadId")]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		var complete struct {
+			Parts []struct {
+				PartNumber int
+				ETag       string
+			} `xml:"Part"`
+		}
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+		var merged []byte
+		for i, part := range complete.Parts {
+			if part.PartNumber != i+1 || strings.Trim(part.ETag, `"`) != "etag-"+strconv.Itoa(i+1) {
+				writeS3Error(w, http.StatusBadRequest, "InvalidPart")
+				return
+			}
+			merged = append(merged, parts[part.PartNumber]...)
+		}
+		s.objects[key] = merged
+		delete(s.uploads, query.Get("uploadId"))
+		fmt.Fprintf(w, "<CompleteMultipartUploadResult><Bucket>%s</Bucket><Key>%s</Key><ETag>\"merged\"</ETag></CompleteMultipartUploadResult>",
+			testBucket, key)
+	case r.Method == http.MethodDelete && query.Has("uploadId"):
+		delete(s.uploads, query.Get("uploadId"))
+		s.aborted++
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		if r.Header.Get("Content-Type") == "" {
+			writeS3Error(w, http.StatusBadRequest, "InvalidRequest")
+			return
+		}
+		s.objects[key] = body
+		w.Header().Set("ETag", `"object"`)
+	case r.Method == http.MethodGet:
+		object, ok := s.objects[key]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(object)))
+		w.Header().Set("ETag", `"object"`)
+		w.Header().Set("Last-Modified", time.Now().UTC().Format(http.TimeFormat))
+		w.Write(object)
+	case r.Method == http.MethodDelete:
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// signed Authorization 헤더나 presigned 쿼리에 이 키로 만든 SigV4 서명이 있는지
+func (s *s3Stub) signed(r *http.Request) bool {
+	query := r.URL.Query()
+	if query.Get("X-Amz-Signature") != "" {
+		return query.Get("X-Amz-Algorithm") == "AWS4-HMAC-SHA256" &&
+			strings.HasPrefix(query.Get("X-Amz-Credential"), "access/")
+	}
+
+	return strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/")
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code></Error>", code)
+}
+
+func TestS3Storage_PutGet(t *testing.T) {
+	stub, storage := newS3Stub(t)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		key  string
+		body string
+	}{
+		{"simple", "order/1/result.mp4", "video"},
+		{"escaped", "order/1/결과 (최종).mp4", "final video"},
+		{"empty", "order/1/empty.txt", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := storage.Put(ctx, tt.key, strings.NewReader(tt.body), int64(len(tt.body)), "video/mp4")
+			if err != nil {
+				t.Fatalf("Put() error = %v", err)
+			}
+
+			stub.mu.Lock()
+			stored := string(stub.objects[tt.key])
+			stub.mu.Unlock()
+			if stored != tt.body {
+				t.Fatalf("stored = %q, want %q", stored, tt.body)
+			}
+
+			reader, err := storage.Get(ctx, tt.key)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			got, _ := io.ReadAll(reader)
+			reader.Close()
+			if string(got) != tt.body {
+				t.Fatalf("Get() = %q, want %q", got, tt.body)
+			}
+		})
+	}
+}
+
+func TestS3Storage_GetNotFound(t *testing.T) {
+	_, storage := newS3Stub(t)
+
+	_, err := storage.Get(context.Background(), "missing")
+	if err != domain.ErrItemNotFound {
+		t.Fatalf("Get() error = %v, want %v", err, domain.ErrItemNotFound)
+	}
+}
+
+func TestS3Storage_Delete(t *testing.T) {
+	stub, storage := newS3Stub(t)
+	ctx := context.Background()
+
+	stub.objects["order/1/a.txt"] = []byte("a")
+	if err := storage.Delete(ctx, "order/1/a.txt"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, ok := stub.objects["order/1/a.txt"]; ok {
+		t.Fatal("object not deleted")
+	}
+	if err := storage.Delete(ctx, "order/1/a.txt"); err != nil {
+		t.Fatalf("Delete() missing error = %v", err)
+	}
+}
+
+func TestS3Storage_SignedURL(t *testing.T) {
+	stub, storage := newS3Stub(t)
+	ctx := context.Background()
+	stub.objects["order/1/결과.mp4"] = []byte("video")
+
+	tests := []struct {
+		name        string
+		expire      time.Duration
+		wantExpires string
+	}{
+		{"in range", time.Hour, "3600"},
+		{"capped", 30 * 24 * time.Hour, "604800"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := storage.SignedURL(ctx, "order/1/결과.mp4", tt.expire)
+			if err != nil {
+				t.Fatalf("SignedURL() error = %v", err)
+			}
+
+			u, err := url.Parse(signed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := u.Query().Get("X-Amz-Expires"); got != tt.wantExpires {
+				t.Fatalf("X-Amz-Expires = %s, want %s", got, tt.wantExpires)
+			}
+
+			resp, err := stub.client.Get(signed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, _ := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if resp.StatusCode != http.StatusOK || string(got) != "video" {
+				t.Fatalf("GET signed url = %d %q", resp.StatusCode, got)
+			}
+		})
+	}
+}
+
+func TestS3Storage_PutMultipart(t *testing.T) {
+	chunks := []string{"first-", "second-", "last"}
+	tests := []struct {
+		name        string
+		failAt      int
+		wantErr     bool
+		wantAborted int
+	}{
+		{"merged in order", -1, false, 0},
+		{"part error aborts", 1, true, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub, storage := newS3Stub(t)
+
+			closed := make(map[int]bool)
+			err := storage.PutMultipart(context.Background(), "order/1/merged.mp4", len(chunks), "video/mp4",
+				func(i int) (io.ReadCloser, int64, error) {
+					if i == tt.failAt {
+						return nil, 0, domain.ErrItemNotFound
+					}
+					return &closeRecorder{Reader: bytes.NewReader([]byte(chunks[i])), index: i, closed: closed},
+						int64(len(chunks[i])), nil
+				})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PutMultipart() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			stub.mu.Lock()
+			defer stub.mu.Unlock()
+			if stub.aborted != tt.wantAborted {
+				t.Fatalf("aborted = %d, want %d", stub.aborted, tt.wantAborted)
+			}
+			if len(stub.uploads) != 0 {
+				t.Fatalf("pending uploads = %d, want 0", len(stub.uploads))
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(closed) != len(chunks) {
+				t.Fatalf("closed parts = %d, want %d", len(closed), len(chunks))
+			}
+			if got := string(stub.objects["order/1/merged.mp4"]); got != strings.Join(chunks, "") {
+				t.Fatalf("merged = %q", got)
+			}
+		})
+	}
+}
+
+func TestNewS3Storage_InvalidEndpoint(t *testing.T) {
+	for _, endpoint := range []string{"", "not a url", "://missing-scheme"} {
+		var conf config.StorageConfig
+		conf.S3.Endpoint = endpoint
+		_, err := newS3Storage(conf, nil)
+		if err == nil {
+			t.Errorf("newS3Storage(%q) error = nil", endpoint)
+		}
+	}
+}
+
+// closeRecorder http 클라이언트도 본문을 닫으므로 몇 번이 아니라 닫혔는지만 기록
+type closeRecorder struct {
+	io.Reader
+	index  int
+	closed map[int]bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed[c.index] = true
+	return nil
+}
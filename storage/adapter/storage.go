@@ -0,0 +1,37 @@
+package adapter
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+
+	"github.com/stockfolioofficial/back-editfolio/core/config"
+	"github.com/stockfolioofficial/back-editfolio/domain"
+)
+
+const (
+	StorageTypeLocal = "local"
+	StorageTypeS3    = "s3"
+)
+
+func NewStorageAdapter(conf config.StorageConfig) (domain.StorageAdapter, error) {
+	switch conf.Type {
+	case StorageTypeS3:
+		s3, err := newS3Storage(conf, nil)
+		if err != nil {
+			return nil, err
+		}
+		return s3, nil
+	default:
+		return newLocalStorage(conf), nil
+	}
+}
+
+func signKey(secret []byte, key string, expires int64) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(key))
+	mac.Write([]byte{'\n'})
+	mac.Write([]byte(strconv.FormatInt(expires, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
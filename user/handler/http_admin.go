@@ -308,7 +308,19 @@ func (c *UserController) deleteCustomerUser(ctx echo.Context) error {
 }
 
 type FetchCustomerRequest struct {
-	Query string `json:"-" query:"q"`
+	// Query 이름, 채널명, 이메일로 검색, 두 글자 이상
+	Query string `json:"-" query:"q" validate:"omitempty,min=2,max=100"`
+
+	// Cursor 이전 응답의 nextCursor, 비어있으면 첫 페이지
+	Cursor string `json:"-" query:"cursor"`
+	Limit  int    `json:"-" query:"limit" validate:"omitempty,min=1,max=100" example:"20"`
+
+	// Sort 비어있으면 가입 일시 순
+	Sort string `json:"-" query:"sort" validate:"omitempty,oneof=createdAt name" example:"name"`
+	Desc bool   `json:"-" query:"desc" example:"false"`
+
+	CreatedFrom *time.Time `json:"-" query:"createdFrom" example:"2021-10-01T00:00:00+09:00"`
+	CreatedTo   *time.Time `json:"-" query:"createdTo" example:"2021-10-31T23:59:59+09:00"`
 }
 
 type CustomerInfoResponse struct {
@@ -323,6 +335,11 @@ type CustomerInfoResponse struct {
 
 type CustomerInfoListResponse []CustomerInfoResponse
 
+type CustomerInfoPageResponse struct {
+	Items CustomerInfoListResponse `json:"items" validate:"required"`
+	domain.PageResponse
+} // @name CustomerInfoPageResponse
+
 // @Tags (User) 어드민 기능
 // @Security Auth-Jwt-Bearer
 // @Summary [어드민] 고객 목록
@@ -330,7 +347,14 @@ type CustomerInfoListResponse []CustomerInfoResponse
 // @Accept json
 // @Produce json
 // @Param q query string false "검색어"
-// @Success 200 {object} CustomerInfoListResponse "성공"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param limit query int false "페이지 크기, 기본 20, 최대 100"
+// @Param sort query string false "정렬 기준 (createdAt, name)"
+// @Param desc query boolean false "내림차순"
+// @Param createdFrom query string false "가입 일시 시작 (RFC3339)"
+// @Param createdTo query string false "가입 일시 끝 (RFC3339)"
+// @Success 200 {object} CustomerInfoPageResponse "성공"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 커서"
 // @Router /customer [get]
 func (c *UserController) fetchCustomer(ctx echo.Context) error {
 	var req FetchCustomerRequest
@@ -342,12 +366,24 @@ func (c *UserController) fetchCustomer(ctx echo.Context) error {
 		})
 	}
 
-	list, err := c.useCase.FetchAllCustomer(ctx.Request().Context(), domain.FetchCustomerOption{
-		Query: req.Query,
+	list, page, err := c.useCase.FetchCustomer(ctx.Request().Context(), domain.FetchCustomerOption{
+		Query:       req.Query,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		Sort:        domain.UserSort(req.Sort),
+		Desc:        req.Desc,
+		Page: &domain.PageOption{
+			Cursor: req.Cursor,
+			Limit:  req.Limit,
+		},
 	})
 
-	if err != nil {
-		log.WithError(err).Error(tag, "fetch full customer, unhandled error useCase.FetchAllCustomer")
+	switch err {
+	case nil:
+	case domain.ErrInvalidCursor:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	default:
+		log.WithError(err).Error(tag, "fetch customer, unhandled error useCase.FetchCustomer")
 		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
 	}
 
@@ -370,7 +406,10 @@ func (c *UserController) fetchCustomer(ctx echo.Context) error {
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, res)
+	return ctx.JSON(http.StatusOK, CustomerInfoPageResponse{
+		Items:        res,
+		PageResponse: domain.ToPageResponse(page),
+	})
 }
 
 
@@ -431,7 +470,19 @@ func (c *UserController) getCustomerDetailInfo(ctx echo.Context) error {
 }
 
 type FetchAdminRequest struct {
-	Query string `json:"-" query:"q"`
+	// Query 이름, 닉네임으로 검색, 두 글자 이상
+	Query string `json:"-" query:"q" validate:"omitempty,min=2,max=100"`
+
+	// Cursor 이전 응답의 nextCursor, 비어있으면 첫 페이지
+	Cursor string `json:"-" query:"cursor"`
+	Limit  int    `json:"-" query:"limit" validate:"omitempty,min=1,max=100" example:"20"`
+
+	// Sort 비어있으면 가입 일시 순
+	Sort string `json:"-" query:"sort" validate:"omitempty,oneof=createdAt name" example:"name"`
+	Desc bool   `json:"-" query:"desc" example:"false"`
+
+	CreatedFrom *time.Time `json:"-" query:"createdFrom" example:"2021-10-01T00:00:00+09:00"`
+	CreatedTo   *time.Time `json:"-" query:"createdTo" example:"2021-10-31T23:59:59+09:00"`
 }
 
 type AdminInfoResponse struct {
@@ -444,6 +495,11 @@ type AdminInfoResponse struct {
 
 type AdminInfoListResponse []AdminInfoResponse
 
+type AdminInfoPageResponse struct {
+	Items AdminInfoListResponse `json:"items" validate:"required"`
+	domain.PageResponse
+} // @name AdminInfoPageResponse
+
 // @Tags (User) 어드민 기능
 // @Security Auth-Jwt-Bearer
 // @Summary [어드민] 어드민 목록
@@ -451,7 +507,14 @@ type AdminInfoListResponse []AdminInfoResponse
 // @Accept json
 // @Produce json
 // @Param q query string false "검색어"
-// @Success 200 {object} AdminInfoListResponse "성공"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param limit query int false "페이지 크기, 기본 20, 최대 100"
+// @Param sort query string false "정렬 기준 (createdAt, name)"
+// @Param desc query boolean false "내림차순"
+// @Param createdFrom query string false "가입 일시 시작 (RFC3339)"
+// @Param createdTo query string false "가입 일시 끝 (RFC3339)"
+// @Success 200 {object} AdminInfoPageResponse "성공"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 커서"
 // @Router /admin [get]
 func (c *UserController) fetchAdmin(ctx echo.Context) error {
 	var req FetchAdminRequest
@@ -463,12 +526,24 @@ func (c *UserController) fetchAdmin(ctx echo.Context) error {
 		})
 	}
 
-	list, err := c.useCase.FetchAllAdmin(ctx.Request().Context(), domain.FetchAdminOption{
-		Query: req.Query,
+	list, page, err := c.useCase.FetchAdmin(ctx.Request().Context(), domain.FetchAdminOption{
+		Query:       req.Query,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		Sort:        domain.UserSort(req.Sort),
+		Desc:        req.Desc,
+		Page: &domain.PageOption{
+			Cursor: req.Cursor,
+			Limit:  req.Limit,
+		},
 	})
 
-	if err != nil {
-		log.WithError(err).Error(tag, "fetch full customer, unhandled error useCase.FetchAllCustomer")
+	switch err {
+	case nil:
+	case domain.ErrInvalidCursor:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	default:
+		log.WithError(err).Error(tag, "fetch admin, unhandled error useCase.FetchAdmin")
 		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
 	}
 
@@ -489,7 +564,10 @@ func (c *UserController) fetchAdmin(ctx echo.Context) error {
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, res)
+	return ctx.JSON(http.StatusOK, AdminInfoPageResponse{
+		Items:        res,
+		PageResponse: domain.ToPageResponse(page),
+	})
 }
 
 type AdminCreatorInfoResponse struct {
@@ -500,6 +578,11 @@ type AdminCreatorInfoResponse struct {
 
 type AdminCreatorInfoListResponse []AdminCreatorInfoResponse
 
+type AdminCreatorInfoPageResponse struct {
+	Items AdminCreatorInfoListResponse `json:"items" validate:"required"`
+	domain.PageResponse
+} // @name AdminCreatorInfoPageResponse
+
 // @Tags (User) 어드민 기능
 // @Security Auth-Jwt-Bearer
 // @Summary [어드민] 편집자 목록
@@ -507,7 +590,14 @@ type AdminCreatorInfoListResponse []AdminCreatorInfoResponse
 // @Accept json
 // @Produce json
 // @Param q query string false "검색어"
-// @Success 200 {object} AdminCreatorInfoListResponse "성공"
+// @Param cursor query string false "이전 응답의 nextCursor"
+// @Param limit query int false "페이지 크기, 기본 20, 최대 100"
+// @Param sort query string false "정렬 기준 (createdAt, name)"
+// @Param desc query boolean false "내림차순"
+// @Param createdFrom query string false "가입 일시 시작 (RFC3339)"
+// @Param createdTo query string false "가입 일시 끝 (RFC3339)"
+// @Success 200 {object} AdminCreatorInfoPageResponse "성공"
+// @Failure 400 {object} domain.ErrorResponse "잘못된 커서"
 // @Router /admin/creator [get]
 func (c *UserController) fetchAdminCreator(ctx echo.Context) error {
 	var req FetchAdminRequest
@@ -519,12 +609,24 @@ func (c *UserController) fetchAdminCreator(ctx echo.Context) error {
 		})
 	}
 
-	list, err := c.useCase.FetchAllAdmin(ctx.Request().Context(), domain.FetchAdminOption{
-		Query: req.Query,
+	list, page, err := c.useCase.FetchAdmin(ctx.Request().Context(), domain.FetchAdminOption{
+		Query:       req.Query,
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		Sort:        domain.UserSort(req.Sort),
+		Desc:        req.Desc,
+		Page: &domain.PageOption{
+			Cursor: req.Cursor,
+			Limit:  req.Limit,
+		},
 	})
 
-	if err != nil {
-		log.WithError(err).Error(tag, "fetch full customer, unhandled error useCase.FetchAllCustomer")
+	switch err {
+	case nil:
+	case domain.ErrInvalidCursor:
+		return ctx.JSON(http.StatusBadRequest, domain.ErrorResponse{Message: err.Error()})
+	default:
+		log.WithError(err).Error(tag, "fetch admin, unhandled error useCase.FetchAdmin")
 		return ctx.JSON(http.StatusInternalServerError, domain.ServerInternalErrorResponse)
 	}
 
@@ -543,5 +645,8 @@ func (c *UserController) fetchAdminCreator(ctx echo.Context) error {
 		}
 	}
 
-	return ctx.JSON(http.StatusOK, res)
+	return ctx.JSON(http.StatusOK, AdminCreatorInfoPageResponse{
+		Items:        res,
+		PageResponse: domain.ToPageResponse(page),
+	})
 }
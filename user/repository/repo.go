@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stockfolioofficial/back-editfolio/domain"
@@ -32,21 +34,124 @@ func (r *repo) ExistsSuperUser(ctx context.Context) (exists bool, err error) {
 }
 
 func (r *repo) FetchAllAdmin(ctx context.Context, option domain.FetchAdminOption) (list []domain.User, err error) {
-	err = r.db.WithContext(ctx).
-		Joins("Manager").
-		Where("`deleted_at` IS NULL").
-		Where(r.db.Where("`role` = ?", domain.AdminUserRole).
-			Or("`role` = ?", domain.SuperAdminUserRole)).
+	err = r.adminFilter(r.db.WithContext(ctx), option).
 		Find(&list).Error
 	return
 }
 
-func (r *repo) FetchAllCustomer(ctx context.Context, option domain.FetchCustomerOption) (list []domain.User, err error) {
-	err = r.db.WithContext(ctx).
+func (r *repo) FetchAdminPage(ctx context.Context, option domain.FetchAdminOption) (list []domain.User, page domain.Page, err error) {
+	db := r.adminFilter(r.db.WithContext(ctx), option)
+	return r.fetchPage(db, option.Sort, option.Desc, option.Page, "`Manager`.`name`", func(user *domain.User) string {
+		return user.Manager.Name
+	})
+}
+
+func (r *repo) FetchCustomerPage(ctx context.Context, option domain.FetchCustomerOption) (list []domain.User, page domain.Page, err error) {
+	db := r.db.WithContext(ctx).
 		Joins("Customer").
 		Where("`deleted_at` IS NULL").
-		Where("`role` = ?", domain.CustomerUserRole).
+		Where("`role` = ?", domain.CustomerUserRole)
+	if query := strings.TrimSpace(option.Query); len(query) > 0 {
+		if gormx.IsShorterThanNgram(query) {
+			pattern := gormx.LikeContains(query)
+			db = db.Where("`Customer`.`name` LIKE ? OR `Customer`.`channel_name` LIKE ? OR `Customer`.`email` LIKE ?",
+				pattern, pattern, pattern)
+		} else {
+			db = db.Where("MATCH(`Customer`.`name`, `Customer`.`channel_name`, `Customer`.`email`) AGAINST(? IN BOOLEAN MODE)",
+				gormx.FulltextPhrase(query))
+		}
+	}
+	db = createdBetween(db, option.CreatedFrom, option.CreatedTo)
+
+	return r.fetchPage(db, option.Sort, option.Desc, option.Page, "`Customer`.`name`", func(user *domain.User) string {
+		return user.Customer.Name
+	})
+}
+
+func (r *repo) adminFilter(db *gorm.DB, option domain.FetchAdminOption) *gorm.DB {
+	db = db.Joins("Manager").
+		Where("`deleted_at` IS NULL").
+		Where(r.db.Where("`role` = ?", domain.AdminUserRole).
+			Or("`role` = ?", domain.SuperAdminUserRole))
+	if query := strings.TrimSpace(option.Query); len(query) > 0 {
+		if gormx.IsShorterThanNgram(query) {
+			pattern := gormx.LikeContains(query)
+			db = db.Where("`Manager`.`name` LIKE ? OR `Manager`.`nickname` LIKE ?", pattern, pattern)
+		} else {
+			db = db.Where("MATCH(`Manager`.`name`, `Manager`.`nickname`) AGAINST(? IN BOOLEAN MODE)",
+				gormx.FulltextPhrase(query))
+		}
+	}
+	return createdBetween(db, option.CreatedFrom, option.CreatedTo)
+}
+
+func createdBetween(db *gorm.DB, from, to *time.Time) *gorm.DB {
+	if from != nil {
+		db = db.Where("`user`.`created_at` >= ?", from)
+	}
+	if to != nil {
+		db = db.Where("`user`.`created_at` <= ?", to)
+	}
+	return db
+}
+
+// fetchPage 이름 또는 가입 일시 다음 아이디 순, 커서는 [정렬 값, 아이디]
+func (r *repo) fetchPage(db *gorm.DB, sort domain.UserSort, desc bool, pageOption *domain.PageOption, nameExpr string, name func(user *domain.User) string) (list []domain.User, page domain.Page, err error) {
+	var option domain.PageOption
+	if pageOption != nil {
+		option = *pageOption
+	}
+
+	columns := []gormx.KeysetColumn{
+		{Expr: "`user`.`created_at`", Desc: desc},
+		{Expr: "`user`.`id`", Desc: desc},
+	}
+	if sort == domain.UserSortName {
+		columns[0].Expr = nameExpr
+	}
+
+	values, err := domain.DecodePageCursor(option.Cursor, len(columns))
+	if err != nil {
+		return
+	}
+
+	var after []interface{}
+	if values != nil {
+		after = []interface{}{values[0], values[1]}
+		if sort != domain.UserSortName {
+			var createdAt time.Time
+			createdAt, err = time.Parse(time.RFC3339Nano, values[0])
+			if err != nil {
+				err = domain.ErrInvalidCursor
+				return
+			}
+			after[0] = createdAt
+		}
+	}
+
+	err = db.Session(&gorm.Session{}).
+		Model(&domain.User{}).
+		Count(&page.Total).Error
+	if err != nil {
+		return
+	}
+
+	size := option.Size()
+	err = gormx.Keyset(db, columns, after, size+1).
 		Find(&list).Error
+	if err != nil || len(list) <= size {
+		return
+	}
+
+	list = list[:size]
+	last := &list[size-1]
+	value := last.CreatedAt.Format(time.RFC3339Nano)
+	if sort == domain.UserSortName {
+		value = name(last)
+	}
+
+	cursor := domain.EncodePageCursor(value, last.Id.String())
+	page.NextCursor = &cursor
 	return
 }
 
@@ -107,7 +212,11 @@ func (r *repo) GetById(ctx context.Context, userId uuid.UUID) (user *domain.User
 }
 
 func (r *repo) Save(ctx context.Context, user *domain.User) error {
-	return gormx.Upsert(ctx, r.db, user)
+	saved, err := gormx.SaveWithVersion(ctx, r.db, user, &user.Version)
+	if err == nil && !saved {
+		err = domain.ErrVersionConflict
+	}
+	return err
 }
 
 func (r *repo) Get() *gorm.DB {
@@ -9,11 +9,11 @@ import (
 	"time"
 )
 
-func (u *ucase) FetchAllAdmin(ctx context.Context, option domain.FetchAdminOption) (res []domain.AdminInfoData, err error) {
+func (u *ucase) FetchAdmin(ctx context.Context, option domain.FetchAdminOption) (res []domain.AdminInfoData, page domain.Page, err error) {
 	c, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
-	list, err := u.userRepo.FetchAllAdmin(c, option)
+	list, page, err := u.userRepo.FetchAdminPage(c, option)
 	if err != nil {
 		return
 	}
@@ -38,11 +38,11 @@ func (u *ucase) FetchAllAdmin(ctx context.Context, option domain.FetchAdminOptio
 	return
 }
 
-func (u *ucase) FetchAllCustomer(ctx context.Context, option domain.FetchCustomerOption) (res []domain.CustomerInfoData, err error) {
+func (u *ucase) FetchCustomer(ctx context.Context, option domain.FetchCustomerOption) (res []domain.CustomerInfoData, page domain.Page, err error) {
 	c, cancel := context.WithTimeout(ctx, u.timeout)
 	defer cancel()
 
-	list, err := u.userRepo.FetchAllCustomer(c, option)
+	list, page, err := u.userRepo.FetchCustomerPage(c, option)
 	if err != nil {
 		return
 	}
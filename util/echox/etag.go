@@ -0,0 +1,33 @@
+package echox
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+var ErrInvalidIfMatch = errors.New("invalid If-Match header")
+
+// SetVersionETag 버전을 ETag 헤더로 내려줌, 수정 요청 시 If-Match 로 돌려받음
+func SetVersionETag(ctx echo.Context, version uint) {
+	ctx.Response().Header().Set("ETag", strconv.Quote(strconv.FormatUint(uint64(version), 10)))
+}
+
+// IfMatchVersion If-Match 헤더가 없거나 * 이면 nil
+func IfMatchVersion(ctx echo.Context) (*uint, error) {
+	value := strings.TrimSpace(ctx.Request().Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	value = strings.Trim(strings.TrimPrefix(value, "W/"), `"`)
+	version, err := strconv.ParseUint(value, 10, 0)
+	if err != nil {
+		return nil, ErrInvalidIfMatch
+	}
+
+	res := uint(version)
+	return &res, nil
+}
@@ -0,0 +1,26 @@
+package gormx
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// NgramTokenSize MySQL ngram_token_size 기본값, 이보다 짧은 검색어는 ngram FULLTEXT 인덱스로 찾을 수 없음
+const NgramTokenSize = 2
+
+// FulltextPhrase 따옴표로 감싸 BOOLEAN MODE 연산자를 쓰지 못하게 하고 구문 검색
+func FulltextPhrase(query string) string {
+	return `"` + strings.ReplaceAll(query, `"`, " ") + `"`
+}
+
+// IsShorterThanNgram true 면 MATCH 대신 LIKE 로 검색해야함
+func IsShorterThanNgram(query string) bool {
+	return utf8.RuneCountInString(query) < NgramTokenSize
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// LikeContains 검색어의 %, _ 를 문자 그대로 찾는 부분 일치 LIKE 패턴
+func LikeContains(query string) string {
+	return "%" + likeEscaper.Replace(query) + "%"
+}
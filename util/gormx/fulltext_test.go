@@ -0,0 +1,55 @@
+package gormx
+
+import "testing"
+
+func TestIsShorterThanNgram(t *testing.T) {
+	tests := []struct {
+		query string
+		want  bool
+	}{
+		{"", true},
+		{"a", true},
+		{"김", true},
+		{"ab", false},
+		{"김철", false},
+	}
+	for _, tt := range tests {
+		if got := IsShorterThanNgram(tt.query); got != tt.want {
+			t.Errorf("IsShorterThanNgram(%q) = %v, want %v", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestLikeContains(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"a", "%a%"},
+		{"%", `%\%%`},
+		{"_", `%\_%`},
+		{`\`, `%\\%`},
+		{`50%_off\`, `%50\%\_off\\%`},
+	}
+	for _, tt := range tests {
+		if got := LikeContains(tt.query); got != tt.want {
+			t.Errorf("LikeContains(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestFulltextPhrase(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"편집", `"편집"`},
+		{`a "b" c`, `"a  b  c"`},
+		{"+a -b", `"+a -b"`},
+	}
+	for _, tt := range tests {
+		if got := FulltextPhrase(tt.query); got != tt.want {
+			t.Errorf("FulltextPhrase(%q) = %q, want %q", tt.query, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package gormx
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// KeysetColumn 커서 페이지 정렬 기준, 순서가 흔들리지 않도록 마지막은 유일한 값(아이디)이어야 함
+type KeysetColumn struct {
+	Expr string
+	Desc bool
+}
+
+// Keyset columns 순으로 정렬하고 after 가 있으면 그 행 다음부터 limit 개 조회
+func Keyset(db *gorm.DB, columns []KeysetColumn, after []interface{}, limit int) *gorm.DB {
+	if len(after) == len(columns) {
+		var (
+			conds = make([]string, len(columns))
+			args  []interface{}
+		)
+		// (a > ?) OR (a = ? AND b > ?) OR (a = ? AND b = ? AND id > ?)
+		for i := range columns {
+			parts := make([]string, 0, i+1)
+			for j := 0; j < i; j++ {
+				parts = append(parts, columns[j].Expr+" = ?")
+				args = append(args, after[j])
+			}
+
+			op := " > ?"
+			if columns[i].Desc {
+				op = " < ?"
+			}
+			parts = append(parts, columns[i].Expr+op)
+			args = append(args, after[i])
+			conds[i] = "(" + strings.Join(parts, " AND ") + ")"
+		}
+		db = db.Where("("+strings.Join(conds, " OR ")+")", args...)
+	}
+
+	for _, column := range columns {
+		if column.Desc {
+			db = db.Order(column.Expr + " desc")
+		} else {
+			db = db.Order(column.Expr + " asc")
+		}
+	}
+	return db.Limit(limit)
+}
@@ -0,0 +1,50 @@
+package gormx
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+const mysqlErrDuplicateEntry = 1062
+
+// SaveWithVersion 저장된 version 이 같을 때만 저장하고 version 을 하나 올림,
+// 그 사이 다른 곳에서 먼저 저장했으면 version 을 되돌리고 false 반환
+func SaveWithVersion(ctx context.Context, db *gorm.DB, model interface{}, version *uint) (saved bool, err error) {
+	current := *version
+	*version = current + 1
+	defer func() {
+		if !saved {
+			*version = current
+		}
+	}()
+
+	res := db.WithContext(ctx).
+		Model(model).
+		Where("`version` = ?", current).
+		Select("*").
+		Updates(model)
+	if res.Error != nil || res.RowsAffected > 0 {
+		return res.Error == nil, res.Error
+	}
+
+	// 이미 저장된 적 있는데 수정된 행이 없으면 version 이 바뀐 것
+	if current > 0 {
+		return
+	}
+
+	// 기본 키 중복이면 그 사이 다른 곳에서 같은 행을 저장한 것, 다른 unique 키 중복은 그대로 에러
+	err = db.WithContext(ctx).Create(model).Error
+	if IsDuplicateEntry(err) && strings.Contains(err.Error(), "PRIMARY") {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// IsDuplicateEntry unique 키 중복으로 저장하지 못한 에러인지 여부
+func IsDuplicateEntry(err error) bool {
+	myErr, ok := err.(*mysql.MySQLError)
+	return ok && myErr.Number == mysqlErrDuplicateEntry
+}
@@ -0,0 +1,68 @@
+package highlight
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	OpenTag  = "<em>"
+	CloseTag = "</em>"
+)
+
+// Fragment text 에서 query 가 처음 나오는 곳을 OpenTag, CloseTag 로 감싸고
+// 앞뒤로 around 글자만 남김, 대소문자 구분 없음, 없으면 false,
+// 결과는 HTML 로 그대로 넣을 수 있도록 태그를 뺀 나머지를 모두 이스케이프함
+func Fragment(text, query string, around int) (string, bool) {
+	src := []rune(text)
+	q := []rune(strings.TrimSpace(query))
+	if len(q) == 0 || len(src) < len(q) {
+		return "", false
+	}
+
+	start := indexFold(src, q)
+	if start < 0 {
+		return "", false
+	}
+	end := start + len(q)
+
+	from := start - around
+	if from < 0 {
+		from = 0
+	}
+	to := end + around
+	if to > len(src) {
+		to = len(src)
+	}
+
+	var b strings.Builder
+	if from > 0 {
+		b.WriteString("…")
+	}
+	b.WriteString(html.EscapeString(string(src[from:start])))
+	b.WriteString(OpenTag)
+	b.WriteString(html.EscapeString(string(src[start:end])))
+	b.WriteString(CloseTag)
+	b.WriteString(html.EscapeString(string(src[end:to])))
+	if to < len(src) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
+
+func indexFold(src, q []rune) int {
+	for i := 0; i+len(q) <= len(src); i++ {
+		matched := true
+		for j := range q {
+			if unicode.ToLower(src[i+j]) != unicode.ToLower(q[j]) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return i
+		}
+	}
+	return -1
+}
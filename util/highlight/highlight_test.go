@@ -0,0 +1,35 @@
+package highlight
+
+import "testing"
+
+func TestFragment(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		query  string
+		around int
+		want   string
+		wantOk bool
+	}{
+		{"whole text", "편집자", "편집자", 20, "<em>편집자</em>", true},
+		{"case insensitive", "Hello World", "world", 20, "Hello <em>World</em>", true},
+		{"trimmed query", "Hello World", "  world ", 20, "Hello <em>World</em>", true},
+		{"first match only", "abcabc", "b", 1, "a<em>b</em>c…", true},
+		{"cut both sides", "0123456789", "45", 2, "…23<em>45</em>67…", true},
+		{"korean runes", "가나다라마바사", "라", 1, "…다<em>라</em>마…", true},
+		{"escape around", `<b onclick="x">&</b>`, "click", 20,
+			"&lt;b on<em>click</em>=&#34;x&#34;&gt;&amp;&lt;/b&gt;", true},
+		{"escape match", "a<script>b", "<script>", 20, "a<em>&lt;script&gt;</em>b", true},
+		{"not found", "Hello", "bye", 20, "", false},
+		{"empty query", "Hello", "   ", 20, "", false},
+		{"query longer than text", "Hi", "Hello", 20, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Fragment(tt.text, tt.query, tt.around)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Fragment() = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+var ErrClosed = errors.New("xlsx writer already closed")
+
+const (
+	contentTypesXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+
+	rootRelsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+
+	workbookRelsXml = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+
+	workbookXmlHead = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="`
+	workbookXmlTail = `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+
+	sheetXmlHead = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	sheetXmlTail = `</sheetData></worksheet>`
+)
+
+// Writer 시트 하나짜리 xlsx 파일을 한 줄씩 바로 내보냄, 문자열은 공유 문자열 표 대신 셀에 직접 넣음
+type Writer struct {
+	zw     *zip.Writer
+	sheet  *bufio.Writer
+	row    int
+	closed bool
+}
+
+// NewWriter sheetName 은 엑셀 제한에 맞게 31자 이하, 특수문자 없이
+func NewWriter(w io.Writer, sheetName string) (*Writer, error) {
+	zw := zip.NewWriter(w)
+	files := []struct {
+		name string
+		body string
+	}{
+		{"[Content_Types].xml", contentTypesXml},
+		{"_rels/.rels", rootRelsXml},
+		{"xl/_rels/workbook.xml.rels", workbookRelsXml},
+		{"xl/workbook.xml", workbookXmlHead + escape(sheetName) + workbookXmlTail},
+	}
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = io.WriteString(fw, f.body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 시트는 마지막 파일이라 Close 전까지 계속 이어 쓸 수 있음
+	sw, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := bufio.NewWriter(sw)
+	_, err = sheet.WriteString(sheetXmlHead)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{zw: zw, sheet: sheet}, nil
+}
+
+// WriteRow nil 은 빈 칸, 정수와 실수는 숫자 칸, 그 외에는 문자열 칸
+func (w *Writer) WriteRow(cells ...interface{}) (err error) {
+	if w.closed {
+		return ErrClosed
+	}
+
+	w.row++
+	rowRef := strconv.Itoa(w.row)
+	_, err = w.sheet.WriteString(`<row r="` + rowRef + `">`)
+	if err != nil {
+		return
+	}
+
+	for i, cell := range cells {
+		if cell == nil {
+			continue
+		}
+
+		ref := columnName(i) + rowRef
+		var s string
+		switch v := cell.(type) {
+		case int:
+			s = `<c r="` + ref + `"><v>` + strconv.Itoa(v) + `</v></c>`
+		case int64:
+			s = `<c r="` + ref + `"><v>` + strconv.FormatInt(v, 10) + `</v></c>`
+		case uint8:
+			s = `<c r="` + ref + `"><v>` + strconv.FormatUint(uint64(v), 10) + `</v></c>`
+		case uint64:
+			s = `<c r="` + ref + `"><v>` + strconv.FormatUint(v, 10) + `</v></c>`
+		case float64:
+			s = `<c r="` + ref + `"><v>` + strconv.FormatFloat(v, 'f', -1, 64) + `</v></c>`
+		case string:
+			s = `<c r="` + ref + `" t="inlineStr"><is><t xml:space="preserve">` + escape(v) + `</t></is></c>`
+		default:
+			return errors.New("xlsx: unsupported cell type")
+		}
+
+		_, err = w.sheet.WriteString(s)
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = w.sheet.WriteString(`</row>`)
+	return
+}
+
+// Flush 지금까지 쓴 줄을 내보냄, 압축 중인 일부는 남아있을 수 있음
+func (w *Writer) Flush() error {
+	if w.closed {
+		return ErrClosed
+	}
+
+	err := w.sheet.Flush()
+	if err != nil {
+		return err
+	}
+	return w.zw.Flush()
+}
+
+// Close 시트를 닫고 zip 목차를 씀, 밑에 있는 io.Writer 는 닫지 않음
+func (w *Writer) Close() (err error) {
+	if w.closed {
+		return ErrClosed
+	}
+	w.closed = true
+
+	_, err = w.sheet.WriteString(sheetXmlTail)
+	if err != nil {
+		return
+	}
+
+	err = w.sheet.Flush()
+	if err != nil {
+		return
+	}
+	return w.zw.Close()
+}
+
+// columnName 0 부터 A, B, ..., Z, AA, AB, ...
+func columnName(i int) string {
+	var name []byte
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = append([]byte{byte('A' + (i-1)%26)}, name...)
+	}
+	return string(name)
+}
+
+// escape xml 에 쓸 수 없는 문자는 U+FFFD 로 바뀜
+func escape(s string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
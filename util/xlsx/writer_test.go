@@ -0,0 +1,206 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type sheetXml struct {
+	Rows []struct {
+		Ref   string `xml:"r,attr"`
+		Cells []struct {
+			Ref    string  `xml:"r,attr"`
+			Type   string  `xml:"t,attr"`
+			Value  *string `xml:"v"`
+			Inline *string `xml:"is>t"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+type workbookXml struct {
+	Sheets []struct {
+		Name string `xml:"name,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+func readZipFile(t *testing.T, r *zip.Reader, name string) []byte {
+	t.Helper()
+	f, err := r.Open(name)
+	if err != nil {
+		t.Fatalf("open %s: %v", name, err)
+	}
+	defer f.Close()
+
+	body, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("read %s: %v", name, err)
+	}
+	return body
+}
+
+func TestWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, `주문 <"목록">`)
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+
+	rows := [][]interface{}{
+		{"아이디", "수량", nil, "메모"},
+		{"a&b", 3, int64(-4), uint8(5), uint64(6), 1.5},
+		{},
+		{"<script>\"x\"</script>", "  앞뒤 공백  "},
+	}
+	for _, row := range rows {
+		if err = w.WriteRow(row...); err != nil {
+			t.Fatalf("WriteRow() error = %v", err)
+		}
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader() error = %v", err)
+	}
+
+	var names []string
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	wantNames := []string{
+		"[Content_Types].xml",
+		"_rels/.rels",
+		"xl/_rels/workbook.xml.rels",
+		"xl/workbook.xml",
+		"xl/worksheets/sheet1.xml",
+	}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Fatalf("files = %v, want %v", names, wantNames)
+	}
+
+	var workbook workbookXml
+	if err = xml.Unmarshal(readZipFile(t, r, "xl/workbook.xml"), &workbook); err != nil {
+		t.Fatalf("workbook xml: %v", err)
+	}
+	if len(workbook.Sheets) != 1 || workbook.Sheets[0].Name != `주문 <"목록">` {
+		t.Fatalf("sheets = %+v", workbook.Sheets)
+	}
+
+	var sheet sheetXml
+	if err = xml.Unmarshal(readZipFile(t, r, "xl/worksheets/sheet1.xml"), &sheet); err != nil {
+		t.Fatalf("sheet xml: %v", err)
+	}
+
+	type cell struct {
+		ref, kind, value string
+	}
+	want := [][]cell{
+		{{"A1", "inlineStr", "아이디"}, {"B1", "inlineStr", "수량"}, {"D1", "inlineStr", "메모"}},
+		{{"A2", "inlineStr", "a&b"}, {"B2", "", "3"}, {"C2", "", "-4"}, {"D2", "", "5"}, {"E2", "", "6"}, {"F2", "", "1.5"}},
+		nil,
+		{{"A4", "inlineStr", "<script>\"x\"</script>"}, {"B4", "inlineStr", "  앞뒤 공백  "}},
+	}
+	if len(sheet.Rows) != len(want) {
+		t.Fatalf("rows = %d, want %d", len(sheet.Rows), len(want))
+	}
+	for i, row := range sheet.Rows {
+		var got []cell
+		for _, c := range row.Cells {
+			value := c.Value
+			if c.Type == "inlineStr" {
+				value = c.Inline
+			}
+			if value == nil {
+				t.Fatalf("row %d cell %s has no value", i+1, c.Ref)
+			}
+			got = append(got, cell{c.Ref, c.Type, *value})
+		}
+		if !reflect.DeepEqual(got, want[i]) {
+			t.Errorf("row %s = %v, want %v", row.Ref, got, want[i])
+		}
+	}
+}
+
+func TestWriter_WriteRow_UnsupportedType(t *testing.T) {
+	w, err := NewWriter(io.Discard, "sheet")
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	if err = w.WriteRow(true); err == nil {
+		t.Fatal("WriteRow(bool) error = nil")
+	}
+}
+
+func TestWriter_Closed(t *testing.T) {
+	w, err := NewWriter(io.Discard, "sheet")
+	if err != nil {
+		t.Fatalf("NewWriter() error = %v", err)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	tests := []struct {
+		name string
+		call func() error
+	}{
+		{"WriteRow", func() error { return w.WriteRow("a") }},
+		{"Flush", w.Flush},
+		{"Close", w.Close},
+	}
+	for _, tt := range tests {
+		if err := tt.call(); err != ErrClosed {
+			t.Errorf("%s() after Close error = %v, want %v", tt.name, err, ErrClosed)
+		}
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	tests := []struct {
+		index int
+		want  string
+	}{
+		{0, "A"},
+		{1, "B"},
+		{25, "Z"},
+		{26, "AA"},
+		{27, "AB"},
+		{51, "AZ"},
+		{52, "BA"},
+		{701, "ZZ"},
+		{702, "AAA"},
+	}
+	for _, tt := range tests {
+		if got := columnName(tt.index); got != tt.want {
+			t.Errorf("columnName(%d) = %q, want %q", tt.index, got, tt.want)
+		}
+	}
+}
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+	}{
+		{"plain", "plain"},
+		{"a & b", "a &amp; b"},
+		{`<"'>`, "&lt;&#34;&#39;&gt;"},
+		{"한글", "한글"},
+		{"bell\a", "bell\uFFFD"},
+	}
+	for _, tt := range tests {
+		if got := escape(tt.s); got != tt.want {
+			t.Errorf("escape(%q) = %q, want %q", tt.s, got, tt.want)
+		}
+		if strings.ContainsAny(escape(tt.s), "<>") {
+			t.Errorf("escape(%q) left markup", tt.s)
+		}
+	}
+}
@@ -6,10 +6,17 @@ import (
 	"github.com/google/wire"
 	"github.com/stockfolioofficial/back-editfolio/core/app"
 	"github.com/stockfolioofficial/back-editfolio/core/di"
+	"github.com/stockfolioofficial/back-editfolio/core/seed"
 )
 
 // getApp returns a real app.
-func getApp() app.App {
+func getApp() (app.App, error) {
 	wire.Build(di.DI)
+	return nil, nil
+}
+
+// getSeeder returns a seeder for the seed command.
+func getSeeder() *seed.Seeder {
+	wire.Build(di.NewDatabase, seed.NewSeeder)
 	return nil
-}
\ No newline at end of file
+}